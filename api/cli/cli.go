@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strings"
+
 	"github.com/alecthomas/kong"
 
 	"fibo/config"
@@ -10,12 +12,43 @@ import (
 
 type scheme struct {
 	EnvPath string `help:"Path to env config file" type:"path" optional:""`
+
+	Serve      struct{}         `cmd:"" default:"1" help:"Start HTTP server (default)"`
+	Payroll    payrollScheme    `cmd:"" help:"Preview, compute and approve monthly author payroll"`
+	Reputation reputationScheme `cmd:"" help:"Maintain user reputation"`
+	Posts      postsScheme      `cmd:"" help:"Maintain posts"`
+}
+
+type payrollScheme struct {
+	Preview payrollMonthScheme `cmd:"" help:"Show payroll of the month without storing it"`
+	Compute payrollMonthScheme `cmd:"" help:"Store a new draft payroll run of the month"`
+	Approve payrollRunScheme   `cmd:"" help:"Approve a draft payroll run"`
+	Show    payrollRunScheme   `cmd:"" help:"Show a stored payroll run"`
+}
+
+type payrollMonthScheme struct {
+	Month string `arg:"" help:"Month in YYYY-MM format"`
+	Tiers string `help:"Rate tiers overriding PAYROLL_TIERS, e.g. 0:100,50:150" optional:""`
+}
+
+type payrollRunScheme struct {
+	RunId int64 `arg:"" help:"Payroll run id"`
+}
+
+type reputationScheme struct {
+	Recompute struct{} `cmd:"" help:"Rebuild reputation of every user from the event ledger"`
+}
+
+type postsScheme struct {
+	Purge   struct{} `cmd:"" help:"Hard-delete posts deleted longer than POST_RETENTION_DAYS ago"`
+	Publish struct{} `cmd:"" help:"Publish approved posts whose scheduled time has come"`
 }
 
 // Parser
 
 type Parser struct {
-	scheme scheme
+	scheme  scheme
+	command string
 }
 
 func NewParser() *Parser {
@@ -23,6 +56,26 @@ func NewParser() *Parser {
 }
 
 func (p *Parser) ParseConfig() (*config.Config, error) {
-	kong.Parse(&p.scheme)
+	ctx := kong.Parse(&p.scheme)
+	p.command = ctx.Command()
+
 	return config.ParseEnv(p.scheme.EnvPath)
 }
+
+// IsPayroll reports whether a payroll subcommand was picked instead of
+// starting the server.
+func (p *Parser) IsPayroll() bool {
+	return strings.HasPrefix(p.command, "payroll")
+}
+
+// IsReputation reports whether a reputation subcommand was picked instead of
+// starting the server.
+func (p *Parser) IsReputation() bool {
+	return strings.HasPrefix(p.command, "reputation")
+}
+
+// IsPosts reports whether a posts subcommand was picked instead of starting
+// the server.
+func (p *Parser) IsPosts() bool {
+	return strings.HasPrefix(p.command, "posts")
+}
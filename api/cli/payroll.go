@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"fibo/internal/payroll"
+)
+
+// RunPayroll executes the picked payroll subcommand and prints its result
+// as JSON.
+func (p *Parser) RunPayroll(ctx context.Context, usecases payroll.PayrollUsecases, out io.Writer) error {
+	var result interface{}
+	var err error
+
+	cmd := p.scheme.Payroll
+
+	switch p.command {
+	case "payroll preview <month>":
+		result, err = usecases.Preview(ctx, payroll.ComputeRunDto{
+			Month: cmd.Preview.Month,
+			Tiers: cmd.Preview.Tiers,
+		})
+	case "payroll compute <month>":
+		result, err = usecases.Compute(ctx, payroll.ComputeRunDto{
+			Month: cmd.Compute.Month,
+			Tiers: cmd.Compute.Tiers,
+		})
+	case "payroll approve <run-id>":
+		err = usecases.Approve(ctx, payroll.ApproveRunDto{RunId: cmd.Approve.RunId})
+		if err == nil {
+			result, err = usecases.GetRun(ctx, cmd.Approve.RunId)
+		}
+	case "payroll show <run-id>":
+		result, err = usecases.GetRun(ctx, cmd.Show.RunId)
+	}
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"fibo/internal/post"
+)
+
+// RunPosts executes the picked posts subcommand and prints its result as
+// JSON.
+func (p *Parser) RunPosts(ctx context.Context, usecases post.PostUseCase, out io.Writer) error {
+	var result interface{}
+	var err error
+
+	switch p.command {
+	case "posts purge":
+		result, err = usecases.PurgePosts(ctx)
+	case "posts publish":
+		result, err = usecases.PublishScheduled(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"fibo/internal/user"
+)
+
+// RunReputation executes the picked reputation subcommand and prints its
+// result as JSON.
+func (p *Parser) RunReputation(ctx context.Context, usecases user.UserUsecases, out io.Writer) error {
+	var result interface{}
+	var err error
+
+	switch p.command {
+	case "reputation recompute":
+		result, err = usecases.RecomputeReputation(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
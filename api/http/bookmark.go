@@ -0,0 +1,174 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/bookmark"
+)
+
+func (r *router) getBookmarkLists(c *gin.Context) {
+	lists, err := r.bookmarkUsecases.GetLists(contextWithReqInfo(c), GetReqInfo(c).UserId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(lists).Reply(c)
+}
+
+func (r *router) addBookmarkList(c *gin.Context) {
+	var addListDto bookmark.AddListDto
+
+	if err := BindBody(&addListDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+	addListDto.UserId = GetReqInfo(c).UserId
+
+	list, err := r.bookmarkUsecases.AddList(contextWithReqInfo(c), addListDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(list).Reply(c)
+}
+
+func (r *router) getBookmarkList(c *gin.Context) {
+	listId, err := strconv.ParseInt(c.Param("listId"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	listKeyDto := bookmark.ListKeyDto{
+		Id:     listId,
+		UserId: GetReqInfo(c).UserId,
+	}
+
+	bookmarks, err := r.bookmarkUsecases.GetBookmarks(contextWithReqInfo(c), listKeyDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(bookmarks).Reply(c)
+}
+
+func (r *router) updateBookmarkList(c *gin.Context) {
+	var updateListDto bookmark.UpdateListDto
+
+	listId, err := strconv.ParseInt(c.Param("listId"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	if err := BindBody(&updateListDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+	updateListDto.Id = listId
+	updateListDto.UserId = GetReqInfo(c).UserId
+
+	if err := r.bookmarkUsecases.UpdateList(contextWithReqInfo(c), updateListDto); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) deleteBookmarkList(c *gin.Context) {
+	listId, err := strconv.ParseInt(c.Param("listId"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	listKeyDto := bookmark.ListKeyDto{
+		Id:     listId,
+		UserId: GetReqInfo(c).UserId,
+	}
+
+	if err := r.bookmarkUsecases.DeleteList(contextWithReqInfo(c), listKeyDto); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) addBookmark(c *gin.Context) {
+	var addBookmarkDto bookmark.AddBookmarkDto
+
+	if err := BindBody(&addBookmarkDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+	addBookmarkDto.UserId = GetReqInfo(c).UserId
+
+	saves, err := r.bookmarkUsecases.AddBookmark(contextWithReqInfo(c), addBookmarkDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(saves).Reply(c)
+}
+
+// removeBookmark takes the post off the list in the "listId" query, or off
+// every list without it.
+func (r *router) removeBookmark(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("postId"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	listId, err := QueryInt64(c, "listId")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	removeBookmarkDto := bookmark.RemoveBookmarkDto{
+		UserId: GetReqInfo(c).UserId,
+		PostId: postId,
+		ListId: listId,
+	}
+
+	saves, err := r.bookmarkUsecases.RemoveBookmark(contextWithReqInfo(c), removeBookmarkDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(saves).Reply(c)
+}
+
+func (r *router) moveBookmark(c *gin.Context) {
+	var moveBookmarkDto bookmark.MoveBookmarkDto
+
+	postId, err := strconv.ParseInt(c.Param("postId"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	if err := BindBody(&moveBookmarkDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+	moveBookmarkDto.PostId = postId
+	moveBookmarkDto.UserId = GetReqInfo(c).UserId
+
+	if err := r.bookmarkUsecases.MoveBookmark(contextWithReqInfo(c), moveBookmarkDto); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/comment"
+	"fibo/internal/user"
+)
+
+func (r *router) getPostComments(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	offset, err := QueryUint(c, "offset")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	getCommentsDto := comment.GetCommentsDto{
+		PostId:   postId,
+		UserId:   reqInfo.UserId,
+		UserRole: user.Role(reqInfo.Role),
+		Tree:     c.Query("view") != "flat",
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	comments, err := r.commentUsecases.GetByPost(contextWithReqInfo(c), getCommentsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(comments).Reply(c)
+}
+
+func (r *router) addPostComment(c *gin.Context) {
+	var addCommentDto comment.AddCommentDto
+
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	if err := BindBody(&addCommentDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	addCommentDto.PostId = postId
+	addCommentDto.UserId = reqInfo.UserId
+	addCommentDto.UserRole = user.Role(reqInfo.Role)
+	if reqInfo.UserId != 0 {
+		addCommentDto.AuthorName = ""
+	}
+
+	commentId, err := r.commentUsecases.Add(contextWithReqInfo(c), addCommentDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(commentId).Reply(c)
+}
+
+func (r *router) updatePostComment(c *gin.Context) {
+	var updateCommentDto comment.UpdateCommentDto
+
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	commentId, err := strconv.ParseInt(c.Param("commentId"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	if err := BindBody(&updateCommentDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	updateCommentDto.Id = commentId
+	updateCommentDto.PostId = postId
+	updateCommentDto.UserId = GetReqInfo(c).UserId
+
+	err = r.commentUsecases.Update(contextWithReqInfo(c), updateCommentDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) deletePostComment(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	commentId, err := strconv.ParseInt(c.Param("commentId"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	deleteCommentDto := comment.DeleteCommentDto{
+		Id:     commentId,
+		PostId: postId,
+		UserId: GetReqInfo(c).UserId,
+	}
+
+	err = r.commentUsecases.Delete(contextWithReqInfo(c), deleteCommentDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
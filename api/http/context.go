@@ -42,6 +42,34 @@ func setUserId(c *gin.Context, userId int64) {
 	c.Set(reqInfoKey, request.RequestInfo{UserId: userId})
 }
 
+func setRole(c *gin.Context, role string) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.Role = role
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{Role: role})
+}
+
+func setVisitorId(c *gin.Context, visitorId string) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.VisitorId = visitorId
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{VisitorId: visitorId})
+}
+
 func GetReqInfo(c *gin.Context) request.RequestInfo {
 	info, ok := c.Get(reqInfoKey)
 	if ok {
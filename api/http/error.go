@@ -33,6 +33,8 @@ func convertErrorStatusToHTTP(status errors.Status) int {
 		return http.StatusUnauthorized
 	case errors.WrongCredentialsError:
 		return http.StatusUnauthorized
+	case errors.ForbiddenError:
+		return http.StatusForbidden
 	case errors.NotFoundError:
 		return http.StatusNotFound
 	case errors.AlreadyExistsError:
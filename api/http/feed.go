@@ -0,0 +1,234 @@
+package http
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/post"
+	"fibo/internal/post/content"
+)
+
+const (
+	feedTitle = "Fibo"
+	// feedSize is how many of the latest posts a feed holds.
+	feedSize uint = 20
+	// excerptWords is about how long the excerpt of a feed item is.
+	excerptWords = 60
+)
+
+type feedFormat string
+
+const (
+	feedRSS  feedFormat = "rss"
+	feedAtom feedFormat = "atom"
+	feedJSON feedFormat = "json"
+)
+
+// feed holds the published posts of a feed before they are written in one
+// of the formats. Updated is when any of its posts last changed, so edits
+// count as changes of the feed, not only new posts.
+type feed struct {
+	Title    string
+	Link     string
+	SelfLink string
+	Updated  time.Time
+	Items    []feedItem
+}
+
+type feedItem struct {
+	Id          int64
+	Title       string
+	Link        string
+	Author      string
+	Tags        []string
+	Summary     string
+	ExcerptHTML string
+	Published   time.Time
+	Updated     time.Time
+}
+
+func (r *router) getFeed(c *gin.Context) {
+	r.replyFeed(c, post.ListPostsDto{}, feedTitle, r.config.PublicURL())
+}
+
+func (r *router) getCategoryFeed(c *gin.Context) {
+	categoryId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	category, err := r.catUsecases.GetByID(contextWithReqInfo(c), categoryId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	listPostsDto := post.ListPostsDto{CategoryId: categoryId}
+	r.replyFeed(c, listPostsDto, feedTitle+": "+category.Name, r.categoryURL(categoryId))
+}
+
+func (r *router) getAuthorFeed(c *gin.Context) {
+	authorId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	author, err := r.userUsecases.GetById(contextWithReqInfo(c), authorId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	listPostsDto := post.ListPostsDto{AuthorId: authorId}
+	name := strings.TrimSpace(author.FirstName + " " + author.LastName)
+	r.replyFeed(c, listPostsDto, feedTitle+": "+name, r.authorURL(authorId))
+}
+
+// replyFeed writes the latest published posts the dto selects in the format
+// from the path, newest publish date first. Readers polling an unchanged feed
+// get 304 Not Modified.
+func (r *router) replyFeed(c *gin.Context, listPostsDto post.ListPostsDto, title string, link string) {
+	format := feedFormat(c.Param("format"))
+	if format != feedRSS && format != feedAtom && format != feedJSON {
+		err := errors.Errorf(errors.BadRequestError, "unknown feed format \"%s\"", format)
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	listPostsDto.Sort = post.SortPublishAt
+	listPostsDto.Limit = feedSize
+	page, err := r.postUsecases.GetPublishedPosts(contextWithReqInfo(c), listPostsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	f := feed{
+		Title:    title,
+		Link:     link,
+		SelfLink: r.config.PublicURL() + c.Request.URL.Path,
+	}
+	for _, p := range page.Posts {
+		item := r.feedItem(p)
+		if item.Updated.After(f.Updated) {
+			f.Updated = item.Updated
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	etag := feedETag(format, f)
+	c.Header("ETag", etag)
+	if !f.Updated.IsZero() {
+		c.Header("Last-Modified", f.Updated.Format(http.TimeFormat))
+	}
+	if isNotModified(c.Request, etag, f.Updated) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	var body []byte
+	var contentType string
+	switch format {
+	case feedRSS:
+		body, err = f.RSS()
+		contentType = "application/rss+xml; charset=utf-8"
+	case feedAtom:
+		body, err = f.Atom()
+		contentType = "application/atom+xml; charset=utf-8"
+	default:
+		body, err = f.JSON()
+		contentType = "application/feed+json; charset=utf-8"
+	}
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, body)
+}
+
+func (r *router) feedItem(p post.PostModelWithUser) feedItem {
+	item := feedItem{
+		Id:          p.Id,
+		Title:       p.Title,
+		Link:        r.postURL(p.Slug),
+		Author:      p.UserName,
+		Tags:        p.Tags,
+		Summary:     content.Summary(p.Content, excerptWords),
+		ExcerptHTML: content.Excerpt(p.Content, excerptWords),
+		Published:   parseFeedTime(p.PublishAt, p.StateChangedAt, p.CreatedAt),
+	}
+
+	item.Updated = parseFeedTime(p.UpdatedAt)
+	if item.Updated.Before(item.Published) {
+		item.Updated = item.Published
+	}
+
+	return item
+}
+
+// postURL is the address readers open the post at.
+func (r *router) postURL(slug string) string {
+	return r.config.PublicURL() + "/posts/" + url.PathEscape(slug)
+}
+
+func (r *router) categoryURL(categoryId int64) string {
+	return fmt.Sprintf("%s/categories/%d", r.config.PublicURL(), categoryId)
+}
+
+func (r *router) authorURL(authorId int64) string {
+	return fmt.Sprintf("%s/users/%d", r.config.PublicURL(), authorId)
+}
+
+// parseFeedTime reads the first of the RFC3339 values that is set.
+func parseFeedTime(values ...string) time.Time {
+	for _, value := range values {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t.UTC()
+		}
+	}
+
+	return time.Time{}
+}
+
+// feedETag changes whenever an item of the feed is added, removed or edited.
+func feedETag(format feedFormat, f feed) string {
+	hash := sha1.New()
+	fmt.Fprintf(hash, "%s\n%s\n", format, f.Title)
+	for _, item := range f.Items {
+		fmt.Fprintf(hash, "%d %d\n", item.Id, item.Updated.Unix())
+	}
+
+	return fmt.Sprintf("\"%x\"", hash.Sum(nil))
+}
+
+// isNotModified checks the conditional headers of the request. If-None-Match
+// wins over If-Modified-Since when both are sent.
+func isNotModified(req *http.Request, etag string, modified time.Time) bool {
+	if match := req.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil || modified.IsZero() {
+		return false
+	}
+
+	return !modified.Truncate(time.Second).After(since)
+}
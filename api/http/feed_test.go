@@ -0,0 +1,294 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"fibo/internal/post"
+)
+
+func TestRouter_Feed(t *testing.T) {
+	published := time.Date(2026, time.September, 2, 10, 0, 0, 0, time.UTC)
+	updated := time.Date(2026, time.September, 5, 8, 0, 0, 0, time.UTC)
+	posts := []post.PostModelWithUser{
+		{
+			Id:        1,
+			Title:     "Fish & <Chips>",
+			Slug:      "fish-chips",
+			Content:   `{"blocks":[{"type":"paragraph","data":{"text":"Fried <b>fish</b> &amp; chips<script>x</script>"}}]}`,
+			Tags:      []string{"food"},
+			PublishAt: "2026-09-02T10:00:00Z",
+			UpdatedAt: "2026-09-03T08:00:00Z",
+			UserName:  "Ann",
+		},
+		{
+			Id:        2,
+			Title:     "Older",
+			Slug:      "older",
+			Content:   `{"blocks":[{"type":"paragraph","data":{"text":"Text"}}]}`,
+			PublishAt: "2026-09-01T10:00:00Z",
+			UpdatedAt: "2026-09-05T08:00:00Z",
+		},
+	}
+	excerpt := "<p>Fried <b>fish</b> &amp; chips</p>"
+
+	tests := []struct {
+		name        string
+		format      string
+		contentType string
+		check       func(t *testing.T, body []byte)
+	}{
+		{
+			name:        "expect RSS",
+			format:      "rss",
+			contentType: "application/rss+xml; charset=utf-8",
+			check: func(t *testing.T, body []byte) {
+				var out struct {
+					Items []struct {
+						Title       string `xml:"title"`
+						Link        string `xml:"link"`
+						Guid        string `xml:"guid"`
+						PubDate     string `xml:"pubDate"`
+						Description string `xml:"description"`
+					} `xml:"channel>item"`
+				}
+				require.NoError(t, xml.Unmarshal(body, &out))
+				require.Len(t, out.Items, 2)
+				require.Equal(t, "Fish & <Chips>", out.Items[0].Title)
+				require.Equal(t, "https://fibo.test/posts/fish-chips", out.Items[0].Link)
+				require.Equal(t, "1", out.Items[0].Guid)
+				require.Equal(t, published.Format(time.RFC1123Z), out.Items[0].PubDate)
+				require.Equal(t, excerpt, out.Items[0].Description)
+				require.Contains(t, string(body), "<title>Fish &amp; &lt;Chips&gt;</title>")
+				require.NotContains(t, string(body), "<script>")
+			},
+		},
+		{
+			name:        "expect Atom",
+			format:      "atom",
+			contentType: "application/atom+xml; charset=utf-8",
+			check: func(t *testing.T, body []byte) {
+				var out struct {
+					Updated string `xml:"updated"`
+					Entries []struct {
+						Title     string `xml:"title"`
+						Published string `xml:"published"`
+						Updated   string `xml:"updated"`
+						Author    string `xml:"author>name"`
+						Summary   struct {
+							Type string `xml:"type,attr"`
+							Body string `xml:",chardata"`
+						} `xml:"summary"`
+					} `xml:"entry"`
+				}
+				require.NoError(t, xml.Unmarshal(body, &out))
+				require.Equal(t, "2026-09-05T08:00:00Z", out.Updated)
+				require.Len(t, out.Entries, 2)
+				require.Equal(t, "Fish & <Chips>", out.Entries[0].Title)
+				require.Equal(t, "2026-09-02T10:00:00Z", out.Entries[0].Published)
+				require.Equal(t, "2026-09-03T08:00:00Z", out.Entries[0].Updated)
+				require.Equal(t, "Ann", out.Entries[0].Author)
+				require.Equal(t, "html", out.Entries[0].Summary.Type)
+				require.Equal(t, excerpt, out.Entries[0].Summary.Body)
+				require.NotContains(t, string(body), "<script>")
+			},
+		},
+		{
+			name:        "expect JSON Feed",
+			format:      "json",
+			contentType: "application/feed+json; charset=utf-8",
+			check: func(t *testing.T, body []byte) {
+				var out jsonFeed
+				require.NoError(t, json.Unmarshal(body, &out))
+				require.Equal(t, "https://jsonfeed.org/version/1.1", out.Version)
+				require.Equal(t, "https://fibo.test/feeds/json", out.FeedURL)
+				require.Len(t, out.Items, 2)
+				require.Equal(t, jsonFeedItem{
+					Id:            "1",
+					URL:           "https://fibo.test/posts/fish-chips",
+					Title:         "Fish & <Chips>",
+					ContentHTML:   excerpt,
+					Summary:       "Fried fish & chips",
+					DatePublished: "2026-09-02T10:00:00Z",
+					DateModified:  "2026-09-03T08:00:00Z",
+					Authors:       []jsonFeedAuthor{{Name: "Ann"}},
+					Tags:          []string{"food"},
+				}, out.Items[0])
+				require.Empty(t, out.Items[1].Authors)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, posts := newFeedTestRouter(posts)
+
+			res := serveFeed(engine, "/feeds/"+tt.format, nil)
+
+			require.Equal(t, http.StatusOK, res.Code)
+			require.Equal(t, tt.contentType, res.Header().Get("Content-Type"))
+			require.Equal(t, updated.Format(http.TimeFormat), res.Header().Get("Last-Modified"))
+			require.NotEmpty(t, res.Header().Get("ETag"))
+			require.Equal(t, []post.ListPostsDto{{Sort: post.SortPublishAt, Limit: feedSize}}, posts.listed)
+			tt.check(t, res.Body.Bytes())
+		})
+	}
+
+	t.Run("expect unknown format to be a bad request", func(t *testing.T) {
+		engine, posts := newFeedTestRouter(posts)
+
+		res := serveFeed(engine, "/feeds/xml", nil)
+
+		require.Equal(t, http.StatusBadRequest, res.Code)
+		require.Empty(t, posts.listed)
+	})
+
+	t.Run("expect the same ETag to be not modified", func(t *testing.T) {
+		engine, _ := newFeedTestRouter(posts)
+		first := serveFeed(engine, "/feeds/rss", nil)
+
+		res := serveFeed(engine, "/feeds/rss", map[string]string{"If-None-Match": first.Header().Get("ETag")})
+
+		require.Equal(t, http.StatusNotModified, res.Code)
+		require.Empty(t, res.Body.Bytes())
+	})
+
+	t.Run("expect ETag to differ between formats", func(t *testing.T) {
+		engine, _ := newFeedTestRouter(posts)
+		rss := serveFeed(engine, "/feeds/rss", nil)
+
+		res := serveFeed(engine, "/feeds/atom", map[string]string{"If-None-Match": rss.Header().Get("ETag")})
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("expect a feed not changed since to be not modified", func(t *testing.T) {
+		engine, _ := newFeedTestRouter(posts)
+
+		res := serveFeed(engine, "/feeds/json", map[string]string{"If-Modified-Since": updated.Format(http.TimeFormat)})
+
+		require.Equal(t, http.StatusNotModified, res.Code)
+	})
+
+	t.Run("expect a feed with a post edited since to be sent", func(t *testing.T) {
+		engine, _ := newFeedTestRouter(posts)
+		since := updated.Add(-time.Hour).Format(http.TimeFormat)
+
+		res := serveFeed(engine, "/feeds/json", map[string]string{"If-Modified-Since": since})
+
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+}
+
+func TestIsNotModified(t *testing.T) {
+	etag := `"abc"`
+	modified := time.Date(2026, time.September, 2, 10, 0, 0, 500, time.UTC)
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		modified time.Time
+		want     bool
+	}{
+		{"expect no conditional headers to be modified", nil, modified, false},
+		{"expect matching ETag not to be modified", map[string]string{"If-None-Match": `"abc"`}, modified, true},
+		{"expect weak matching ETag not to be modified", map[string]string{"If-None-Match": `W/"abc"`}, modified, true},
+		{"expect ETag in a list not to be modified", map[string]string{"If-None-Match": `"x", "abc"`}, modified, true},
+		{"expect any ETag not to be modified", map[string]string{"If-None-Match": "*"}, modified, true},
+		{"expect other ETag to be modified", map[string]string{"If-None-Match": `"x"`}, modified, false},
+		{
+			"expect ETag to win over the date",
+			map[string]string{"If-None-Match": `"x"`, "If-Modified-Since": modified.Add(time.Hour).Format(http.TimeFormat)},
+			modified,
+			false,
+		},
+		{
+			"expect the same second not to be modified",
+			map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)},
+			modified,
+			true,
+		},
+		{
+			"expect a later date not to be modified",
+			map[string]string{"If-Modified-Since": modified.Add(time.Hour).Format(http.TimeFormat)},
+			modified,
+			true,
+		},
+		{
+			"expect an earlier date to be modified",
+			map[string]string{"If-Modified-Since": modified.Add(-time.Second).Format(http.TimeFormat)},
+			modified,
+			false,
+		},
+		{"expect a malformed date to be modified", map[string]string{"If-Modified-Since": "yesterday"}, modified, false},
+		{
+			"expect an empty feed to be modified",
+			map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)},
+			time.Time{},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/feeds/rss", nil)
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+
+			require.Equal(t, tt.want, isNotModified(req, etag, tt.modified))
+		})
+	}
+}
+
+type feedTestConfig struct{}
+
+func (feedTestConfig) DetailedError() bool { return false }
+func (feedTestConfig) Address() string     { return "" }
+func (feedTestConfig) PublicURL() string   { return "https://fibo.test" }
+
+// feedTestPosts serves the published posts of a feed and records how they
+// were asked for.
+type feedTestPosts struct {
+	post.PostUseCase
+	posts  []post.PostModelWithUser
+	listed []post.ListPostsDto
+}
+
+func (p *feedTestPosts) GetPublishedPosts(_ context.Context, in post.ListPostsDto) (post.PostsPageDto, error) {
+	p.listed = append(p.listed, in)
+	return post.PostsPageDto{Posts: p.posts}, nil
+}
+
+func newFeedTestRouter(posts []post.PostModelWithUser) (*gin.Engine, *feedTestPosts) {
+	gin.SetMode(gin.TestMode)
+	postUsecases := &feedTestPosts{posts: posts}
+	r := &router{Server: &Server{
+		engine:       gin.New(),
+		config:       feedTestConfig{},
+		postUsecases: postUsecases,
+	}}
+	r.engine.GET("/feeds/:format", r.getFeed)
+
+	return r.engine, postUsecases
+}
+
+func serveFeed(engine *gin.Engine, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+
+	return res
+}
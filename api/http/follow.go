@@ -0,0 +1,70 @@
+package http
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/follow"
+)
+
+type followFunc func(ctx context.Context, dto follow.FollowDto) (follow.FollowedDto, error)
+
+// followTarget builds a handler making the logged user follow the author or
+// category from the path, or stop following it.
+func (r *router) followTarget(targetType follow.TargetType, change followFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		followDto := follow.FollowDto{
+			UserId:     GetReqInfo(c).UserId,
+			TargetType: targetType,
+			TargetId:   targetId,
+		}
+
+		followed, err := change(contextWithReqInfo(c), followDto)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		OkResponse(followed).Reply(c)
+	}
+}
+
+func (r *router) getMyFollowing(c *gin.Context) {
+	following, err := r.followUsecases.GetFollowing(contextWithReqInfo(c), GetReqInfo(c).UserId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(following).Reply(c)
+}
+
+func (r *router) getMyFeed(c *gin.Context) {
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	getFeedDto := follow.GetFeedDto{
+		UserId: GetReqInfo(c).UserId,
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	}
+
+	page, err := r.followUsecases.GetFeed(contextWithReqInfo(c), getFeedDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	PageResponse(page.Posts, page.NextCursor).Reply(c)
+}
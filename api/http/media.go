@@ -0,0 +1,138 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/media"
+)
+
+// uploadMedia uploads the image of a multipart form, sent in an "image"
+// field as the EditorJS image tool does or in a "file" field. The form is
+// read as it comes, the image is never held past the size limit.
+func (r *router) uploadMedia(c *gin.Context) {
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		err = errors.New(errors.BadRequestError, "body must be a multipart form")
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			err = errors.Wrap(err, errors.BadRequestError, "multipart form is malformed")
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+		if part.FormName() != "image" && part.FormName() != "file" {
+			continue
+		}
+
+		uploadDto := media.UploadDto{
+			UserId: GetReqInfo(c).UserId,
+			Body:   part,
+		}
+
+		out, err := r.mediaUsecases.Upload(contextWithReqInfo(c), uploadDto)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		OkResponse(out).Reply(c)
+		return
+	}
+
+	err = errors.New(errors.ValidationError, "image: cannot be blank.")
+	ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+}
+
+func (r *router) getMyMedia(c *gin.Context) {
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	getMediaDto := media.GetMediaDto{
+		UserId: GetReqInfo(c).UserId,
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	}
+
+	page, err := r.mediaUsecases.GetMyMedia(contextWithReqInfo(c), getMediaDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	PageResponse(page.Media, page.NextCursor).Reply(c)
+}
+
+func (r *router) getMedia(c *gin.Context) {
+	mediaKeyDto, err := mediaKeyDtoFromRequest(c)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	out, err := r.mediaUsecases.GetMedia(contextWithReqInfo(c), mediaKeyDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(out).Reply(c)
+}
+
+func (r *router) deleteMedia(c *gin.Context) {
+	mediaKeyDto, err := mediaKeyDtoFromRequest(c)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	if err := r.mediaUsecases.DeleteMedia(contextWithReqInfo(c), mediaKeyDto); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+// getMediaFile serves a file of the media storage. Keys are never reused,
+// so browsers may keep the files for good.
+func (r *router) getMediaFile(c *gin.Context) {
+	file, err := r.mediaUsecases.GetFile(contextWithReqInfo(c), strings.TrimPrefix(c.Param("key"), "/"))
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+	defer file.Body.Close()
+
+	c.DataFromReader(http.StatusOK, file.Size, file.ContentType, file.Body, map[string]string{
+		"Cache-Control":          "public, max-age=31536000, immutable",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func mediaKeyDtoFromRequest(c *gin.Context) (media.MediaKeyDto, error) {
+	mediaId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return media.MediaKeyDto{}, errors.Errorf(errors.BadRequestError, "media id \"%s\" is malformed", c.Param("id"))
+	}
+
+	return media.MediaKeyDto{
+		Id:     mediaId,
+		UserId: GetReqInfo(c).UserId,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/notification"
+)
+
+func (r *router) getNotifications(c *gin.Context) {
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	unreadOnly, err := strconv.ParseBool(c.DefaultQuery("unread", "false"))
+	if err != nil {
+		err = errors.New(errors.BadRequestError, "query parameter \"unread\" must be true or false")
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	getNotificationsDto := notification.GetNotificationsDto{
+		UserId:     GetReqInfo(c).UserId,
+		UnreadOnly: unreadOnly,
+		Limit:      limit,
+		Cursor:     c.Query("cursor"),
+	}
+
+	page, err := r.notificationUsecases.GetNotifications(contextWithReqInfo(c), getNotificationsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	PageResponse(page.Notifications, page.NextCursor).Reply(c)
+}
+
+func (r *router) getUnreadNotifications(c *gin.Context) {
+	unread, err := r.notificationUsecases.GetUnread(contextWithReqInfo(c), GetReqInfo(c).UserId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(unread).Reply(c)
+}
+
+// markNotificationsRead marks the notifications of the body read, or every
+// notification for no ids.
+func (r *router) markNotificationsRead(c *gin.Context) {
+	var markReadDto notification.MarkReadDto
+
+	if c.Request.ContentLength != 0 {
+		if err := BindBody(&markReadDto, c); err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+	}
+	markReadDto.UserId = GetReqInfo(c).UserId
+
+	unread, err := r.notificationUsecases.MarkRead(contextWithReqInfo(c), markReadDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(unread).Reply(c)
+}
+
+func (r *router) markNotificationRead(c *gin.Context) {
+	notificationId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	markReadDto := notification.MarkReadDto{
+		UserId: GetReqInfo(c).UserId,
+		Ids:    []int64{notificationId},
+	}
+
+	unread, err := r.notificationUsecases.MarkRead(contextWithReqInfo(c), markReadDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(unread).Reply(c)
+}
+
+func (r *router) getNotificationPreferences(c *gin.Context) {
+	preferences, err := r.notificationUsecases.GetPreferences(contextWithReqInfo(c), GetReqInfo(c).UserId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(preferences).Reply(c)
+}
+
+func (r *router) updateNotificationPreferences(c *gin.Context) {
+	var updatePreferencesDto notification.UpdatePreferencesDto
+
+	if err := BindBody(&updatePreferencesDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+	updatePreferencesDto.UserId = GetReqInfo(c).UserId
+
+	preferences, err := r.notificationUsecases.UpdatePreferences(contextWithReqInfo(c), updatePreferencesDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(preferences).Reply(c)
+}
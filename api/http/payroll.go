@@ -0,0 +1,91 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/payroll"
+)
+
+func (r *router) previewPayroll(c *gin.Context) {
+	computeRunDto := payroll.ComputeRunDto{
+		Month:   c.Query("month"),
+		Tiers:   c.Query("tiers"),
+		ActorId: GetReqInfo(c).UserId,
+	}
+
+	run, err := r.payrollUsecases.Preview(contextWithReqInfo(c), computeRunDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(run).Reply(c)
+}
+
+func (r *router) computePayroll(c *gin.Context) {
+	var computeRunDto payroll.ComputeRunDto
+
+	if err := BindBody(&computeRunDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	computeRunDto.ActorId = GetReqInfo(c).UserId
+
+	run, err := r.payrollUsecases.Compute(contextWithReqInfo(c), computeRunDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(run).Reply(c)
+}
+
+func (r *router) approvePayroll(c *gin.Context) {
+	runId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	approveRunDto := payroll.ApproveRunDto{
+		RunId:   runId,
+		ActorId: GetReqInfo(c).UserId,
+	}
+
+	err = r.payrollUsecases.Approve(contextWithReqInfo(c), approveRunDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) getPayrollRun(c *gin.Context) {
+	runId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	run, err := r.payrollUsecases.GetRun(contextWithReqInfo(c), runId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(run).Reply(c)
+}
+
+func (r *router) getPayrollRuns(c *gin.Context) {
+	runs, err := r.payrollUsecases.GetRuns(contextWithReqInfo(c))
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(runs).Reply(c)
+}
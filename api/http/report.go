@@ -0,0 +1,96 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/report"
+)
+
+// reportContent builds a handler reporting the post or comment whose id is
+// in the given path param on behalf of the logged user or visitor.
+func (r *router) reportContent(targetType report.TargetType, idParam string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var addReportDto report.AddReportDto
+
+		targetId, err := strconv.ParseInt(c.Param(idParam), 10, 64)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		if err := BindBody(&addReportDto, c); err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		reqInfo := GetReqInfo(c)
+		addReportDto.TargetType = targetType
+		addReportDto.TargetId = targetId
+		addReportDto.UserId = reqInfo.UserId
+		addReportDto.VisitorId = reqInfo.VisitorId
+
+		added, err := r.reportUsecases.Add(contextWithReqInfo(c), addReportDto)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		OkResponse(added).Reply(c)
+	}
+}
+
+func (r *router) getReportQueue(c *gin.Context) {
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	offset, err := QueryUint(c, "offset")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	getQueueDto := report.GetQueueDto{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	targets, err := r.reportUsecases.GetQueue(contextWithReqInfo(c), getQueueDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(targets).Reply(c)
+}
+
+// moderateReports builds a handler taking the action on the reports of the
+// post or comment in the path.
+func (r *router) moderateReports(action report.Action) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		moderateDto := report.ModerateDto{
+			TargetType: report.TargetType(c.Param("type")),
+			TargetId:   targetId,
+			ActorId:    GetReqInfo(c).UserId,
+			Action:     action,
+		}
+
+		moderated, err := r.reportUsecases.Moderate(contextWithReqInfo(c), moderateDto)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		OkResponse(moderated).Reply(c)
+	}
+}
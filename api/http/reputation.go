@@ -0,0 +1,74 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/reputation"
+)
+
+func (r *router) getMyReputation(c *gin.Context) {
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	offset, err := QueryUint(c, "offset")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	getEventsDto := reputation.GetEventsDto{
+		UserId: GetReqInfo(c).UserId,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	events, err := r.userUsecases.GetReputationHistory(contextWithReqInfo(c), getEventsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(events).Reply(c)
+}
+
+func (r *router) getReputationRules(c *gin.Context) {
+	rules, err := r.userUsecases.GetReputationRules(contextWithReqInfo(c))
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(rules).Reply(c)
+}
+
+func (r *router) updateReputationRule(c *gin.Context) {
+	var updateRuleDto reputation.UpdateRuleDto
+
+	if err := BindBody(&updateRuleDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	updateRuleDto.Event = reputation.Event(c.Param("event"))
+
+	err := r.userUsecases.UpdateReputationRule(contextWithReqInfo(c), updateRuleDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) recomputeReputation(c *gin.Context) {
+	recomputed, err := r.userUsecases.RecomputeReputation(contextWithReqInfo(c))
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(recomputed).Reply(c)
+}
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/post"
+	"fibo/internal/user"
+)
+
+type transitionPostFunc func(ctx context.Context, dto post.TransitionPostDto) error
+
+// transitionPost builds a handler moving the post from the path through the
+// review pipeline. Reviewer notes are read from an optional JSON body.
+func (r *router) transitionPost(move transitionPostFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var transitionPostDto post.TransitionPostDto
+
+		postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		if c.Request.ContentLength != 0 {
+			if err := BindBody(&transitionPostDto, c); err != nil {
+				ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+				return
+			}
+		}
+
+		transitionPostDto.PostId = postId
+		reqInfo := GetReqInfo(c)
+		transitionPostDto.ActorId = reqInfo.UserId
+		transitionPostDto.ActorRole = user.Role(reqInfo.Role)
+
+		err = move(contextWithReqInfo(c), transitionPostDto)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		OkResponse(nil).Reply(c)
+	}
+}
+
+func (r *router) getReviewQueue(c *gin.Context) {
+	posts, err := r.postUsecases.GetReviewQueue(contextWithReqInfo(c))
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(posts).Reply(c)
+}
+
+func (r *router) getPostTransitions(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	getTransitionsDto := post.GetTransitionsDto{
+		PostId:   postId,
+		UserId:   reqInfo.UserId,
+		UserRole: user.Role(reqInfo.Role),
+	}
+
+	transitions, err := r.postUsecases.GetPostTransitions(contextWithReqInfo(c), getTransitionsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(transitions).Reply(c)
+}
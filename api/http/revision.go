@@ -0,0 +1,100 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/post"
+	"fibo/internal/user"
+)
+
+func (r *router) getPostRevisions(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	getRevisionsDto := post.GetRevisionsDto{
+		PostId:   postId,
+		UserId:   reqInfo.UserId,
+		UserRole: user.Role(reqInfo.Role),
+	}
+
+	revisions, err := r.postUsecases.GetRevisions(contextWithReqInfo(c), getRevisionsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(revisions).Reply(c)
+}
+
+func (r *router) diffPostRevisions(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	from, err := QueryUint(c, "from")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	to, err := QueryUint(c, "to")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	diffRevisionsDto := post.DiffRevisionsDto{
+		PostId:   postId,
+		From:     int64(from),
+		To:       int64(to),
+		UserId:   reqInfo.UserId,
+		UserRole: user.Role(reqInfo.Role),
+	}
+
+	diff, err := r.postUsecases.DiffRevisions(contextWithReqInfo(c), diffRevisionsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(diff).Reply(c)
+}
+
+func (r *router) restorePostRevision(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	revision, err := strconv.ParseInt(c.Param("rev"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	restoreRevisionDto := post.RestoreRevisionDto{
+		PostId:   postId,
+		Revision: revision,
+		UserId:   reqInfo.UserId,
+		UserRole: user.Role(reqInfo.Role),
+	}
+
+	err = r.postUsecases.RestoreRevision(contextWithReqInfo(c), restoreRevisionDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
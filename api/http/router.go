@@ -12,10 +12,17 @@ import (
 	"fibo/internal/base/errors"
 	"fibo/internal/base/request"
 	"fibo/internal/category"
+	"fibo/internal/follow"
 	"fibo/internal/post"
+	"fibo/internal/report"
 	"fibo/internal/user"
 )
 
+var (
+	reviewerRoles = []user.Role{user.RoleReviewer, user.RoleEditor, user.RoleAdmin}
+	editorRoles   = []user.Role{user.RoleEditor, user.RoleAdmin}
+)
+
 func initRouter(server *Server) {
 	router := &router{
 		Server: server,
@@ -31,6 +38,7 @@ type router struct {
 func (r *router) init() {
 	r.engine.Use(corsMiddleware())
 	r.engine.Use(r.trace())
+	r.engine.Use(r.visitor())
 	r.engine.Use(r.recover())
 	r.engine.Use(r.logger())
 
@@ -38,25 +46,69 @@ func (r *router) init() {
 	userRoutes := r.engine.Group("/users")
 	{
 		userRoutes.POST("", r.addUser)
+		userRoutes.POST("/verify-email", r.verifyEmail)
+		userRoutes.POST("/password-reset", r.requestPasswordReset)
+		userRoutes.POST("/password-reset/confirm", r.resetPassword)
 		userRoutes.PUT("/me", r.authenticate, r.updateMe)
 		userRoutes.GET("/me", r.authenticate, r.getMe)
 		userRoutes.PATCH("/me/password", r.authenticate, r.changeMyPassword)
 		userRoutes.GET("/me/posts", r.authenticate, r.getMyPosts)
-		// admin
-		userRoutes.GET("/all", r.authenticate, r.getAllUsers)
+		userRoutes.GET("/me/reputation", r.authenticate, r.getMyReputation)
+		userRoutes.GET("/me/bookmarks", r.authenticate, r.getBookmarkLists)
+		userRoutes.POST("/me/bookmarks", r.authenticate, r.addBookmark)
+		userRoutes.DELETE("/me/bookmarks/:postId", r.authenticate, r.removeBookmark)
+		userRoutes.PUT("/me/bookmarks/:postId/position", r.authenticate, r.moveBookmark)
+		userRoutes.POST("/me/bookmarks/lists", r.authenticate, r.addBookmarkList)
+		userRoutes.GET("/me/bookmarks/lists/:listId", r.authenticate, r.getBookmarkList)
+		userRoutes.PUT("/me/bookmarks/lists/:listId", r.authenticate, r.updateBookmarkList)
+		userRoutes.DELETE("/me/bookmarks/lists/:listId", r.authenticate, r.deleteBookmarkList)
+		userRoutes.GET("/me/following", r.authenticate, r.getMyFollowing)
+		userRoutes.GET("/all", r.authenticate, r.authorize(user.RoleAdmin), r.getAllUsers)
+		userRoutes.PUT("/:id/role", r.authenticate, r.authorize(user.RoleAdmin), r.changeUserRole)
+		userRoutes.POST("/:id/follow", r.authenticate, r.followTarget(follow.TargetAuthor, r.followUsecases.Follow))
+		userRoutes.DELETE("/:id/follow", r.authenticate, r.followTarget(follow.TargetAuthor, r.followUsecases.Unfollow))
 	}
 
 	// Post routes
 	postRoutes := r.engine.Group("/posts")
 	{
-		postRoutes.POST("/like/:id", r.LikePost)
+		// Kept for frontend builds that still call the old like route.
+		postRoutes.POST("/like/:id", r.identify, r.likePost)
+		postRoutes.POST("/:id/like", r.identify, r.likePost)
+		postRoutes.DELETE("/:id/like", r.identify, r.unlikePost)
 		postRoutes.POST("", r.authenticate, r.postcontroller.AddPostC)
-		// admin
-		postRoutes.GET("", r.getPosts)
-		postRoutes.GET("/:id", r.getPostById)
+		postRoutes.GET("", r.authenticate, r.authorize(editorRoles...), r.getPosts)
+		postRoutes.GET("/:id", r.identify, r.getPostById)
+		postRoutes.GET("/by-slug/:slug", r.identify, r.getPostBySlug)
 		postRoutes.PUT("/:id", r.authenticate, r.updatePost)
+		postRoutes.DELETE("/:id", r.authenticate, r.trashPost(r.postUsecases.DeletePost))
+		postRoutes.POST("/:id/restore", r.authenticate, r.trashPost(r.postUsecases.RestorePost))
 		postRoutes.GET("/published", r.getPublishedPosts)
+		postRoutes.GET("/search", r.searchPosts)
 		postRoutes.GET("/me/likes", r.authenticate, r.getTotalLikesCountByUser)
+
+		postRoutes.GET("/review-queue", r.authenticate, r.authorize(reviewerRoles...), r.getReviewQueue)
+		postRoutes.GET("/:id/transitions", r.authenticate, r.getPostTransitions)
+		postRoutes.GET("/:id/revisions", r.authenticate, r.getPostRevisions)
+		postRoutes.GET("/:id/diff", r.authenticate, r.diffPostRevisions)
+		postRoutes.POST("/:id/revisions/:rev/restore", r.authenticate, r.restorePostRevision)
+		postRoutes.POST("/:id/submit", r.authenticate, r.transitionPost(r.postUsecases.SubmitPost))
+		postRoutes.POST("/:id/withdraw", r.authenticate, r.transitionPost(r.postUsecases.WithdrawPost))
+		postRoutes.POST("/:id/unpublish", r.authenticate, r.transitionPost(r.postUsecases.UnpublishPost))
+		postRoutes.POST("/:id/review", r.authenticate, r.authorize(reviewerRoles...), r.transitionPost(r.postUsecases.StartReview))
+		postRoutes.POST("/:id/approve", r.authenticate, r.authorize(reviewerRoles...), r.transitionPost(r.postUsecases.ApprovePost))
+		postRoutes.POST("/:id/reject", r.authenticate, r.authorize(reviewerRoles...), r.transitionPost(r.postUsecases.RejectPost))
+
+		postRoutes.GET("/:id/comments", r.identify, r.getPostComments)
+		postRoutes.POST("/:id/comments", r.identify, r.addPostComment)
+		postRoutes.PUT("/:id/comments/:commentId", r.authenticate, r.updatePostComment)
+		postRoutes.DELETE("/:id/comments/:commentId", r.authenticate, r.deletePostComment)
+
+		postRoutes.POST("/:id/report", r.identify, r.reportContent(report.TargetPost, "id"))
+		postRoutes.POST("/:id/comments/:commentId/report", r.identify, r.reportContent(report.TargetComment, "commentId"))
+		postRoutes.GET("/:id/share", r.getPostShare)
+		postRoutes.POST("/:id/shares", r.identify, r.sharePost)
+		postRoutes.GET("/:id/shares", r.authenticate, r.getPostShareStats)
 	}
 
 	// Category routes
@@ -65,6 +117,81 @@ func (r *router) init() {
 		categoryRoutes.POST("/add", r.authenticate, r.addCategory)
 		categoryRoutes.GET("", r.getCategories)
 		categoryRoutes.GET("/:id", r.getCategoryById)
+		categoryRoutes.POST("/:id/follow", r.authenticate, r.followTarget(follow.TargetCategory, r.followUsecases.Follow))
+		categoryRoutes.DELETE("/:id/follow", r.authenticate, r.followTarget(follow.TargetCategory, r.followUsecases.Unfollow))
+	}
+
+	// Tag routes
+	tagRoutes := r.engine.Group("/tags")
+	{
+		tagRoutes.GET("", r.getTags)
+		tagRoutes.GET("/:slug", r.getTag)
+		tagRoutes.GET("/:slug/posts", r.getTagPosts)
+	}
+
+	// Feed routes
+	feedRoutes := r.engine.Group("/feeds")
+	{
+		feedRoutes.GET("/:format", r.getFeed)
+		feedRoutes.GET("/categories/:id/:format", r.getCategoryFeed)
+		feedRoutes.GET("/authors/:id/:format", r.getAuthorFeed)
+	}
+
+	// Home feed of the logged user
+	r.engine.GET("/feed", r.authenticate, r.getMyFeed)
+
+	// Notification routes
+	notificationRoutes := r.engine.Group("/notifications", r.authenticate)
+	{
+		notificationRoutes.GET("", r.getNotifications)
+		notificationRoutes.GET("/unread", r.getUnreadNotifications)
+		notificationRoutes.POST("/read", r.markNotificationsRead)
+		notificationRoutes.POST("/:id/read", r.markNotificationRead)
+		notificationRoutes.GET("/preferences", r.getNotificationPreferences)
+		notificationRoutes.PUT("/preferences", r.updateNotificationPreferences)
+	}
+
+	// Media routes
+	mediaRoutes := r.engine.Group("/media", r.authenticate)
+	{
+		mediaRoutes.POST("", r.uploadMedia)
+		mediaRoutes.GET("", r.getMyMedia)
+		mediaRoutes.GET("/:id", r.getMedia)
+		mediaRoutes.DELETE("/:id", r.deleteMedia)
+	}
+
+	// Files of the media storage
+	r.engine.GET("/uploads/*key", r.getMediaFile)
+
+	// Sitemap routes
+	r.engine.GET("/sitemap.xml", r.getSitemap)
+	r.engine.GET("/sitemaps/:file", r.getSitemapFile)
+
+	// Report routes
+	reportRoutes := r.engine.Group("/reports", r.authenticate, r.authorize(reviewerRoles...))
+	{
+		reportRoutes.GET("", r.getReportQueue)
+		reportRoutes.POST("/:type/:id/resolve", r.moderateReports(report.ActionResolve))
+		reportRoutes.POST("/:type/:id/dismiss", r.moderateReports(report.ActionDismiss))
+		reportRoutes.POST("/:type/:id/hide", r.moderateReports(report.ActionHide))
+	}
+
+	// Payroll routes
+	payrollRoutes := r.engine.Group("/payroll", r.authenticate, r.authorize(user.RoleAdmin))
+	{
+		payrollRoutes.GET("/preview", r.previewPayroll)
+		payrollRoutes.GET("/runs", r.getPayrollRuns)
+		payrollRoutes.POST("/runs", r.computePayroll)
+		payrollRoutes.GET("/runs/:id", r.getPayrollRun)
+		payrollRoutes.POST("/runs/:id/approve", r.approvePayroll)
+	}
+
+	// Reputation routes
+	reputationRoutes := r.engine.Group("/reputation", r.authenticate, r.authorize(user.RoleAdmin))
+	{
+		reputationRoutes.GET("/rules", r.getReputationRules)
+		reputationRoutes.PUT("/rules/:event", r.updateReputationRule)
+		reputationRoutes.POST("/recompute", r.recomputeReputation)
 	}
 
 	r.engine.POST("/login", r.login)
@@ -75,7 +202,7 @@ func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS, PUT, DELETE")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Visitor-Id")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 
 		if c.Request.Method == "OPTIONS" {
@@ -87,26 +214,51 @@ func corsMiddleware() gin.HandlerFunc {
 	}
 }
 
-func (r *router) LikePost(c *gin.Context) {
-	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
-	var likePostDto post.LikePostDto
+func (r *router) likePost(c *gin.Context) {
+	likePostDto, err := likePostDtoFromRequest(c)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
 
-	if err := BindBody(&likePostDto, c); err != nil {
+	likes, err := r.postUsecases.LikePost(contextWithReqInfo(c), likePostDto)
+	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
 	}
+
+	OkResponse(likes).Reply(c)
+}
+
+func (r *router) unlikePost(c *gin.Context) {
+	likePostDto, err := likePostDtoFromRequest(c)
 	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
 	}
 
-	err = r.postUsecases.LikePost(c, postId, likePostDto)
+	likes, err := r.postUsecases.UnlikePost(contextWithReqInfo(c), likePostDto)
 	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
 	}
 
-	OkResponse(nil).Reply(c)
+	OkResponse(likes).Reply(c)
+}
+
+func likePostDtoFromRequest(c *gin.Context) (post.LikePostDto, error) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return post.LikePostDto{}, errors.New(errors.BadRequestError, err.Error())
+	}
+
+	reqInfo := GetReqInfo(c)
+
+	return post.LikePostDto{
+		PostId:    postId,
+		UserId:    reqInfo.UserId,
+		VisitorId: reqInfo.VisitorId,
+	}, nil
 }
 
 func (r *router) getTotalLikesCountByUser(c *gin.Context) {
@@ -184,13 +336,47 @@ func (r *router) login(c *gin.Context) {
 func (r *router) authenticate(c *gin.Context) {
 	token := c.Request.Header.Get("Authorization")
 
-	userId, err := r.authService.VerifyAccessToken(token)
+	claims, err := r.authService.VerifyAccessToken(token)
 	if err != nil {
 		response := ErrorResponse(err, nil, r.config.DetailedError())
 		c.AbortWithStatusJSON(response.Status, response)
+		return
+	}
+
+	setUserId(c, claims.UserId)
+	setRole(c, string(claims.Role))
+}
+
+// authorize lets through only users holding one of the roles. It must run
+// after authenticate.
+func (r *router) authorize(roles ...user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := user.Role(GetReqInfo(c).Role)
+		if !role.In(roles...) {
+			err := errors.New(errors.ForbiddenError, "")
+			response := ErrorResponse(err, nil, r.config.DetailedError())
+			c.AbortWithStatusJSON(response.Status, response)
+		}
+	}
+}
+
+// identify resolves the user from the access token when one is sent, so
+// routes open to anonymous visitors can still recognize registered users.
+func (r *router) identify(c *gin.Context) {
+	token := c.Request.Header.Get("Authorization")
+	if token == "" {
+		return
 	}
 
-	setUserId(c, userId)
+	claims, err := r.authService.VerifyAccessToken(token)
+	if err != nil {
+		response := ErrorResponse(err, nil, r.config.DetailedError())
+		c.AbortWithStatusJSON(response.Status, response)
+		return
+	}
+
+	setUserId(c, claims.UserId)
+	setRole(c, string(claims.Role))
 }
 
 func (r *router) addUser(c *gin.Context) {
@@ -210,6 +396,57 @@ func (r *router) addUser(c *gin.Context) {
 	OkResponse(user).Reply(c)
 }
 
+func (r *router) verifyEmail(c *gin.Context) {
+	var verifyEmailDto user.VerifyEmailDto
+
+	if err := BindBody(&verifyEmailDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	err := r.userUsecases.VerifyEmail(contextWithReqInfo(c), verifyEmailDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) requestPasswordReset(c *gin.Context) {
+	var requestPasswordResetDto user.RequestPasswordResetDto
+
+	if err := BindBody(&requestPasswordResetDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	err := r.userUsecases.RequestPasswordReset(contextWithReqInfo(c), requestPasswordResetDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) resetPassword(c *gin.Context) {
+	var resetPasswordDto user.ResetPasswordDto
+
+	if err := BindBody(&resetPasswordDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	err := r.userUsecases.ResetPassword(contextWithReqInfo(c), resetPasswordDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
 func (r *router) updateMe(c *gin.Context) {
 	var updateUserDto user.UpdateUserDto
 
@@ -250,6 +487,31 @@ func (r *router) changeMyPassword(c *gin.Context) {
 	OkResponse(nil).Reply(c)
 }
 
+func (r *router) changeUserRole(c *gin.Context) {
+	var changeUserRoleDto user.ChangeUserRoleDto
+
+	userId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	if err := BindBody(&changeUserRoleDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	changeUserRoleDto.Id = userId
+
+	err = r.userUsecases.ChangeRole(contextWithReqInfo(c), changeUserRoleDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
 func (r *router) getAllUsers(c *gin.Context) {
 	users, err := r.userUsecases.GetAllUsers(contextWithReqInfo(c))
 	if err != nil {
@@ -279,7 +541,16 @@ func (r *router) getPostById(c *gin.Context) {
 		return
 	}
 
-	post, err := r.postUsecases.GetPostById(c, postId)
+	reqInfo := GetReqInfo(c)
+
+	viewPostDto := post.ViewPostDto{
+		PostId:    postId,
+		UserId:    reqInfo.UserId,
+		UserRole:  user.Role(reqInfo.Role),
+		VisitorId: reqInfo.VisitorId,
+	}
+
+	post, err := r.postUsecases.ViewPost(contextWithReqInfo(c), viewPostDto)
 	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
@@ -288,16 +559,43 @@ func (r *router) getPostById(c *gin.Context) {
 	OkResponse(post).Reply(c)
 }
 
+// getPostBySlug replies with the post or, for a slug the post had before,
+// with the slug to redirect to.
+func (r *router) getPostBySlug(c *gin.Context) {
+	reqInfo := GetReqInfo(c)
+
+	viewPostBySlugDto := post.ViewPostBySlugDto{
+		Slug:      c.Param("slug"),
+		UserId:    reqInfo.UserId,
+		UserRole:  user.Role(reqInfo.Role),
+		VisitorId: reqInfo.VisitorId,
+	}
+
+	postBySlug, err := r.postUsecases.ViewPostBySlug(contextWithReqInfo(c), viewPostBySlugDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(postBySlug).Reply(c)
+}
+
 func (r *router) getMyPosts(c *gin.Context) {
+	listPostsDto, err := bindListPosts(c)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
 	reqInfo := GetReqInfo(c)
 
-	posts, err := r.postUsecases.GetMyPosts(c, reqInfo.UserId)
+	page, err := r.postUsecases.GetMyPosts(c, reqInfo.UserId, listPostsDto)
 	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
 	}
 
-	OkResponse(posts).Reply(c)
+	PageResponse(page.Posts, page.NextCursor).Reply(c)
 }
 
 func (r *router) updatePost(c *gin.Context) {
@@ -309,14 +607,17 @@ func (r *router) updatePost(c *gin.Context) {
 		return
 	}
 
-	updatePostDto.Id = postId
-
 	if err := BindBody(&updatePostDto, c); err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
 	}
 
-	err = r.postUsecases.UpdatePost(c, updatePostDto)
+	reqInfo := GetReqInfo(c)
+	updatePostDto.Id = postId
+	updatePostDto.UserId = reqInfo.UserId
+	updatePostDto.UserRole = user.Role(reqInfo.Role)
+
+	err = r.postUsecases.UpdatePost(contextWithReqInfo(c), updatePostDto)
 	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
@@ -326,23 +627,59 @@ func (r *router) updatePost(c *gin.Context) {
 }
 
 func (r *router) getPublishedPosts(c *gin.Context) {
-	posts, err := r.postUsecases.GetPublishedPosts(c)
+	listPostsDto, err := bindListPosts(c)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	page, err := r.postUsecases.GetPublishedPosts(c, listPostsDto)
 	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
 	}
 
-	OkResponse(posts).Reply(c)
+	PageResponse(page.Posts, page.NextCursor).Reply(c)
 }
 
 func (r *router) getPosts(c *gin.Context) {
-	posts, err := r.postUsecases.GetPosts(c)
+	listPostsDto, err := bindListPosts(c)
 	if err != nil {
 		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
 		return
 	}
 
-	OkResponse(posts).Reply(c)
+	page, err := r.postUsecases.GetPosts(c, listPostsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	PageResponse(page.Posts, page.NextCursor).Reply(c)
+}
+
+// bindListPosts reads filters, sorting and the cursor of a post listing from
+// the query string.
+func bindListPosts(c *gin.Context) (dto post.ListPostsDto, err error) {
+	if dto.CategoryId, err = QueryInt64(c, "category_id"); err != nil {
+		return dto, err
+	}
+	if dto.AuthorId, err = QueryInt64(c, "author_id"); err != nil {
+		return dto, err
+	}
+	if dto.Limit, err = QueryUint(c, "limit"); err != nil {
+		return dto, err
+	}
+
+	dto.State = post.State(c.Query("state"))
+	dto.Tag = c.Query("tag")
+	dto.From = c.Query("from")
+	dto.To = c.Query("to")
+	dto.Sort = post.SortField(c.Query("sort"))
+	dto.Order = post.SortOrder(c.Query("order"))
+	dto.Cursor = c.Query("cursor")
+
+	return dto, nil
 }
 
 func (r *router) methodNotFound(c *gin.Context) {
@@ -368,6 +705,17 @@ func (r *router) trace() gin.HandlerFunc {
 	}
 }
 
+// visitor picks up the identity the frontend generates for anonymous
+// readers, so their likes and views can be deduplicated.
+func (r *router) visitor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		visitorId := c.Request.Header.Get("Visitor-Id")
+		if visitorId != "" {
+			setVisitorId(c, visitorId)
+		}
+	}
+}
+
 func (r *router) logger() gin.HandlerFunc {
 	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
 		var parsedReqInfo request.RequestInfo
@@ -399,10 +747,41 @@ func BindBody(payload interface{}, c *gin.Context) error {
 	return nil
 }
 
+func QueryUint(c *gin.Context, key string) (uint, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, errors.Errorf(errors.BadRequestError, "query parameter \"%s\" must be a positive number", key)
+	}
+
+	return uint(parsed), nil
+}
+
+func QueryInt64(c *gin.Context, key string) (int64, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || parsed < 0 {
+		return 0, errors.Errorf(errors.BadRequestError, "query parameter \"%s\" must be a positive number", key)
+	}
+
+	return parsed, nil
+}
+
 type Response struct {
 	Status  int         `json:"status"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+	// NextCursor is set on paginated responses only. It is empty on the last
+	// page.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 func OkResponse(data interface{}) *Response {
@@ -413,6 +792,15 @@ func OkResponse(data interface{}) *Response {
 	}
 }
 
+func PageResponse(data interface{}, nextCursor string) *Response {
+	return &Response{
+		Status:     http.StatusOK,
+		Message:    "ok",
+		Data:       data,
+		NextCursor: &nextCursor,
+	}
+}
+
 func InternalErrorResponse(data interface{}) *Response {
 	status, message := http.StatusInternalServerError, "internal error"
 
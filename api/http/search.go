@@ -0,0 +1,42 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/search"
+)
+
+func (r *router) searchPosts(c *gin.Context) {
+	categoryId, err := QueryInt64(c, "category_id")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	offset, err := QueryUint(c, "offset")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	searchPostsDto := search.SearchPostsDto{
+		Query:      c.Query("q"),
+		CategoryId: categoryId,
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	results, err := r.searchUsecases.SearchPosts(contextWithReqInfo(c), searchPostsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(results).Reply(c)
+}
@@ -8,14 +8,28 @@ import (
 	"fibo/api/http/postcontroller"
 	"fibo/internal/auth"
 	"fibo/internal/base/crypto"
+	"fibo/internal/bookmark"
 	"fibo/internal/category"
+	"fibo/internal/comment"
+	"fibo/internal/follow"
+	"fibo/internal/media"
+	"fibo/internal/notification"
+	"fibo/internal/payroll"
 	"fibo/internal/post"
+	"fibo/internal/report"
+	"fibo/internal/search"
+	"fibo/internal/share"
+	"fibo/internal/sitemap"
+	"fibo/internal/tag"
 	"fibo/internal/user"
 )
 
 type Config interface {
 	DetailedError() bool
 	Address() string
+	// PublicURL is the address of the site readers open, without a trailing
+	// slash. Feeds and sitemaps link to pages under it.
+	PublicURL() string
 }
 
 type ServerOpts struct {
@@ -26,20 +40,42 @@ type ServerOpts struct {
 	Post           post.PostUseCase
 	Category       category.CatUseCase
 	PostController postcontroller.PostController
+	Comment        comment.CommentUsecases
+	Payroll        payroll.PayrollUsecases
+	Search         search.SearchUsecases
+	Tag            tag.TagUsecases
+	Report         report.ReportUsecases
+	Sitemap        sitemap.SitemapUsecases
+	Share          share.ShareUsecases
+	Bookmark       bookmark.BookmarkUsecases
+	Follow         follow.FollowUsecases
+	Notification   notification.NotificationUsecases
+	Media          media.MediaUsecases
 }
 
 func NewServer(opts ServerOpts) *Server {
 	gin.SetMode(gin.ReleaseMode)
 
 	server := &Server{
-		engine:         gin.New(),
-		config:         opts.Config,
-		crypto:         opts.Crypto,
-		userUsecases:   opts.UserUsecases,
-		authService:    opts.AuthService,
-		postUsecases:   opts.Post,
-		catUsecases:    opts.Category,
-		postcontroller: opts.PostController,
+		engine:               gin.New(),
+		config:               opts.Config,
+		crypto:               opts.Crypto,
+		userUsecases:         opts.UserUsecases,
+		authService:          opts.AuthService,
+		postUsecases:         opts.Post,
+		catUsecases:          opts.Category,
+		postcontroller:       opts.PostController,
+		commentUsecases:      opts.Comment,
+		payrollUsecases:      opts.Payroll,
+		searchUsecases:       opts.Search,
+		tagUsecases:          opts.Tag,
+		reportUsecases:       opts.Report,
+		sitemapUsecases:      opts.Sitemap,
+		shareUsecases:        opts.Share,
+		bookmarkUsecases:     opts.Bookmark,
+		followUsecases:       opts.Follow,
+		notificationUsecases: opts.Notification,
+		mediaUsecases:        opts.Media,
 	}
 
 	initRouter(server)
@@ -48,14 +84,25 @@ func NewServer(opts ServerOpts) *Server {
 }
 
 type Server struct {
-	engine         *gin.Engine
-	config         Config
-	crypto         crypto.Crypto
-	userUsecases   user.UserUsecases
-	authService    auth.AuthService
-	postUsecases   post.PostUseCase
-	catUsecases    category.CatUseCase
-	postcontroller postcontroller.PostController
+	engine               *gin.Engine
+	config               Config
+	crypto               crypto.Crypto
+	userUsecases         user.UserUsecases
+	authService          auth.AuthService
+	postUsecases         post.PostUseCase
+	catUsecases          category.CatUseCase
+	postcontroller       postcontroller.PostController
+	commentUsecases      comment.CommentUsecases
+	payrollUsecases      payroll.PayrollUsecases
+	searchUsecases       search.SearchUsecases
+	tagUsecases          tag.TagUsecases
+	reportUsecases       report.ReportUsecases
+	sitemapUsecases      sitemap.SitemapUsecases
+	shareUsecases        share.ShareUsecases
+	bookmarkUsecases     bookmark.BookmarkUsecases
+	followUsecases       follow.FollowUsecases
+	notificationUsecases notification.NotificationUsecases
+	mediaUsecases        media.MediaUsecases
 }
 
 func (s Server) Listen() error {
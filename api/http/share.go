@@ -0,0 +1,77 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/share"
+	"fibo/internal/user"
+)
+
+func (r *router) getPostShare(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	shareDto, err := r.shareUsecases.GetShare(contextWithReqInfo(c), postId)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(shareDto).Reply(c)
+}
+
+// sharePost records a click on a share button of the post.
+func (r *router) sharePost(c *gin.Context) {
+	var addShareDto share.AddShareDto
+
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	if err := BindBody(&addShareDto, c); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	addShareDto.PostId = postId
+	addShareDto.UserId = reqInfo.UserId
+	addShareDto.VisitorId = reqInfo.VisitorId
+
+	if err := r.shareUsecases.AddShare(contextWithReqInfo(c), addShareDto); err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(nil).Reply(c)
+}
+
+func (r *router) getPostShareStats(c *gin.Context) {
+	postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	reqInfo := GetReqInfo(c)
+	getShareStatsDto := share.GetShareStatsDto{
+		PostId:   postId,
+		UserId:   reqInfo.UserId,
+		UserRole: user.Role(reqInfo.Role),
+	}
+
+	stats, err := r.shareUsecases.GetStats(contextWithReqInfo(c), getShareStatsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(stats).Reply(c)
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/sitemap"
+)
+
+const sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	NS      string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	NS       string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapURL `xml:"sitemap"`
+}
+
+func (r *router) getSitemap(c *gin.Context) {
+	r.replySitemap(c, sitemap.GetSitemapDto{})
+}
+
+// getSitemapFile serves a file of the sitemap index, "/sitemaps/1.xml" and
+// so on.
+func (r *router) getSitemapFile(c *gin.Context) {
+	page, err := strconv.Atoi(strings.TrimSuffix(c.Param("file"), ".xml"))
+	if err != nil || page < 1 {
+		err := errors.Errorf(errors.NotFoundError, "sitemap file \"%s\" not found", c.Param("file"))
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	r.replySitemap(c, sitemap.GetSitemapDto{Page: page})
+}
+
+func (r *router) replySitemap(c *gin.Context, getSitemapDto sitemap.GetSitemapDto) {
+	out, err := r.sitemapUsecases.GetSitemap(contextWithReqInfo(c), getSitemapDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	var body []byte
+	if out.IsIndex() {
+		index := sitemapIndex{NS: sitemapNS}
+		for _, file := range out.Files {
+			index.Sitemaps = append(index.Sitemaps, sitemapURL{
+				Loc:     fmt.Sprintf("%s/sitemaps/%d.xml", r.config.PublicURL(), file.Page),
+				LastMod: file.LastMod,
+			})
+		}
+		body, err = marshalXML(index)
+	} else {
+		urlSet := sitemapURLSet{NS: sitemapNS}
+		for _, url := range out.URLs {
+			urlSet.URLs = append(urlSet.URLs, sitemapURL{Loc: r.sitemapLoc(url), LastMod: url.LastMod})
+		}
+		body, err = marshalXML(urlSet)
+	}
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", body)
+}
+
+func (r *router) sitemapLoc(url sitemap.URLDto) string {
+	switch url.Kind {
+	case sitemap.KindCategory:
+		return r.categoryURL(url.Id)
+	case sitemap.KindAuthor:
+		return r.authorURL(url.Id)
+	default:
+		return r.postURL(url.Slug)
+	}
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/tag"
+)
+
+func (r *router) getTags(c *gin.Context) {
+	limit, err := QueryUint(c, "limit")
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	getTagsDto := tag.GetTagsDto{
+		Prefix: c.Query("prefix"),
+		Limit:  limit,
+	}
+
+	tags, err := r.tagUsecases.GetTags(contextWithReqInfo(c), getTagsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(tags).Reply(c)
+}
+
+func (r *router) getTag(c *gin.Context) {
+	tagDto, err := r.tagUsecases.GetTag(contextWithReqInfo(c), c.Param("slug"))
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	OkResponse(tagDto).Reply(c)
+}
+
+func (r *router) getTagPosts(c *gin.Context) {
+	listPostsDto, err := bindListPosts(c)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	// A tag nobody uses is a 404, not an empty page.
+	tagDto, err := r.tagUsecases.GetTag(contextWithReqInfo(c), c.Param("slug"))
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+	listPostsDto.Tag = tagDto.Slug
+
+	page, err := r.postUsecases.GetPublishedPosts(c, listPostsDto)
+	if err != nil {
+		ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+		return
+	}
+
+	PageResponse(page.Posts, page.NextCursor).Reply(c)
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"fibo/internal/post"
+	"fibo/internal/user"
+)
+
+type trashPostFunc func(ctx context.Context, dto post.DeletePostDto) error
+
+// trashPost builds a handler moving the post from the path to the trash or
+// back on behalf of the logged user.
+func (r *router) trashPost(move trashPostFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		reqInfo := GetReqInfo(c)
+		deletePostDto := post.DeletePostDto{
+			PostId:   postId,
+			UserId:   reqInfo.UserId,
+			UserRole: user.Role(reqInfo.Role),
+		}
+
+		err = move(contextWithReqInfo(c), deletePostDto)
+		if err != nil {
+			ErrorResponse(err, nil, r.config.DetailedError()).Reply(c)
+			return
+		}
+
+		OkResponse(nil).Reply(c)
+	}
+}
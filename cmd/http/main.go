@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"log"
+	"os"
+	"time"
 
 	"fibo/api/cli"
 	"fibo/api/http"
@@ -10,8 +12,24 @@ import (
 	authImpl "fibo/internal/auth/impl"
 	cryptoImpl "fibo/internal/base/crypto/impl"
 	databaseImpl "fibo/internal/base/database/impl"
+	eventImpl "fibo/internal/base/event/impl"
+	bookmarkImpl "fibo/internal/bookmark/impl"
 	categoryImpl "fibo/internal/category/impl"
+	commentImpl "fibo/internal/comment/impl"
+	followImpl "fibo/internal/follow/impl"
+	"fibo/internal/mail"
+	mailImpl "fibo/internal/mail/impl"
+	mediaImpl "fibo/internal/media/impl"
+	notificationImpl "fibo/internal/notification/impl"
+	payrollImpl "fibo/internal/payroll/impl"
+	"fibo/internal/post"
 	postImpl "fibo/internal/post/impl"
+	reportImpl "fibo/internal/report/impl"
+	reputationImpl "fibo/internal/reputation/impl"
+	searchImpl "fibo/internal/search/impl"
+	shareImpl "fibo/internal/share/impl"
+	sitemapImpl "fibo/internal/sitemap/impl"
+	tagImpl "fibo/internal/tag/impl"
 	userImpl "fibo/internal/user/impl"
 )
 
@@ -49,22 +67,59 @@ func main() {
 	}
 	authService := authImpl.NewAuthService(authServiceOpts)
 
+	reputationRepositoryOpts := reputationImpl.ReputationRepositoryOpts{
+		ConnManager: dbService,
+	}
+	reputationRepository := reputationImpl.NewReputationRepository(reputationRepositoryOpts)
+
+	events := eventImpl.NewBus()
+
 	userUsecasesOpts := userImpl.UserUsecasesOpts{
-		TxManager:      dbService,
-		UserRepository: userRepository,
-		Crypto:         crypto,
+		TxManager:            dbService,
+		Events:               events,
+		UserRepository:       userRepository,
+		ReputationRepository: reputationRepository,
+		Crypto:               crypto,
+		Config:               conf.User(),
 	}
 	userUsecases := userImpl.NewUserUsecases(userUsecasesOpts)
 
+	tagRepositoryOpts := tagImpl.TagRepositoryOpts{
+		ConnManager: dbService,
+	}
+	tagRepository := tagImpl.NewTagRepository(tagRepositoryOpts)
+
+	tagUsecasesOpts := tagImpl.TagUsecasesOpts{
+		TagRepository: tagRepository,
+	}
+	tagUsecases := tagImpl.NewTagUsecases(tagUsecasesOpts)
+
 	postRepositoryOpts := postImpl.PostRepositoryOpts{
 		ConnManager: dbService,
 	}
 
 	postRepository := postImpl.NewPostRepository(postRepositoryOpts)
 
+	notificationRepositoryOpts := notificationImpl.NotificationRepositoryOpts{
+		ConnManager: dbService,
+	}
+	notificationRepository := notificationImpl.NewNotificationRepository(notificationRepositoryOpts)
+
+	// Subscribed before anything publishes, commands included.
+	notificationUsecasesOpts := notificationImpl.NotificationUsecasesOpts{
+		TxManager:              dbService,
+		Events:                 events,
+		NotificationRepository: notificationRepository,
+	}
+	notificationUsecases := notificationImpl.NewNotificationUsecases(notificationUsecasesOpts)
+
 	postUsecasesOpts := postImpl.PostUsecaseOpts{
-		PostRepository: postRepository,
-		TxManager:      dbService,
+		PostRepository:       postRepository,
+		ReputationRepository: reputationRepository,
+		TagRepository:        tagRepository,
+		TxManager:            dbService,
+		Events:               events,
+		Config:               conf.Post(),
 	}
 
 	postUsecases := postImpl.NewPostUsecase(postUsecasesOpts)
@@ -89,6 +144,170 @@ func main() {
 
 	postController := postControllerImpl.NewPostController(postControllerOpts)
 
+	commentRepositoryOpts := commentImpl.CommentRepositoryOpts{
+		ConnManager: dbService,
+	}
+	commentRepository := commentImpl.NewCommentRepository(commentRepositoryOpts)
+
+	commentUsecasesOpts := commentImpl.CommentUsecasesOpts{
+		TxManager:            dbService,
+		CommentRepository:    commentRepository,
+		PostRepository:       postRepository,
+		ReputationRepository: reputationRepository,
+		Events:               events,
+	}
+	commentUsecases := commentImpl.NewCommentUsecases(commentUsecasesOpts)
+
+	payrollRepositoryOpts := payrollImpl.PayrollRepositoryOpts{
+		ConnManager: dbService,
+	}
+	payrollRepository := payrollImpl.NewPayrollRepository(payrollRepositoryOpts)
+
+	payrollUsecasesOpts := payrollImpl.PayrollUsecasesOpts{
+		TxManager:         dbService,
+		PayrollRepository: payrollRepository,
+		Config:            conf.Payroll(),
+		Events:            events,
+	}
+	payrollUsecases := payrollImpl.NewPayrollUsecases(payrollUsecasesOpts)
+
+	searchRepositoryOpts := searchImpl.SearchRepositoryOpts{
+		ConnManager: dbService,
+	}
+	searchRepository := searchImpl.NewSearchRepository(searchRepositoryOpts)
+
+	searchUsecasesOpts := searchImpl.SearchUsecasesOpts{
+		SearchRepository: searchRepository,
+	}
+	searchUsecases := searchImpl.NewSearchUsecases(searchUsecasesOpts)
+
+	reportRepositoryOpts := reportImpl.ReportRepositoryOpts{
+		ConnManager: dbService,
+	}
+	reportRepository := reportImpl.NewReportRepository(reportRepositoryOpts)
+
+	reportUsecasesOpts := reportImpl.ReportUsecasesOpts{
+		Config:               conf.Report(),
+		TxManager:            dbService,
+		Events:               events,
+		ReportRepository:     reportRepository,
+		ReputationRepository: reputationRepository,
+	}
+	reportUsecases := reportImpl.NewReportUsecases(reportUsecasesOpts)
+
+	sitemapUsecasesOpts := sitemapImpl.SitemapUsecasesOpts{
+		Config:             conf.Sitemap(),
+		Events:             events,
+		PostRepository:     postRepository,
+		CategoryRepository: catRepository,
+	}
+	sitemapUsecases := sitemapImpl.NewSitemapUsecases(sitemapUsecasesOpts)
+
+	shareRepositoryOpts := shareImpl.ShareRepositoryOpts{
+		ConnManager: dbService,
+	}
+	shareRepository := shareImpl.NewShareRepository(shareRepositoryOpts)
+
+	shareUsecasesOpts := shareImpl.ShareUsecasesOpts{
+		Config:          conf.Share(),
+		ShareRepository: shareRepository,
+		PostRepository:  postRepository,
+		UserRepository:  userRepository,
+	}
+	shareUsecases := shareImpl.NewShareUsecases(shareUsecasesOpts)
+
+	bookmarkRepositoryOpts := bookmarkImpl.BookmarkRepositoryOpts{
+		ConnManager: dbService,
+	}
+	bookmarkRepository := bookmarkImpl.NewBookmarkRepository(bookmarkRepositoryOpts)
+
+	bookmarkUsecasesOpts := bookmarkImpl.BookmarkUsecasesOpts{
+		TxManager:          dbService,
+		BookmarkRepository: bookmarkRepository,
+		PostRepository:     postRepository,
+	}
+	bookmarkUsecases := bookmarkImpl.NewBookmarkUsecases(bookmarkUsecasesOpts)
+
+	followRepositoryOpts := followImpl.FollowRepositoryOpts{
+		ConnManager: dbService,
+	}
+	followRepository := followImpl.NewFollowRepository(followRepositoryOpts)
+
+	followUsecasesOpts := followImpl.FollowUsecasesOpts{
+		TxManager:        dbService,
+		FollowRepository: followRepository,
+		PostRepository:   postRepository,
+		Events:           events,
+	}
+	followUsecases := followImpl.NewFollowUsecases(followUsecasesOpts)
+
+	mailSender, err := mailImpl.NewSender(conf.MailSender())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	outboxRepositoryOpts := mailImpl.OutboxRepositoryOpts{
+		ConnManager: dbService,
+	}
+	outboxRepository := mailImpl.NewOutboxRepository(outboxRepositoryOpts)
+
+	// Subscribed before the commands run, they approve posts and payrolls too.
+	mailUsecasesOpts := mailImpl.MailUsecasesOpts{
+		Events:            events,
+		OutboxRepository:  outboxRepository,
+		Sender:            mailSender,
+		Config:            conf.Mail(),
+		UserConfig:        conf.User(),
+		Crypto:            crypto,
+		UserRepository:    userRepository,
+		PostRepository:    postRepository,
+		PayrollRepository: payrollRepository,
+	}
+	mailUsecases := mailImpl.NewMailUsecases(mailUsecasesOpts)
+
+	mediaStorage, err := mediaImpl.NewStorage(conf.MediaStorage())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mediaRepositoryOpts := mediaImpl.MediaRepositoryOpts{
+		ConnManager: dbService,
+	}
+	mediaRepository := mediaImpl.NewMediaRepository(mediaRepositoryOpts)
+
+	mediaUsecasesOpts := mediaImpl.MediaUsecasesOpts{
+		TxManager:       dbService,
+		MediaRepository: mediaRepository,
+		Storage:         mediaStorage,
+		Config:          conf.Media(),
+	}
+	mediaUsecases := mediaImpl.NewMediaUsecases(mediaUsecasesOpts)
+
+	if parser.IsPayroll() {
+		if err := parser.RunPayroll(ctx, payrollUsecases, os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if parser.IsPosts() {
+		if err := parser.RunPosts(ctx, postUsecases, os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if parser.IsReputation() {
+		if err := parser.RunReputation(ctx, userUsecases, os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	go flushPostViews(ctx, postUsecases, conf.Post().ViewFlushInterval())
+	go publishScheduledPosts(ctx, postUsecases, conf.Post().PublishInterval())
+	go dispatchMails(ctx, mailUsecases, conf.Mail().DispatchInterval())
+
 	serverOpts := http.ServerOpts{
 		UserUsecases:   userUsecases,
 		AuthService:    authService,
@@ -97,8 +316,74 @@ func main() {
 		Post:           postUsecases,
 		Category:       catUsecases,
 		PostController: postController,
+		Comment:        commentUsecases,
+		Payroll:        payrollUsecases,
+		Search:         searchUsecases,
+		Tag:            tagUsecases,
+		Report:         reportUsecases,
+		Sitemap:        sitemapUsecases,
+		Share:          shareUsecases,
+		Bookmark:       bookmarkUsecases,
+		Follow:         followUsecases,
+		Notification:   notificationUsecases,
+		Media:          mediaUsecases,
 	}
 	server := http.NewServer(serverOpts)
 
 	log.Fatal(server.Listen())
 }
+
+// flushPostViews writes buffered post views to the database until the
+// context is done.
+func flushPostViews(ctx context.Context, postUsecases post.PostUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := postUsecases.FlushViews(ctx); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// publishScheduledPosts publishes scheduled posts as they come due until the
+// context is done. Every replica runs it, the posts are claimed with row
+// locks so each goes live once.
+func publishScheduledPosts(ctx context.Context, postUsecases post.PostUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := postUsecases.PublishScheduled(ctx); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// dispatchMails sends the emails waiting in the outbox until the context is
+// done. Failed emails are retried by later runs.
+func dispatchMails(ctx context.Context, mailUsecases mail.MailUsecases, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := mailUsecases.Dispatch(ctx); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
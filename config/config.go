@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -10,6 +11,14 @@ import (
 	"fibo/api/http"
 	"fibo/internal/auth"
 	"fibo/internal/base/database"
+	"fibo/internal/mail"
+	"fibo/internal/media"
+	"fibo/internal/payroll"
+	"fibo/internal/post"
+	"fibo/internal/report"
+	"fibo/internal/share"
+	"fibo/internal/sitemap"
+	"fibo/internal/user"
 )
 
 // Config
@@ -18,11 +27,43 @@ type Config struct {
 	HttpHost          string `envconfig:"HTTP_HOST"`
 	HttpPort          int    `envconfig:"HTTP_PORT"`
 	HttpDetailedError bool   `envconfig:"HTTP_DETAILED_ERROR"`
+	HttpPublicURL     string `envconfig:"HTTP_PUBLIC_URL" default:"http://localhost:3005"`
 
 	DatabaseURL string `envconfig:"DATABASE_URL"`
 
 	AccessTokenExpiresTTL int    `envconfig:"ACCESS_TOKEN_EXPIRES_TTL"`
 	AccessTokenSecret     string `envconfig:"ACCESS_TOKEN_SECRET"`
+	UserTokenSecret       string `envconfig:"USER_TOKEN_SECRET"`
+
+	MailSenderType      string `envconfig:"MAIL_SENDER" default:"log"`
+	MailFrom            string `envconfig:"MAIL_FROM" default:"Fibo <no-reply@localhost>"`
+	MailDir             string `envconfig:"MAIL_DIR" default:"mails"`
+	MailDispatchSeconds int    `envconfig:"MAIL_DISPATCH_SECONDS" default:"10"`
+	SMTPHost            string `envconfig:"SMTP_HOST"`
+	SMTPPort            int    `envconfig:"SMTP_PORT" default:"587"`
+	SMTPUsername        string `envconfig:"SMTP_USERNAME"`
+	SMTPPassword        string `envconfig:"SMTP_PASSWORD"`
+
+	MediaStorageType string `envconfig:"MEDIA_STORAGE" default:"local"`
+	MediaDir         string `envconfig:"MEDIA_DIR" default:"uploads"`
+	MediaMaxMB       int64  `envconfig:"MEDIA_MAX_MB" default:"10"`
+	MediaPublicURL   string `envconfig:"MEDIA_PUBLIC_URL"`
+	S3Endpoint       string `envconfig:"S3_ENDPOINT"`
+	S3Region         string `envconfig:"S3_REGION" default:"us-east-1"`
+	S3Bucket         string `envconfig:"S3_BUCKET"`
+	S3AccessKey      string `envconfig:"S3_ACCESS_KEY"`
+	S3SecretKey      string `envconfig:"S3_SECRET_KEY"`
+
+	PayrollTiers string `envconfig:"PAYROLL_TIERS" default:"0:100,50:150,200:200"`
+
+	PostRetentionDays    int `envconfig:"POST_RETENTION_DAYS" default:"30"`
+	PostViewWindowHours  int `envconfig:"POST_VIEW_WINDOW_HOURS" default:"24"`
+	PostViewFlushSeconds int `envconfig:"POST_VIEW_FLUSH_SECONDS" default:"10"`
+	PostPublishSeconds   int `envconfig:"POST_PUBLISH_SECONDS" default:"30"`
+
+	ReportHideThreshold int64 `envconfig:"REPORT_HIDE_THRESHOLD" default:"5"`
+
+	SitemapCacheMinutes int `envconfig:"SITEMAP_CACHE_MINUTES" default:"60"`
 }
 
 func ParseEnv(envPath string) (*Config, error) {
@@ -47,6 +88,7 @@ func (c *Config) HTTP() http.Config {
 		host:          c.HttpHost,
 		port:          c.HttpPort,
 		detailedError: c.HttpDetailedError,
+		publicURL:     strings.TrimRight(c.HttpPublicURL, "/"),
 	}
 }
 
@@ -63,12 +105,97 @@ func (c *Config) Auth() auth.Config {
 	}
 }
 
+func (c *Config) User() user.Config {
+	return &userConfig{
+		tokenSecret: c.UserTokenSecret,
+	}
+}
+
+func (c *Config) Mail() mail.Config {
+	return &mailConfig{
+		publicURL:       strings.TrimRight(c.HttpPublicURL, "/"),
+		dispatchSeconds: c.MailDispatchSeconds,
+	}
+}
+
+func (c *Config) MailSender() mail.SenderConfig {
+	return &mailSenderConfig{
+		sender:       c.MailSenderType,
+		from:         c.MailFrom,
+		dir:          c.MailDir,
+		smtpHost:     c.SMTPHost,
+		smtpPort:     c.SMTPPort,
+		smtpUsername: c.SMTPUsername,
+		smtpPassword: c.SMTPPassword,
+	}
+}
+
+// Media files are served by the API under "/uploads" unless MEDIA_PUBLIC_URL
+// points elsewhere, such as a CDN in front of the bucket.
+func (c *Config) Media() media.Config {
+	publicURL := c.MediaPublicURL
+	if publicURL == "" {
+		publicURL = strings.TrimRight(c.HttpPublicURL, "/") + "/uploads"
+	}
+
+	return &mediaConfig{
+		maxMB:     c.MediaMaxMB,
+		publicURL: strings.TrimRight(publicURL, "/"),
+	}
+}
+
+func (c *Config) MediaStorage() media.StorageConfig {
+	return &mediaStorageConfig{
+		storage:     c.MediaStorageType,
+		dir:         c.MediaDir,
+		s3Endpoint:  c.S3Endpoint,
+		s3Region:    c.S3Region,
+		s3Bucket:    c.S3Bucket,
+		s3AccessKey: c.S3AccessKey,
+		s3SecretKey: c.S3SecretKey,
+	}
+}
+
+func (c *Config) Payroll() payroll.Config {
+	return &payrollConfig{
+		tiers: c.PayrollTiers,
+	}
+}
+
+func (c *Config) Post() post.Config {
+	return &postConfig{
+		retentionDays:    c.PostRetentionDays,
+		viewWindowHours:  c.PostViewWindowHours,
+		viewFlushSeconds: c.PostViewFlushSeconds,
+		publishSeconds:   c.PostPublishSeconds,
+	}
+}
+
+func (c *Config) Report() report.Config {
+	return &reportConfig{
+		hideThreshold: c.ReportHideThreshold,
+	}
+}
+
+func (c *Config) Share() share.Config {
+	return &shareConfig{
+		publicURL: strings.TrimRight(c.HttpPublicURL, "/"),
+	}
+}
+
+func (c *Config) Sitemap() sitemap.Config {
+	return &sitemapConfig{
+		cacheMinutes: c.SitemapCacheMinutes,
+	}
+}
+
 // HTTP
 
 type httpConfig struct {
 	host          string
 	port          int
 	detailedError bool
+	publicURL     string
 }
 
 func (c *httpConfig) Address() string {
@@ -79,6 +206,10 @@ func (c *httpConfig) DetailedError() bool {
 	return c.detailedError
 }
 
+func (c *httpConfig) PublicURL() string {
+	return c.publicURL
+}
+
 // Database
 
 type databaseConfig struct {
@@ -104,3 +235,184 @@ func (c *authConfig) AccessTokenExpiresDate() time.Time {
 	duration := time.Duration(c.accessTokenExpiresTTL)
 	return time.Now().UTC().Add(time.Minute * duration)
 }
+
+// User
+
+type userConfig struct {
+	tokenSecret string
+}
+
+func (c *userConfig) TokenSecret() string {
+	return c.tokenSecret
+}
+
+// Mail
+
+type mailConfig struct {
+	publicURL       string
+	dispatchSeconds int
+}
+
+func (c *mailConfig) PublicURL() string {
+	return c.publicURL
+}
+
+func (c *mailConfig) DispatchInterval() time.Duration {
+	return time.Second * time.Duration(c.dispatchSeconds)
+}
+
+type mailSenderConfig struct {
+	sender       string
+	from         string
+	dir          string
+	smtpHost     string
+	smtpPort     int
+	smtpUsername string
+	smtpPassword string
+}
+
+func (c *mailSenderConfig) Sender() string {
+	return c.sender
+}
+
+func (c *mailSenderConfig) From() string {
+	return c.from
+}
+
+func (c *mailSenderConfig) Dir() string {
+	return c.dir
+}
+
+func (c *mailSenderConfig) SMTPHost() string {
+	return c.smtpHost
+}
+
+func (c *mailSenderConfig) SMTPPort() int {
+	return c.smtpPort
+}
+
+func (c *mailSenderConfig) SMTPUsername() string {
+	return c.smtpUsername
+}
+
+func (c *mailSenderConfig) SMTPPassword() string {
+	return c.smtpPassword
+}
+
+// Media
+
+type mediaConfig struct {
+	maxMB     int64
+	publicURL string
+}
+
+func (c *mediaConfig) MaxSize() int64 {
+	return c.maxMB << 20
+}
+
+func (c *mediaConfig) PublicURL() string {
+	return c.publicURL
+}
+
+type mediaStorageConfig struct {
+	storage     string
+	dir         string
+	s3Endpoint  string
+	s3Region    string
+	s3Bucket    string
+	s3AccessKey string
+	s3SecretKey string
+}
+
+func (c *mediaStorageConfig) Storage() string {
+	return c.storage
+}
+
+func (c *mediaStorageConfig) Dir() string {
+	return c.dir
+}
+
+func (c *mediaStorageConfig) S3Endpoint() string {
+	return c.s3Endpoint
+}
+
+func (c *mediaStorageConfig) S3Region() string {
+	return c.s3Region
+}
+
+func (c *mediaStorageConfig) S3Bucket() string {
+	return c.s3Bucket
+}
+
+func (c *mediaStorageConfig) S3AccessKey() string {
+	return c.s3AccessKey
+}
+
+func (c *mediaStorageConfig) S3SecretKey() string {
+	return c.s3SecretKey
+}
+
+// Payroll
+
+type payrollConfig struct {
+	tiers string
+}
+
+func (c *payrollConfig) Tiers() string {
+	return c.tiers
+}
+
+// Post
+
+type postConfig struct {
+	retentionDays    int
+	viewWindowHours  int
+	viewFlushSeconds int
+	publishSeconds   int
+}
+
+func (c *postConfig) RetentionPeriod() time.Duration {
+	return time.Hour * 24 * time.Duration(c.retentionDays)
+}
+
+func (c *postConfig) ViewWindow() time.Duration {
+	return time.Hour * time.Duration(c.viewWindowHours)
+}
+
+func (c *postConfig) ViewFlushInterval() time.Duration {
+	return time.Second * time.Duration(c.viewFlushSeconds)
+}
+
+func (c *postConfig) PublishInterval() time.Duration {
+	return time.Second * time.Duration(c.publishSeconds)
+}
+
+// Report
+
+type reportConfig struct {
+	hideThreshold int64
+}
+
+func (c *reportConfig) HideThreshold() int64 {
+	return c.hideThreshold
+}
+
+// Share
+
+type shareConfig struct {
+	publicURL string
+}
+
+func (c *shareConfig) PublicURL() string {
+	return c.publicURL
+}
+
+// Sitemap
+
+type sitemapConfig struct {
+	cacheMinutes int
+}
+
+func (c *sitemapConfig) CacheTTL() time.Duration {
+	return time.Minute * time.Duration(c.cacheMinutes)
+}
@@ -17,7 +17,15 @@ func (dto LoggedUserDto) MapFromModel(model user.UserModel, token string) Logged
 	dto.FirstName = model.FirstName
 	dto.LastName = model.LastName
 	dto.Email = model.Email
+	dto.Reputation = model.Reputation
+	dto.Role = model.Role
 	dto.Token = token
 
 	return dto
 }
+
+// AccessTokenClaims is what the access token tells about its holder.
+type AccessTokenClaims struct {
+	UserId int64
+	Role   user.Role
+}
@@ -37,7 +37,7 @@ func (u *authService) Login(ctx context.Context, in auth.LoginUserDto) (out auth
 	if !user.ComparePassword(in.Password, u.Crypto) {
 		return out, errors.New(errors.WrongCredentialsError, "")
 	}
-	token, err := u.generateAccessToken(user.Id)
+	token, err := u.generateAccessToken(user)
 	if err != nil {
 		return out, err
 	}
@@ -45,36 +45,26 @@ func (u *authService) Login(ctx context.Context, in auth.LoginUserDto) (out auth
 	return out.MapFromModel(user, token), nil
 }
 
-func (u *authService) VerifyAccessToken(accessToken string) (int64, error) {
+func (u *authService) VerifyAccessToken(accessToken string) (auth.AccessTokenClaims, error) {
 	payload, err := u.ParseAndValidateJWT(accessToken, u.AccessTokenSecret())
 	if err != nil {
-		return 0, errors.New(errors.UnauthorizedError, "")
+		return auth.AccessTokenClaims{}, errors.New(errors.UnauthorizedError, "")
 	}
 
-	userId, ok := payload["userId"].(float64)
-	if !ok {
-		return 0, errors.New(errors.UnauthorizedError, "")
-	}
-
-	return int64(userId), nil
+	return parseAccessTokenClaims(payload)
 }
 
-func (u *authService) ParseAccessToken(accessToken string) (int64, error) {
+func (u *authService) ParseAccessToken(accessToken string) (auth.AccessTokenClaims, error) {
 	payload, err := u.ParseJWT(accessToken, u.AccessTokenSecret())
 	if err != nil {
-		return 0, errors.New(errors.UnauthorizedError, "")
+		return auth.AccessTokenClaims{}, errors.New(errors.UnauthorizedError, "")
 	}
 
-	userId, ok := payload["userId"].(float64)
-	if !ok {
-		return 0, errors.New(errors.UnauthorizedError, "")
-	}
-
-	return int64(userId), nil
+	return parseAccessTokenClaims(payload)
 }
 
-func (u *authService) generateAccessToken(userId int64) (string, error) {
-	payload := map[string]interface{}{"userId": userId}
+func (u *authService) generateAccessToken(model user.UserModel) (string, error) {
+	payload := map[string]interface{}{"userId": model.Id, "role": string(model.Role)}
 
 	return u.GenerateJWT(
 		payload,
@@ -82,3 +72,21 @@ func (u *authService) generateAccessToken(userId int64) (string, error) {
 		u.AccessTokenExpiresDate(),
 	)
 }
+
+func parseAccessTokenClaims(payload map[string]interface{}) (auth.AccessTokenClaims, error) {
+	userId, ok := payload["userId"].(float64)
+	if !ok {
+		return auth.AccessTokenClaims{}, errors.New(errors.UnauthorizedError, "")
+	}
+
+	// Tokens issued before roles were introduced carry no role claim.
+	role := user.RoleAuthor
+	if value, ok := payload["role"].(string); ok {
+		role = user.Role(value)
+	}
+	if !role.IsValid() {
+		return auth.AccessTokenClaims{}, errors.New(errors.UnauthorizedError, "")
+	}
+
+	return auth.AccessTokenClaims{UserId: int64(userId), Role: role}, nil
+}
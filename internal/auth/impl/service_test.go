@@ -23,7 +23,7 @@ func TestAuthUsecases_Login(t *testing.T) {
 	token := "token"
 	tokenSecret := "token-secret"
 	tokenExpires := time.Now().Add(time.Hour)
-	tokenPayload := map[string]interface{}{"userId": userId}
+	tokenPayload := map[string]interface{}{"userId": userId, "role": "editor"}
 
 	password := "password"
 	passwordHash := "password-hash"
@@ -38,6 +38,7 @@ func TestAuthUsecases_Login(t *testing.T) {
 		LastName:  "LastName",
 		Email:     in.Email,
 		Password:  passwordHash,
+		Role:      user.RoleEditor,
 	}
 	loginUser := auth.LoggedUserDto{
 		UserDto: user.UserDto{
@@ -45,6 +46,7 @@ func TestAuthUsecases_Login(t *testing.T) {
 			FirstName: getUser.FirstName,
 			LastName:  getUser.LastName,
 			Email:     getUser.Email,
+			Role:      getUser.Role,
 		},
 		Token: token,
 	}
@@ -115,7 +117,7 @@ func TestAuthUsecases_VerifyAccessToken(t *testing.T) {
 
 	token := "token"
 	tokenSecret := "token-secret"
-	tokenPayload := map[string]interface{}{"userId": float64(userId)}
+	tokenPayload := map[string]interface{}{"userId": float64(userId), "role": "reviewer"}
 
 	t.Run("expect it virifies token", func(t *testing.T) {
 		prep := newTestPrep()
@@ -123,10 +125,37 @@ func TestAuthUsecases_VerifyAccessToken(t *testing.T) {
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
 		prep.crypto.EXPECT().ParseAndValidateJWT(token, tokenSecret).Return(tokenPayload, nil)
 
-		actualUserId, err := prep.authService.VerifyAccessToken(token)
+		claims, err := prep.authService.VerifyAccessToken(token)
 
 		require.NoError(t, err)
-		require.Equal(t, userId, actualUserId)
+		require.Equal(t, auth.AccessTokenClaims{UserId: userId, Role: user.RoleReviewer}, claims)
+	})
+
+	t.Run("expect tokens without role to belong to authors", func(t *testing.T) {
+		prep := newTestPrep()
+		legacyPayload := map[string]interface{}{"userId": float64(userId)}
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().ParseAndValidateJWT(token, tokenSecret).Return(legacyPayload, nil)
+
+		claims, err := prep.authService.VerifyAccessToken(token)
+
+		require.NoError(t, err)
+		require.Equal(t, user.RoleAuthor, claims.Role)
+	})
+
+	t.Run("expect it fails if token has unknown role", func(t *testing.T) {
+		prep := newTestPrep()
+		wrapErr := baseErrors.New(baseErrors.UnauthorizedError, "")
+		unknownPayload := map[string]interface{}{"userId": float64(userId), "role": "root"}
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().ParseAndValidateJWT(token, tokenSecret).Return(unknownPayload, nil)
+
+		_, actualErr := prep.authService.VerifyAccessToken(token)
+
+		require.Error(t, actualErr)
+		require.Equal(t, wrapErr, actualErr)
 	})
 
 	t.Run("expect it fails if token is not valid", func(t *testing.T) {
@@ -150,7 +179,7 @@ func TestAuthUsecases_ParseAccessToken(t *testing.T) {
 
 	token := "token"
 	tokenSecret := "token-secret"
-	tokenPayload := map[string]interface{}{"userId": float64(userId)}
+	tokenPayload := map[string]interface{}{"userId": float64(userId), "role": "admin"}
 
 	t.Run("expect it virifies token", func(t *testing.T) {
 		prep := newTestPrep()
@@ -158,10 +187,10 @@ func TestAuthUsecases_ParseAccessToken(t *testing.T) {
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
 		prep.crypto.EXPECT().ParseJWT(token, tokenSecret).Return(tokenPayload, nil)
 
-		actualUserId, err := prep.authService.ParseAccessToken(token)
+		claims, err := prep.authService.ParseAccessToken(token)
 
 		require.NoError(t, err)
-		require.Equal(t, userId, actualUserId)
+		require.Equal(t, auth.AccessTokenClaims{UserId: userId, Role: user.RoleAdmin}, claims)
 	})
 
 	t.Run("expect it fails if token parsing fails", func(t *testing.T) {
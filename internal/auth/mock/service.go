@@ -49,8 +49,8 @@ type AuthService_Login_Call struct {
 }
 
 // Login is a helper method to define mock.On call
-//  - ctx context.Context
-//  - dto auth.LoginUserDto
+//   - ctx context.Context
+//   - dto auth.LoginUserDto
 func (_e *AuthService_Expecter) Login(ctx interface{}, dto interface{}) *AuthService_Login_Call {
 	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, dto)}
 }
@@ -68,14 +68,14 @@ func (_c *AuthService_Login_Call) Return(_a0 auth.LoggedUserDto, _a1 error) *Aut
 }
 
 // ParseAccessToken provides a mock function with given fields: accessToken
-func (_m *AuthService) ParseAccessToken(accessToken string) (int64, error) {
+func (_m *AuthService) ParseAccessToken(accessToken string) (auth.AccessTokenClaims, error) {
 	ret := _m.Called(accessToken)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
+	var r0 auth.AccessTokenClaims
+	if rf, ok := ret.Get(0).(func(string) auth.AccessTokenClaims); ok {
 		r0 = rf(accessToken)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(auth.AccessTokenClaims)
 	}
 
 	var r1 error
@@ -94,7 +94,7 @@ type AuthService_ParseAccessToken_Call struct {
 }
 
 // ParseAccessToken is a helper method to define mock.On call
-//  - accessToken string
+//   - accessToken string
 func (_e *AuthService_Expecter) ParseAccessToken(accessToken interface{}) *AuthService_ParseAccessToken_Call {
 	return &AuthService_ParseAccessToken_Call{Call: _e.mock.On("ParseAccessToken", accessToken)}
 }
@@ -106,20 +106,20 @@ func (_c *AuthService_ParseAccessToken_Call) Run(run func(accessToken string)) *
 	return _c
 }
 
-func (_c *AuthService_ParseAccessToken_Call) Return(_a0 int64, _a1 error) *AuthService_ParseAccessToken_Call {
+func (_c *AuthService_ParseAccessToken_Call) Return(_a0 auth.AccessTokenClaims, _a1 error) *AuthService_ParseAccessToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
 // VerifyAccessToken provides a mock function with given fields: accessToken
-func (_m *AuthService) VerifyAccessToken(accessToken string) (int64, error) {
+func (_m *AuthService) VerifyAccessToken(accessToken string) (auth.AccessTokenClaims, error) {
 	ret := _m.Called(accessToken)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
+	var r0 auth.AccessTokenClaims
+	if rf, ok := ret.Get(0).(func(string) auth.AccessTokenClaims); ok {
 		r0 = rf(accessToken)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(auth.AccessTokenClaims)
 	}
 
 	var r1 error
@@ -138,7 +138,7 @@ type AuthService_VerifyAccessToken_Call struct {
 }
 
 // VerifyAccessToken is a helper method to define mock.On call
-//  - accessToken string
+//   - accessToken string
 func (_e *AuthService_Expecter) VerifyAccessToken(accessToken interface{}) *AuthService_VerifyAccessToken_Call {
 	return &AuthService_VerifyAccessToken_Call{Call: _e.mock.On("VerifyAccessToken", accessToken)}
 }
@@ -150,7 +150,7 @@ func (_c *AuthService_VerifyAccessToken_Call) Run(run func(accessToken string))
 	return _c
 }
 
-func (_c *AuthService_VerifyAccessToken_Call) Return(_a0 int64, _a1 error) *AuthService_VerifyAccessToken_Call {
+func (_c *AuthService_VerifyAccessToken_Call) Return(_a0 auth.AccessTokenClaims, _a1 error) *AuthService_VerifyAccessToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
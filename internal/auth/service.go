@@ -10,8 +10,8 @@ import (
 
 type AuthService interface {
 	Login(ctx context.Context, dto LoginUserDto) (LoggedUserDto, error)
-	VerifyAccessToken(accessToken string) (int64, error)
-	ParseAccessToken(accessToken string) (int64, error)
+	VerifyAccessToken(accessToken string) (AccessTokenClaims, error)
+	ParseAccessToken(accessToken string) (AccessTokenClaims, error)
 }
 
 type Config interface {
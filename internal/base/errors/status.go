@@ -11,6 +11,7 @@ const (
 	AlreadyExistsError    Status = "AlreadyExistsError"
 	WrongCredentialsError Status = "WrongCredentialsError"
 	UnauthorizedError     Status = "UnauthorizedError"
+	ForbiddenError        Status = "ForbiddenError"
 )
 
 func (s Status) Message() string {
@@ -31,6 +32,8 @@ func (s Status) Message() string {
 		return "wrong credentials error"
 	case UnauthorizedError:
 		return "unauthorized error"
+	case ForbiddenError:
+		return "forbidden error"
 	default:
 		return "internal error"
 	}
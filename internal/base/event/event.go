@@ -0,0 +1,61 @@
+//go:generate mockery --name Bus --filename bus.go --output ./mock --with-expecter
+
+package event
+
+import "context"
+
+// Name tells what happened.
+type Name string
+
+const (
+	// PostPublished is emitted when a post goes live, right after approval
+	// or when its scheduled time comes.
+	PostPublished Name = "post_published"
+	// PostChanged is emitted when a published post is edited, deleted or
+	// restored, and when moderation hides a post or shows it again.
+	PostChanged Name = "post_changed"
+	// PostApproved and PostRejected are emitted when a reviewer decides on a
+	// post.
+	PostApproved Name = "post_approved"
+	PostRejected Name = "post_rejected"
+	// PostLiked is emitted when a reader other than the author likes a post.
+	PostLiked Name = "post_liked"
+	// CommentAdded is emitted when a post gets a comment.
+	CommentAdded Name = "comment_added"
+	// UserCreated is emitted when someone signs up and EmailChanged when a
+	// user changes the address, which is to be confirmed then.
+	UserCreated  Name = "user_created"
+	EmailChanged Name = "email_changed"
+	// PasswordResetRequested is emitted when a user asks for a link to
+	// choose a new password.
+	PasswordResetRequested Name = "password_reset_requested"
+	// UserFollowed is emitted when a reader starts following an author.
+	UserFollowed Name = "user_followed"
+	// PayrollApproved is emitted for every author paid by an approved
+	// payroll run.
+	PayrollApproved Name = "payroll_approved"
+)
+
+// Event is something that happened in one domain that others may react to.
+type Event struct {
+	Name Name
+	// UserId is the user the event is about: the author of the post, the
+	// followed author or the paid one.
+	UserId int64
+	// ActorId is the user who caused the event, zero for the app itself or
+	// an anonymous reader.
+	ActorId   int64
+	PostId    int64
+	CommentId int64
+	RunId     int64
+}
+
+type Handler func(ctx context.Context, event Event) error
+
+// Bus hands events to the handlers subscribed to them. Handlers run with the
+// context of the publisher, so inside its transaction, and an error of a
+// handler fails the publish.
+type Bus interface {
+	Publish(ctx context.Context, event Event) error
+	Subscribe(name Name, handler Handler)
+}
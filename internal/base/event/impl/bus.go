@@ -0,0 +1,40 @@
+package impl
+
+import (
+	"context"
+	"sync"
+
+	"fibo/internal/base/event"
+)
+
+func NewBus() event.Bus {
+	return &bus{
+		handlers: map[event.Name][]event.Handler{},
+	}
+}
+
+type bus struct {
+	mu       sync.RWMutex
+	handlers map[event.Name][]event.Handler
+}
+
+func (b *bus) Publish(ctx context.Context, e event.Event) error {
+	b.mu.RLock()
+	handlers := b.handlers[e.Name]
+	b.mu.RUnlock()
+
+	for _, handle := range handlers {
+		if err := handle(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *bus) Subscribe(name event.Name, handler event.Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[name] = append(b.handlers[name], handler)
+}
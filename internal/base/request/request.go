@@ -9,8 +9,10 @@ const (
 )
 
 type RequestInfo struct {
-	UserId  int64
-	TraceId string
+	UserId    int64
+	Role      string
+	TraceId   string
+	VisitorId string
 }
 
 func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
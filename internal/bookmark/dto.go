@@ -0,0 +1,98 @@
+package bookmark
+
+type ListDto struct {
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"isDefault"`
+	Bookmarks int64  `json:"bookmarks"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+func (dto ListDto) MapFromModel(model ListModel) ListDto {
+	return ListDto{
+		Id:        model.Id,
+		Name:      model.Name,
+		IsDefault: model.IsDefault,
+		Bookmarks: model.Bookmarks,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+type AddListDto struct {
+	UserId int64  `json:"-"`
+	Name   string `json:"name"`
+}
+
+func (dto AddListDto) MapToModel() (ListModel, error) {
+	return NewList(dto.UserId, dto.Name)
+}
+
+type UpdateListDto struct {
+	Id     int64  `json:"-"`
+	UserId int64  `json:"-"`
+	Name   string `json:"name"`
+}
+
+// ListKeyDto points to a reading list of the user.
+type ListKeyDto struct {
+	Id     int64
+	UserId int64
+}
+
+type BookmarkDto struct {
+	PostId         int64  `json:"postId"`
+	Position       int64  `json:"position"`
+	Title          string `json:"title"`
+	Slug           string `json:"slug"`
+	AuthorId       int64  `json:"authorId"`
+	AuthorName     string `json:"authorName"`
+	ReadingMinutes int64  `json:"readingMinutes"`
+	PublishAt      string `json:"publishAt"`
+	SavedAt        string `json:"savedAt"`
+}
+
+func (dto BookmarkDto) MapFromModel(model SavedPostModel) BookmarkDto {
+	return BookmarkDto{
+		PostId:         model.PostId,
+		Position:       model.Position,
+		Title:          model.Title,
+		Slug:           model.Slug,
+		AuthorId:       model.AuthorId,
+		AuthorName:     model.AuthorName,
+		ReadingMinutes: model.ReadingMinutes,
+		PublishAt:      model.PublishAt,
+		SavedAt:        model.CreatedAt,
+	}
+}
+
+// AddBookmarkDto saves the post on the list, or on the default list when
+// ListId is zero.
+type AddBookmarkDto struct {
+	UserId int64 `json:"-"`
+	PostId int64 `json:"postId"`
+	ListId int64 `json:"listId"`
+}
+
+// RemoveBookmarkDto takes the post off the list, or off every list of the
+// user when ListId is zero.
+type RemoveBookmarkDto struct {
+	UserId int64
+	PostId int64
+	ListId int64
+}
+
+type MoveBookmarkDto struct {
+	UserId   int64 `json:"-"`
+	PostId   int64 `json:"-"`
+	ListId   int64 `json:"listId"`
+	Position int   `json:"position"`
+}
+
+// SavesDto tells how many readers saved the post after a change.
+type SavesDto struct {
+	PostId int64 `json:"postId"`
+	ListId int64 `json:"listId,omitempty"`
+	Saves  int64 `json:"saves"`
+}
@@ -0,0 +1,437 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/bookmark"
+	"fibo/internal/post"
+)
+
+type BookmarkRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewBookmarkRepository(opts BookmarkRepositoryOpts) bookmark.BookmarkRepository {
+	return &bookmarkRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type bookmarkRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *bookmarkRepository) AddList(ctx context.Context, list bookmark.ListModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("reading_lists").
+		Rows(databaseImpl.Record{
+			"user_id":    list.UserId,
+			"name":       list.Name,
+			"is_default": list.IsDefault,
+		}).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&list.Id); err != nil {
+		return 0, parseSaveListError(list, err)
+	}
+
+	return list.Id, nil
+}
+
+// GetLists returns the lists of the user, the default one first and the
+// others as they were made.
+func (r *bookmarkRepository) GetLists(ctx context.Context, userId int64) ([]bookmark.ListModel, error) {
+	sql, _, err := selectLists().
+		Where(goqu.Ex{"reading_lists.user_id": userId}).
+		Order(
+			goqu.I("reading_lists.is_default").Desc(),
+			goqu.I("reading_lists.created_at").Asc(),
+			goqu.I("reading_lists.id").Asc(),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reading lists failed")
+	}
+	defer rows.Close()
+
+	var lists []bookmark.ListModel
+	for rows.Next() {
+		list, err := scanList(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan reading list failed")
+		}
+
+		lists = append(lists, list)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reading lists failed")
+	}
+
+	return lists, nil
+}
+
+func (r *bookmarkRepository) GetList(ctx context.Context, listId int64) (bookmark.ListModel, error) {
+	sql, _, err := selectLists().
+		Where(goqu.Ex{"reading_lists.id": listId}).
+		ToSQL()
+	if err != nil {
+		return bookmark.ListModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	list, err := scanList(r.Conn(ctx).QueryRow(ctx, sql))
+	if err == pgx.ErrNoRows {
+		return bookmark.ListModel{}, errors.Wrapf(err, errors.NotFoundError, "reading list with id \"%d\" not found", listId)
+	}
+	if err != nil {
+		return bookmark.ListModel{}, errors.Wrap(err, errors.DatabaseError, "get reading list failed")
+	}
+
+	return list, nil
+}
+
+func (r *bookmarkRepository) GetDefaultList(ctx context.Context, userId int64) (bookmark.ListModel, bool, error) {
+	sql, _, err := selectLists().
+		Where(goqu.Ex{"reading_lists.user_id": userId, "reading_lists.is_default": true}).
+		ToSQL()
+	if err != nil {
+		return bookmark.ListModel{}, false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	list, err := scanList(r.Conn(ctx).QueryRow(ctx, sql))
+	if err == pgx.ErrNoRows {
+		return bookmark.ListModel{}, false, nil
+	}
+	if err != nil {
+		return bookmark.ListModel{}, false, errors.Wrap(err, errors.DatabaseError, "get default reading list failed")
+	}
+
+	return list, true, nil
+}
+
+func (r *bookmarkRepository) UpdateList(ctx context.Context, list bookmark.ListModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("reading_lists").
+		Set(databaseImpl.Record{
+			"name":       list.Name,
+			"updated_at": goqu.L("CURRENT_TIMESTAMP"),
+		}).
+		Where(databaseImpl.Ex{"id": list.Id}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSaveListError(list, err)
+	}
+
+	return nil
+}
+
+func (r *bookmarkRepository) DeleteList(ctx context.Context, listId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("reading_lists").
+		Where(databaseImpl.Ex{"id": listId}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete reading list failed")
+	}
+
+	return nil
+}
+
+func (r *bookmarkRepository) Add(ctx context.Context, model bookmark.BookmarkModel) (bool, error) {
+	nextPosition := databaseImpl.QueryBuilder.
+		From("bookmarks").
+		Select(goqu.L("COALESCE(MAX(position), 0) + 1")).
+		Where(goqu.Ex{"list_id": model.ListId})
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("bookmarks").
+		Rows(databaseImpl.Record{
+			"list_id":  model.ListId,
+			"user_id":  model.UserId,
+			"post_id":  model.PostId,
+			"position": nextPosition,
+		}).
+		OnConflict(goqu.DoNothing()).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, parseAddBookmarkError(model, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *bookmarkRepository) Remove(ctx context.Context, userId int64, listId int64, postId int64) (bool, error) {
+	where := databaseImpl.Ex{"user_id": userId, "post_id": postId}
+	if listId != 0 {
+		where["list_id"] = listId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("bookmarks").
+		Where(where).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "remove bookmark failed")
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// GetSavedPosts returns the bookmarks of the list in order. Posts readers
+// can no longer open keep their bookmark but are left out.
+func (r *bookmarkRepository) GetSavedPosts(ctx context.Context, listId int64) ([]bookmark.SavedPostModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("bookmarks").
+		Select(
+			"bookmarks.id",
+			"bookmarks.list_id",
+			"bookmarks.user_id",
+			"bookmarks.post_id",
+			"bookmarks.position",
+			"bookmarks.created_at",
+			"posts.title",
+			"posts.slug",
+			"posts.user_id",
+			"users.firstname",
+			"posts.reading_minutes",
+			"posts.publish_at",
+		).
+		InnerJoin(goqu.T("posts"), goqu.On(goqu.Ex{"posts.id": goqu.I("bookmarks.post_id")})).
+		InnerJoin(goqu.T("users"), goqu.On(goqu.Ex{"users.user_id": goqu.I("posts.user_id")})).
+		Where(goqu.Ex{
+			"bookmarks.list_id": listId,
+			"posts.state":       post.StatePublished,
+			"posts.deleted_at":  nil,
+			"posts.hidden_at":   nil,
+		}).
+		Order(goqu.I("bookmarks.position").Asc(), goqu.I("bookmarks.id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get saved posts failed")
+	}
+	defer rows.Close()
+
+	var models []bookmark.SavedPostModel
+	for rows.Next() {
+		var model bookmark.SavedPostModel
+		var createdAt time.Time
+		var publishAt sqlS.NullTime
+
+		err := rows.Scan(
+			&model.Id,
+			&model.ListId,
+			&model.UserId,
+			&model.PostId,
+			&model.Position,
+			&createdAt,
+			&model.Title,
+			&model.Slug,
+			&model.AuthorId,
+			&model.AuthorName,
+			&model.ReadingMinutes,
+			&publishAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan saved post failed")
+		}
+		model.CreatedAt = createdAt.Format(time.RFC3339)
+		if publishAt.Valid {
+			model.PublishAt = publishAt.Time.Format(time.RFC3339)
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get saved posts failed")
+	}
+
+	return models, nil
+}
+
+func (r *bookmarkRepository) GetPostIds(ctx context.Context, listId int64) ([]int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("bookmarks").
+		Select("post_id").
+		Where(goqu.Ex{"list_id": listId}).
+		Order(goqu.I("position").Asc(), goqu.I("id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get bookmarked posts failed")
+	}
+	defer rows.Close()
+
+	var postIds []int64
+	for rows.Next() {
+		var postId int64
+		if err := rows.Scan(&postId); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan bookmarked post failed")
+		}
+
+		postIds = append(postIds, postId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get bookmarked posts failed")
+	}
+
+	return postIds, nil
+}
+
+// Reorder numbers the bookmarks of the list in the order of the posts.
+func (r *bookmarkRepository) Reorder(ctx context.Context, listId int64, postIds []int64) error {
+	ids := make([]string, len(postIds))
+	for i, postId := range postIds {
+		ids[i] = fmt.Sprint(postId)
+	}
+	order := "{" + strings.Join(ids, ",") + "}"
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("bookmarks").
+		Set(databaseImpl.Record{"position": goqu.L("array_position(?::bigint[], post_id)", order)}).
+		Where(databaseImpl.Ex{"list_id": listId, "post_id": postIds}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "reorder bookmarks failed")
+	}
+
+	return nil
+}
+
+// RefreshSaves counts a reader once however many lists the post is on.
+func (r *bookmarkRepository) RefreshSaves(ctx context.Context, postId int64) (int64, error) {
+	savesCount := databaseImpl.QueryBuilder.
+		From("bookmarks").
+		Select(goqu.L("COUNT(DISTINCT bookmarks.user_id)")).
+		Where(goqu.Ex{"bookmarks.post_id": goqu.I("posts.id")})
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("posts").
+		Set(goqu.Record{"saves": savesCount}).
+		Where(goqu.Ex{"id": postId}).
+		Returning("saves").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var saves int64
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&saves); err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, errors.Wrapf(err, errors.NotFoundError, "post with id \"%d\" not found", postId)
+		}
+		return 0, errors.Wrap(err, errors.DatabaseError, "refresh saves failed")
+	}
+
+	return saves, nil
+}
+
+func selectLists() *goqu.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		From("reading_lists").
+		Select(
+			"reading_lists.id",
+			"reading_lists.user_id",
+			"reading_lists.name",
+			"reading_lists.is_default",
+			goqu.COUNT("bookmarks.id"),
+			"reading_lists.created_at",
+			"reading_lists.updated_at",
+		).
+		LeftJoin(goqu.T("bookmarks"), goqu.On(goqu.Ex{"bookmarks.list_id": goqu.I("reading_lists.id")})).
+		GroupBy("reading_lists.id")
+}
+
+func scanList(row pgx.Row) (bookmark.ListModel, error) {
+	var list bookmark.ListModel
+	var createdAt time.Time
+	var updatedAt time.Time
+
+	err := row.Scan(
+		&list.Id,
+		&list.UserId,
+		&list.Name,
+		&list.IsDefault,
+		&list.Bookmarks,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return bookmark.ListModel{}, err
+	}
+	list.CreatedAt = createdAt.Format(time.RFC3339)
+	list.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return list, nil
+}
+
+func parseSaveListError(list bookmark.ListModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "reading list \"%s\" already exists", list.Name)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save reading list failed")
+}
+
+func parseAddBookmarkError(model bookmark.BookmarkModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "post with id \"%d\" not found", model.PostId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add bookmark failed")
+}
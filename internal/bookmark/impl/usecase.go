@@ -0,0 +1,265 @@
+package impl
+
+import (
+	"context"
+
+	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/bookmark"
+	"fibo/internal/post"
+)
+
+type BookmarkUsecasesOpts struct {
+	TxManager          database.TxManager
+	BookmarkRepository bookmark.BookmarkRepository
+	PostRepository     post.PostRepository
+}
+
+func NewBookmarkUsecases(opts BookmarkUsecasesOpts) bookmark.BookmarkUsecases {
+	return &bookmarkUsecases{
+		TxManager:          opts.TxManager,
+		BookmarkRepository: opts.BookmarkRepository,
+		PostRepository:     opts.PostRepository,
+	}
+}
+
+type bookmarkUsecases struct {
+	database.TxManager
+	bookmark.BookmarkRepository
+	post.PostRepository
+}
+
+func (u *bookmarkUsecases) GetLists(ctx context.Context, userId int64) (out []bookmark.ListDto, err error) {
+	lists, err := u.BookmarkRepository.GetLists(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []bookmark.ListDto{}
+	for _, list := range lists {
+		out = append(out, bookmark.ListDto{}.MapFromModel(list))
+	}
+
+	return out, nil
+}
+
+func (u *bookmarkUsecases) AddList(ctx context.Context, in bookmark.AddListDto) (out bookmark.ListDto, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		lists, err := u.BookmarkRepository.GetLists(ctx, model.UserId)
+		if err != nil {
+			return err
+		}
+		if len(lists) >= bookmark.MaxLists {
+			return errors.Errorf(errors.ValidationError, "a reader keeps up to %d reading lists", bookmark.MaxLists)
+		}
+
+		model.Id, err = u.BookmarkRepository.AddList(ctx, model)
+		return err
+	})
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(model), nil
+}
+
+func (u *bookmarkUsecases) UpdateList(ctx context.Context, in bookmark.UpdateListDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		list, err := u.ownList(ctx, in.UserId, in.Id)
+		if err != nil {
+			return err
+		}
+		if err := list.Rename(in.Name); err != nil {
+			return err
+		}
+
+		return u.BookmarkRepository.UpdateList(ctx, list)
+	})
+}
+
+// DeleteList deletes the list along with its bookmarks. The default list
+// stays, it is where posts go without a list.
+func (u *bookmarkUsecases) DeleteList(ctx context.Context, in bookmark.ListKeyDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		list, err := u.ownList(ctx, in.UserId, in.Id)
+		if err != nil {
+			return err
+		}
+		if list.IsDefault {
+			return errors.New(errors.ValidationError, "the default reading list cannot be deleted")
+		}
+
+		postIds, err := u.BookmarkRepository.GetPostIds(ctx, list.Id)
+		if err != nil {
+			return err
+		}
+
+		if err := u.BookmarkRepository.DeleteList(ctx, list.Id); err != nil {
+			return err
+		}
+
+		for _, postId := range postIds {
+			if _, err := u.BookmarkRepository.RefreshSaves(ctx, postId); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (u *bookmarkUsecases) GetBookmarks(ctx context.Context, in bookmark.ListKeyDto) (out []bookmark.BookmarkDto, err error) {
+	list, err := u.ownList(ctx, in.UserId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := u.BookmarkRepository.GetSavedPosts(ctx, list.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []bookmark.BookmarkDto{}
+	for _, model := range saved {
+		out = append(out, bookmark.BookmarkDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// AddBookmark saves the post at the end of the list. Saving a post twice
+// on a list keeps its place.
+func (u *bookmarkUsecases) AddBookmark(ctx context.Context, in bookmark.AddBookmarkDto) (out bookmark.SavesDto, err error) {
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.checkReadable(ctx, in.PostId); err != nil {
+			return err
+		}
+
+		list, err := u.targetList(ctx, in.UserId, in.ListId)
+		if err != nil {
+			return err
+		}
+
+		model, err := bookmark.NewBookmark(in.UserId, list.Id, in.PostId)
+		if err != nil {
+			return err
+		}
+
+		if _, err := u.BookmarkRepository.Add(ctx, model); err != nil {
+			return err
+		}
+
+		out.PostId = in.PostId
+		out.ListId = list.Id
+		out.Saves, err = u.BookmarkRepository.RefreshSaves(ctx, in.PostId)
+		return err
+	})
+	if err != nil {
+		return bookmark.SavesDto{}, err
+	}
+
+	return out, nil
+}
+
+func (u *bookmarkUsecases) RemoveBookmark(ctx context.Context, in bookmark.RemoveBookmarkDto) (out bookmark.SavesDto, err error) {
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if in.ListId != 0 {
+			if _, err := u.ownList(ctx, in.UserId, in.ListId); err != nil {
+				return err
+			}
+		}
+
+		removed, err := u.BookmarkRepository.Remove(ctx, in.UserId, in.ListId, in.PostId)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return errors.Errorf(errors.NotFoundError, "post \"%d\" is not bookmarked", in.PostId)
+		}
+
+		out.PostId = in.PostId
+		out.ListId = in.ListId
+		out.Saves, err = u.BookmarkRepository.RefreshSaves(ctx, in.PostId)
+		return err
+	})
+	if err != nil {
+		return bookmark.SavesDto{}, err
+	}
+
+	return out, nil
+}
+
+func (u *bookmarkUsecases) MoveBookmark(ctx context.Context, in bookmark.MoveBookmarkDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		list, err := u.ownList(ctx, in.UserId, in.ListId)
+		if err != nil {
+			return err
+		}
+
+		postIds, err := u.BookmarkRepository.GetPostIds(ctx, list.Id)
+		if err != nil {
+			return err
+		}
+
+		moved, err := bookmark.Move(postIds, in.PostId, in.Position)
+		if err != nil {
+			return err
+		}
+
+		return u.BookmarkRepository.Reorder(ctx, list.Id, moved)
+	})
+}
+
+// ownList returns the list if it belongs to the user. Lists of others are
+// not found rather than forbidden, readers don't learn they exist.
+func (u *bookmarkUsecases) ownList(ctx context.Context, userId int64, listId int64) (bookmark.ListModel, error) {
+	list, err := u.BookmarkRepository.GetList(ctx, listId)
+	if err != nil {
+		return bookmark.ListModel{}, err
+	}
+	if !list.IsOwner(userId) {
+		return bookmark.ListModel{}, errors.Errorf(errors.NotFoundError, "reading list with id \"%d\" not found", listId)
+	}
+
+	return list, nil
+}
+
+// targetList picks the list a post is saved on: the given one or the
+// default one, which the first save without a list makes.
+func (u *bookmarkUsecases) targetList(ctx context.Context, userId int64, listId int64) (bookmark.ListModel, error) {
+	if listId != 0 {
+		return u.ownList(ctx, userId, listId)
+	}
+
+	list, found, err := u.BookmarkRepository.GetDefaultList(ctx, userId)
+	if err != nil || found {
+		return list, err
+	}
+
+	list = bookmark.NewDefaultList(userId)
+	list.Id, err = u.BookmarkRepository.AddList(ctx, list)
+	if err != nil {
+		return bookmark.ListModel{}, err
+	}
+
+	return list, nil
+}
+
+// checkReadable fails unless readers can open the post, only those are
+// saved.
+func (u *bookmarkUsecases) checkReadable(ctx context.Context, postId int64) error {
+	model, err := u.PostRepository.GetById(ctx, postId)
+	if err != nil {
+		return err
+	}
+	if !model.IsPublished() || model.IsHidden() {
+		return errors.Errorf(errors.NotFoundError, "post with id \"%d\" not found", postId)
+	}
+
+	return nil
+}
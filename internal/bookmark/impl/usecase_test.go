@@ -0,0 +1,228 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/bookmark"
+	"fibo/internal/post"
+
+	dbMock "fibo/internal/base/database/mock"
+	bookmarkMock "fibo/internal/bookmark/mock"
+	postMock "fibo/internal/post/mock"
+)
+
+func TestBookmarkUsecases_AddBookmark(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", State: post.StatePublished}
+	defaultList := bookmark.ListModel{Id: 4, UserId: 5, Name: bookmark.DefaultListName, IsDefault: true}
+
+	t.Run("expect it saves the post on the default list", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.bookmarkRepo.EXPECT().GetDefaultList(mock.Anything, int64(5)).Return(defaultList, true, nil)
+		prep.bookmarkRepo.EXPECT().Add(mock.Anything, bookmark.BookmarkModel{ListId: 4, UserId: 5, PostId: 1}).Return(true, nil)
+		prep.bookmarkRepo.EXPECT().RefreshSaves(mock.Anything, getPost.Id).Return(int64(7), nil)
+
+		out, err := prep.bookmarkUsecases.AddBookmark(prep.ctx, bookmark.AddBookmarkDto{UserId: 5, PostId: 1})
+
+		require.NoError(t, err)
+		require.Equal(t, bookmark.SavesDto{PostId: 1, ListId: 4, Saves: 7}, out)
+	})
+
+	t.Run("expect the first save to make the default list", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.bookmarkRepo.EXPECT().GetDefaultList(mock.Anything, int64(5)).Return(bookmark.ListModel{}, false, nil)
+		prep.bookmarkRepo.EXPECT().AddList(mock.Anything, bookmark.NewDefaultList(5)).Return(int64(4), nil)
+		prep.bookmarkRepo.EXPECT().Add(mock.Anything, bookmark.BookmarkModel{ListId: 4, UserId: 5, PostId: 1}).Return(true, nil)
+		prep.bookmarkRepo.EXPECT().RefreshSaves(mock.Anything, getPost.Id).Return(int64(1), nil)
+
+		out, err := prep.bookmarkUsecases.AddBookmark(prep.ctx, bookmark.AddBookmarkDto{UserId: 5, PostId: 1})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(4), out.ListId)
+	})
+
+	t.Run("expect it fails on a list of someone else", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.bookmarkRepo.EXPECT().GetList(mock.Anything, int64(8)).Return(bookmark.ListModel{Id: 8, UserId: 9}, nil)
+
+		_, err := prep.bookmarkUsecases.AddBookmark(prep.ctx, bookmark.AddBookmarkDto{UserId: 5, PostId: 1, ListId: 8})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		prep.bookmarkRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect drafts not to be saved", func(t *testing.T) {
+		prep := newTestPrep()
+		draft := getPost
+		draft.State = post.StateDraft
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(draft, nil)
+
+		_, err := prep.bookmarkUsecases.AddBookmark(prep.ctx, bookmark.AddBookmarkDto{UserId: 5, PostId: 1})
+
+		require.Error(t, err)
+		prep.bookmarkRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBookmarkUsecases_RemoveBookmark(t *testing.T) {
+	t.Run("expect it removes the post from every list", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().Remove(mock.Anything, int64(5), int64(0), int64(1)).Return(true, nil)
+		prep.bookmarkRepo.EXPECT().RefreshSaves(mock.Anything, int64(1)).Return(int64(6), nil)
+
+		out, err := prep.bookmarkUsecases.RemoveBookmark(prep.ctx, bookmark.RemoveBookmarkDto{UserId: 5, PostId: 1})
+
+		require.NoError(t, err)
+		require.Equal(t, bookmark.SavesDto{PostId: 1, Saves: 6}, out)
+		prep.bookmarkRepo.AssertNotCalled(t, "GetList", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the post is not saved", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().Remove(mock.Anything, int64(5), int64(0), int64(1)).Return(false, nil)
+
+		_, err := prep.bookmarkUsecases.RemoveBookmark(prep.ctx, bookmark.RemoveBookmarkDto{UserId: 5, PostId: 1})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+	})
+}
+
+func TestBookmarkUsecases_MoveBookmark(t *testing.T) {
+	list := bookmark.ListModel{Id: 4, UserId: 5, Name: "Go"}
+
+	t.Run("expect it moves the post within the list", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().GetList(mock.Anything, list.Id).Return(list, nil)
+		prep.bookmarkRepo.EXPECT().GetPostIds(mock.Anything, list.Id).Return([]int64{1, 2, 3, 4}, nil)
+		prep.bookmarkRepo.EXPECT().Reorder(mock.Anything, list.Id, []int64{4, 1, 2, 3}).Return(nil)
+
+		err := prep.bookmarkUsecases.MoveBookmark(prep.ctx, bookmark.MoveBookmarkDto{UserId: 5, ListId: 4, PostId: 4, Position: 1})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a position past the end to move the post last", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().GetList(mock.Anything, list.Id).Return(list, nil)
+		prep.bookmarkRepo.EXPECT().GetPostIds(mock.Anything, list.Id).Return([]int64{1, 2, 3}, nil)
+		prep.bookmarkRepo.EXPECT().Reorder(mock.Anything, list.Id, []int64{2, 3, 1}).Return(nil)
+
+		err := prep.bookmarkUsecases.MoveBookmark(prep.ctx, bookmark.MoveBookmarkDto{UserId: 5, ListId: 4, PostId: 1, Position: 10})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if the post is not on the list", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().GetList(mock.Anything, list.Id).Return(list, nil)
+		prep.bookmarkRepo.EXPECT().GetPostIds(mock.Anything, list.Id).Return([]int64{1, 2}, nil)
+
+		err := prep.bookmarkUsecases.MoveBookmark(prep.ctx, bookmark.MoveBookmarkDto{UserId: 5, ListId: 4, PostId: 9, Position: 1})
+
+		require.Error(t, err)
+		prep.bookmarkRepo.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestBookmarkUsecases_DeleteList(t *testing.T) {
+	t.Run("expect it deletes the list and recounts its posts", func(t *testing.T) {
+		prep := newTestPrep()
+		list := bookmark.ListModel{Id: 4, UserId: 5, Name: "Go"}
+
+		prep.bookmarkRepo.EXPECT().GetList(mock.Anything, list.Id).Return(list, nil)
+		prep.bookmarkRepo.EXPECT().GetPostIds(mock.Anything, list.Id).Return([]int64{1, 2}, nil)
+		prep.bookmarkRepo.EXPECT().DeleteList(mock.Anything, list.Id).Return(nil)
+		prep.bookmarkRepo.EXPECT().RefreshSaves(mock.Anything, int64(1)).Return(int64(0), nil)
+		prep.bookmarkRepo.EXPECT().RefreshSaves(mock.Anything, int64(2)).Return(int64(3), nil)
+
+		err := prep.bookmarkUsecases.DeleteList(prep.ctx, bookmark.ListKeyDto{Id: 4, UserId: 5})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect the default list to stay", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().GetList(mock.Anything, int64(4)).
+			Return(bookmark.ListModel{Id: 4, UserId: 5, Name: bookmark.DefaultListName, IsDefault: true}, nil)
+
+		err := prep.bookmarkUsecases.DeleteList(prep.ctx, bookmark.ListKeyDto{Id: 4, UserId: 5})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.bookmarkRepo.AssertNotCalled(t, "DeleteList", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBookmarkUsecases_AddList(t *testing.T) {
+	t.Run("expect it fails on an empty name", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.bookmarkUsecases.AddList(prep.ctx, bookmark.AddListDto{UserId: 5})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+
+	t.Run("expect it fails once the reader has too many lists", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().GetLists(mock.Anything, int64(5)).
+			Return(make([]bookmark.ListModel, bookmark.MaxLists), nil)
+
+		_, err := prep.bookmarkUsecases.AddList(prep.ctx, bookmark.AddListDto{UserId: 5, Name: "Go"})
+
+		require.Error(t, err)
+		prep.bookmarkRepo.AssertNotCalled(t, "AddList", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	bookmarkRepo *bookmarkMock.BookmarkRepository
+	postRepo     *postMock.PostRepository
+
+	bookmarkUsecases bookmark.BookmarkUsecases
+}
+
+func newTestPrep() testPrep {
+	bookmarkRepo := &bookmarkMock.BookmarkRepository{}
+	postRepo := &postMock.PostRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	bookmarkUsecasesOpts := BookmarkUsecasesOpts{
+		TxManager:          txManager,
+		BookmarkRepository: bookmarkRepo,
+		PostRepository:     postRepo,
+	}
+	bookmarkUsecases := NewBookmarkUsecases(bookmarkUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		bookmarkRepo:     bookmarkRepo,
+		postRepo:         postRepo,
+		bookmarkUsecases: bookmarkUsecases,
+	}
+}
@@ -0,0 +1,150 @@
+package bookmark
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+const (
+	// DefaultListName is the name of the list posts are saved to when the
+	// reader picks none. It is made with the first bookmark.
+	DefaultListName = "Read later"
+	// MaxLists is how many reading lists a reader keeps.
+	MaxLists = 50
+)
+
+// ListModel is a named reading list of a user. Bookmarks counts the posts
+// on it.
+type ListModel struct {
+	Id        int64
+	UserId    int64
+	Name      string
+	IsDefault bool
+	Bookmarks int64
+	CreatedAt string
+	UpdatedAt string
+}
+
+func NewList(userId int64, name string) (ListModel, error) {
+	list := ListModel{
+		UserId: userId,
+		Name:   name,
+	}
+
+	if err := list.Validate(); err != nil {
+		return ListModel{}, err
+	}
+
+	return list, nil
+}
+
+func NewDefaultList(userId int64) ListModel {
+	return ListModel{
+		UserId:    userId,
+		Name:      DefaultListName,
+		IsDefault: true,
+	}
+}
+
+func (list *ListModel) Rename(name string) error {
+	list.Name = name
+
+	return list.Validate()
+}
+
+func (list *ListModel) IsOwner(userId int64) bool {
+	return list.UserId == userId
+}
+
+func (list *ListModel) Validate() error {
+	err := validation.ValidateStruct(list,
+		validation.Field(&list.UserId, validation.Required),
+		validation.Field(&list.Name, validation.Required, validation.Length(1, 100)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// BookmarkModel is a post saved on a reading list. Position orders the
+// list from one, a new bookmark goes to its end.
+type BookmarkModel struct {
+	Id        int64
+	ListId    int64
+	UserId    int64
+	PostId    int64
+	Position  int64
+	CreatedAt string
+}
+
+func NewBookmark(userId int64, listId int64, postId int64) (BookmarkModel, error) {
+	bookmark := BookmarkModel{
+		UserId: userId,
+		ListId: listId,
+		PostId: postId,
+	}
+
+	if err := bookmark.Validate(); err != nil {
+		return BookmarkModel{}, err
+	}
+
+	return bookmark, nil
+}
+
+func (bookmark *BookmarkModel) Validate() error {
+	err := validation.ValidateStruct(bookmark,
+		validation.Field(&bookmark.UserId, validation.Required),
+		validation.Field(&bookmark.ListId, validation.Required),
+		validation.Field(&bookmark.PostId, validation.Required),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// SavedPostModel is a bookmark with what a reading list shows of its post.
+type SavedPostModel struct {
+	BookmarkModel
+	Title          string
+	Slug           string
+	AuthorId       int64
+	AuthorName     string
+	ReadingMinutes int64
+	PublishAt      string
+}
+
+// Move puts the post at the position, from one, of the ordered posts of a
+// list and returns the new order. Positions past the end move the post to
+// the end.
+func Move(postIds []int64, postId int64, position int) ([]int64, error) {
+	from := -1
+	for i, id := range postIds {
+		if id == postId {
+			from = i
+			break
+		}
+	}
+	if from < 0 {
+		return nil, errors.Errorf(errors.NotFoundError, "post \"%d\" is not on the list", postId)
+	}
+	if position < 1 {
+		return nil, errors.New(errors.ValidationError, "position must be a positive number")
+	}
+
+	moved := make([]int64, 0, len(postIds))
+	moved = append(moved, postIds[:from]...)
+	moved = append(moved, postIds[from+1:]...)
+
+	to := position - 1
+	if to > len(moved) {
+		to = len(moved)
+	}
+	moved = append(moved[:to], append([]int64{postId}, moved[to:]...)...)
+
+	return moved, nil
+}
@@ -0,0 +1,30 @@
+//go:generate mockery --name BookmarkRepository --filename repository.go --output ./mock --with-expecter
+
+package bookmark
+
+import "context"
+
+type BookmarkRepository interface {
+	AddList(ctx context.Context, list ListModel) (int64, error)
+	GetLists(ctx context.Context, userId int64) ([]ListModel, error)
+	GetList(ctx context.Context, listId int64) (ListModel, error)
+	// GetDefaultList reports false until the user saves a post without
+	// picking a list.
+	GetDefaultList(ctx context.Context, userId int64) (ListModel, bool, error)
+	UpdateList(ctx context.Context, list ListModel) error
+	DeleteList(ctx context.Context, listId int64) error
+
+	// Add saves the post at the end of the list. It reports false if the
+	// post is already on it.
+	Add(ctx context.Context, bookmark BookmarkModel) (bool, error)
+	// Remove takes the post off the list, or off every list of the user for
+	// the zero list. It reports false if the post was on none.
+	Remove(ctx context.Context, userId int64, listId int64, postId int64) (bool, error)
+	GetSavedPosts(ctx context.Context, listId int64) ([]SavedPostModel, error)
+	// GetPostIds returns every post of the list in order, even those readers
+	// can no longer open.
+	GetPostIds(ctx context.Context, listId int64) ([]int64, error)
+	Reorder(ctx context.Context, listId int64, postIds []int64) error
+	// RefreshSaves recounts the readers who saved the post.
+	RefreshSaves(ctx context.Context, postId int64) (int64, error)
+}
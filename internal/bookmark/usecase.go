@@ -0,0 +1,16 @@
+//go:generate mockery --name BookmarkUsecases --filename usecase.go --output ./mock --with-expecter
+
+package bookmark
+
+import "context"
+
+type BookmarkUsecases interface {
+	GetLists(ctx context.Context, userId int64) ([]ListDto, error)
+	AddList(ctx context.Context, dto AddListDto) (ListDto, error)
+	UpdateList(ctx context.Context, dto UpdateListDto) error
+	DeleteList(ctx context.Context, dto ListKeyDto) error
+	GetBookmarks(ctx context.Context, dto ListKeyDto) ([]BookmarkDto, error)
+	AddBookmark(ctx context.Context, dto AddBookmarkDto) (SavesDto, error)
+	RemoveBookmark(ctx context.Context, dto RemoveBookmarkDto) (SavesDto, error)
+	MoveBookmark(ctx context.Context, dto MoveBookmarkDto) error
+}
@@ -56,6 +56,9 @@ func (p *catRepository) GetCategories(
 
 		result = append(result, cat)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get categories failed")
+	}
 
 	return result, nil
 }
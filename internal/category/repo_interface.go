@@ -1,3 +1,5 @@
+//go:generate mockery --name CatRepository --filename repository.go --output ./mock --with-expecter
+
 package category
 
 import "context"
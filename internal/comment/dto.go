@@ -0,0 +1,113 @@
+package comment
+
+import "fibo/internal/user"
+
+type CommentDto struct {
+	Id         int64        `json:"id"`
+	PostId     int64        `json:"postId"`
+	ParentId   int64        `json:"parentId"`
+	UserId     int64        `json:"userId"`
+	AuthorName string       `json:"authorName"`
+	Content    string       `json:"content"`
+	IsDeleted  bool         `json:"isDeleted"`
+	IsHidden   bool         `json:"isHidden"`
+	CreatedAt  string       `json:"createdAt"`
+	UpdatedAt  string       `json:"updatedAt"`
+	Replies    []CommentDto `json:"replies,omitempty"`
+}
+
+func (dto CommentDto) MapFromModel(model CommentModel) CommentDto {
+	dto.Id = model.Id
+	dto.PostId = model.PostId
+	dto.ParentId = model.ParentId
+	dto.UserId = model.UserId
+	dto.AuthorName = model.AuthorName
+	dto.Content = model.Content
+	dto.IsDeleted = model.IsDeleted()
+	dto.IsHidden = model.IsHidden()
+	dto.CreatedAt = model.CreatedAt
+	dto.UpdatedAt = model.UpdatedAt
+
+	if dto.IsDeleted || dto.IsHidden {
+		dto.Content = ""
+	}
+
+	return dto
+}
+
+type AddCommentDto struct {
+	PostId     int64     `json:"postId"`
+	ParentId   int64     `json:"parentId"`
+	UserId     int64     `json:"userId"`
+	UserRole   user.Role `json:"-"`
+	AuthorName string    `json:"authorName"`
+	Content    string    `json:"content"`
+}
+
+func (dto AddCommentDto) MapToModel() (CommentModel, error) {
+	return NewComment(dto.PostId, dto.ParentId, dto.UserId, dto.AuthorName, dto.Content)
+}
+
+type UpdateCommentDto struct {
+	Id      int64  `json:"id"`
+	PostId  int64  `json:"postId"`
+	UserId  int64  `json:"userId"`
+	Content string `json:"content"`
+}
+
+type DeleteCommentDto struct {
+	Id     int64 `json:"id"`
+	PostId int64 `json:"postId"`
+	UserId int64 `json:"userId"`
+}
+
+// GetCommentsDto selects comments of a post. In tree mode Limit and Offset
+// paginate top level threads, otherwise they paginate individual comments.
+type GetCommentsDto struct {
+	PostId   int64
+	UserId   int64
+	UserRole user.Role
+	Tree     bool
+	Limit    uint
+	Offset   uint
+}
+
+// BuildTree nests comments under their parents. Deleted and hidden comments
+// are kept as placeholders only while they still have replies.
+func BuildTree(models []CommentModel) []CommentDto {
+	known := make(map[int64]bool, len(models))
+	for _, model := range models {
+		known[model.Id] = true
+	}
+
+	var roots []CommentModel
+	children := make(map[int64][]CommentModel)
+	for _, model := range models {
+		if model.ParentId == 0 || !known[model.ParentId] {
+			roots = append(roots, model)
+			continue
+		}
+		children[model.ParentId] = append(children[model.ParentId], model)
+	}
+
+	var build func(model CommentModel) (CommentDto, bool)
+	build = func(model CommentModel) (CommentDto, bool) {
+		dto := CommentDto{}.MapFromModel(model)
+		for _, child := range children[model.Id] {
+			if reply, ok := build(child); ok {
+				dto.Replies = append(dto.Replies, reply)
+			}
+		}
+
+		return dto, !dto.IsDeleted && !dto.IsHidden || len(dto.Replies) > 0
+	}
+
+	result := []CommentDto{}
+	for _, root := range roots {
+		if dto, ok := build(root); ok {
+			result = append(result, dto)
+		}
+	}
+
+	return result
+}
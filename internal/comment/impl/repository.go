@@ -0,0 +1,276 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/comment"
+)
+
+type CommentRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewCommentRepository(opts CommentRepositoryOpts) comment.CommentRepository {
+	return &commentRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type commentRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *commentRepository) Add(ctx context.Context, model comment.CommentModel) (int64, error) {
+	record := databaseImpl.Record{
+		"post_id": model.PostId,
+		"content": model.Content,
+	}
+	if model.ParentId != 0 {
+		record["parent_id"] = model.ParentId
+	}
+	if model.UserId != 0 {
+		record["user_id"] = model.UserId
+	} else {
+		record["author_name"] = model.AuthorName
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("comments").
+		Rows(record).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddCommentError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *commentRepository) Update(ctx context.Context, model comment.CommentModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("comments").
+		Set(databaseImpl.Record{
+			"content":    model.Content,
+			"updated_at": goqu.L("CURRENT_TIMESTAMP"),
+		}).
+		Where(databaseImpl.Ex{"id": model.Id}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update comment failed")
+	}
+
+	return nil
+}
+
+func (r *commentRepository) Delete(ctx context.Context, commentId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("comments").
+		Set(databaseImpl.Record{"deleted_at": goqu.L("CURRENT_TIMESTAMP")}).
+		Where(databaseImpl.Ex{"id": commentId}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete comment failed")
+	}
+
+	return nil
+}
+
+func (r *commentRepository) GetById(ctx context.Context, commentId int64) (comment.CommentModel, error) {
+	sql, _, err := selectComments(goqu.T("comments")).
+		Where(goqu.Ex{"comments.id": commentId}).
+		ToSQL()
+	if err != nil {
+		return comment.CommentModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanComment(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return comment.CommentModel{}, parseGetCommentError(commentId, err)
+	}
+
+	return model, nil
+}
+
+func (r *commentRepository) GetByPost(
+	ctx context.Context,
+	postId int64,
+	limit uint,
+	offset uint,
+) ([]comment.CommentModel, error) {
+	sql, _, err := selectComments(goqu.T("comments")).
+		Where(goqu.Ex{"comments.post_id": postId, "comments.deleted_at": nil, "comments.hidden_at": nil}).
+		Order(goqu.I("comments.created_at").Asc(), goqu.I("comments.id").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.queryComments(ctx, sql)
+}
+
+func (r *commentRepository) GetThreadsByPost(
+	ctx context.Context,
+	postId int64,
+	limit uint,
+	offset uint,
+) ([]comment.CommentModel, error) {
+	roots := databaseImpl.QueryBuilder.
+		From("comments").
+		Select("id").
+		Where(goqu.Ex{"post_id": postId, "parent_id": nil}).
+		Order(goqu.I("created_at").Asc(), goqu.I("id").Asc()).
+		Limit(limit).
+		Offset(offset)
+
+	thread := databaseImpl.QueryBuilder.
+		From("comments").
+		Select(goqu.I("comments.*")).
+		Where(goqu.I("comments.id").In(roots)).
+		UnionAll(
+			databaseImpl.QueryBuilder.
+				From("comments").
+				Select(goqu.I("comments.*")).
+				InnerJoin(goqu.T("thread"), goqu.On(goqu.Ex{"comments.parent_id": goqu.I("thread.id")})),
+		)
+
+	sql, _, err := selectComments(goqu.T("thread").As("comments")).
+		WithRecursive("thread", thread).
+		Order(goqu.I("comments.created_at").Asc(), goqu.I("comments.id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.queryComments(ctx, sql)
+}
+
+func (r *commentRepository) queryComments(ctx context.Context, sql string) ([]comment.CommentModel, error) {
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get comments failed")
+	}
+	defer rows.Close()
+
+	var models []comment.CommentModel
+	for rows.Next() {
+		model, err := scanComment(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan comment failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get comments failed")
+	}
+
+	return models, nil
+}
+
+func selectComments(from exp.Expression) *goqu.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		From(from).
+		Select(
+			"comments.id",
+			"comments.post_id",
+			"comments.parent_id",
+			"comments.user_id",
+			goqu.COALESCE(goqu.I("users.firstname"), goqu.I("comments.author_name"), ""),
+			"comments.content",
+			"comments.created_at",
+			"comments.updated_at",
+			"comments.deleted_at",
+			"comments.hidden_at",
+		).
+		LeftJoin(goqu.T("users"), goqu.On(goqu.Ex{"comments.user_id": goqu.I("users.user_id")}))
+}
+
+func scanComment(row pgx.Row) (comment.CommentModel, error) {
+	var model comment.CommentModel
+	var parentId sqlS.NullInt64
+	var userId sqlS.NullInt64
+	var createdAt time.Time
+	var updatedAt time.Time
+	var deletedAt sqlS.NullTime
+	var hiddenAt sqlS.NullTime
+
+	err := row.Scan(
+		&model.Id,
+		&model.PostId,
+		&parentId,
+		&userId,
+		&model.AuthorName,
+		&model.Content,
+		&createdAt,
+		&updatedAt,
+		&deletedAt,
+		&hiddenAt,
+	)
+	if err != nil {
+		return comment.CommentModel{}, err
+	}
+
+	model.ParentId = parentId.Int64
+	model.UserId = userId.Int64
+	model.CreatedAt = createdAt.Format(time.RFC3339)
+	model.UpdatedAt = updatedAt.Format(time.RFC3339)
+	if deletedAt.Valid {
+		model.DeletedAt = deletedAt.Time.Format(time.RFC3339)
+	}
+	if hiddenAt.Valid {
+		model.HiddenAt = hiddenAt.Time.Format(time.RFC3339)
+	}
+
+	return model, nil
+}
+
+func parseAddCommentError(model *comment.CommentModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.ForeignKeyViolation {
+		switch pgErr.ConstraintName {
+		case "comments_post_id_fkey":
+			return errors.Wrapf(err, errors.NotFoundError, "post with id \"%d\" not found", model.PostId)
+		case "comments_parent_id_fkey":
+			return errors.Wrapf(err, errors.NotFoundError, "comment with id \"%d\" not found", model.ParentId)
+		}
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add comment failed")
+}
+
+func parseGetCommentError(commentId int64, err error) error {
+	if err == pgx.ErrNoRows {
+		return errors.Wrapf(err, errors.NotFoundError, "comment with id \"%d\" not found", commentId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get comment by id failed")
+}
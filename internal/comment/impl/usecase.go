@@ -0,0 +1,215 @@
+package impl
+
+import (
+	"context"
+
+	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/comment"
+	"fibo/internal/post"
+	"fibo/internal/reputation"
+	"fibo/internal/user"
+)
+
+const (
+	defaultLimit uint = 20
+	maxLimit     uint = 100
+)
+
+type CommentUsecasesOpts struct {
+	TxManager            database.TxManager
+	CommentRepository    comment.CommentRepository
+	PostRepository       post.PostRepository
+	ReputationRepository reputation.ReputationRepository
+	Events               event.Bus
+}
+
+func NewCommentUsecases(opts CommentUsecasesOpts) comment.CommentUsecases {
+	return &commentUsecases{
+		TxManager:            opts.TxManager,
+		CommentRepository:    opts.CommentRepository,
+		PostRepository:       opts.PostRepository,
+		ReputationRepository: opts.ReputationRepository,
+		Bus:                  opts.Events,
+	}
+}
+
+type commentUsecases struct {
+	database.TxManager
+	comment.CommentRepository
+	post.PostRepository
+	reputation.ReputationRepository
+	event.Bus
+}
+
+func (u *commentUsecases) Add(ctx context.Context, in comment.AddCommentDto) (commentId int64, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		post, err := u.getReadablePost(ctx, model.PostId, in.UserId, in.UserRole)
+		if err != nil {
+			return err
+		}
+
+		if model.ParentId != 0 {
+			parent, err := u.CommentRepository.GetById(ctx, model.ParentId)
+			if err != nil {
+				return err
+			}
+			if parent.PostId != model.PostId {
+				return errors.New(errors.ValidationError, "parent comment belongs to another post")
+			}
+			if parent.IsDeleted() {
+				return errors.New(errors.ValidationError, "cannot reply to a deleted comment")
+			}
+		}
+
+		commentId, err = u.CommentRepository.Add(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		err = u.Bus.Publish(ctx, event.Event{
+			Name:      event.CommentAdded,
+			UserId:    post.UserId,
+			ActorId:   model.UserId,
+			PostId:    post.Id,
+			CommentId: commentId,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Only comments of registered readers other than the author earn
+		// reputation, as likes do.
+		if model.UserId == 0 || post.IsAuthor(model.UserId) {
+			return nil
+		}
+
+		event, err := reputation.NewEvent(
+			post.UserId,
+			reputation.EventCommentReceived,
+			post.Id,
+			reputation.Ref("comment", commentId),
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = u.ReputationRepository.AddEvent(ctx, event)
+		return err
+	})
+
+	return commentId, err
+}
+
+func (u *commentUsecases) Update(ctx context.Context, in comment.UpdateCommentDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.getOwnComment(ctx, in.Id, in.PostId, in.UserId)
+		if err != nil {
+			return err
+		}
+
+		if err := model.Update(in.Content); err != nil {
+			return err
+		}
+
+		return u.CommentRepository.Update(ctx, model)
+	})
+}
+
+func (u *commentUsecases) Delete(ctx context.Context, in comment.DeleteCommentDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.getOwnComment(ctx, in.Id, in.PostId, in.UserId)
+		if err != nil {
+			return err
+		}
+
+		return u.CommentRepository.Delete(ctx, model.Id)
+	})
+}
+
+func (u *commentUsecases) GetByPost(
+	ctx context.Context,
+	in comment.GetCommentsDto,
+) (out []comment.CommentDto, err error) {
+	limit := in.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	if _, err := u.getReadablePost(ctx, in.PostId, in.UserId, in.UserRole); err != nil {
+		return nil, err
+	}
+
+	if in.Tree {
+		models, err := u.CommentRepository.GetThreadsByPost(ctx, in.PostId, limit, in.Offset)
+		if err != nil {
+			return nil, err
+		}
+
+		return comment.BuildTree(models), nil
+	}
+
+	models, err := u.CommentRepository.GetByPost(ctx, in.PostId, limit, in.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []comment.CommentDto{}
+	for _, model := range models {
+		out = append(out, comment.CommentDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// getReadablePost returns the post if the user may read it. Comments of a
+// post that is not public are only there for its author and moderators.
+func (u *commentUsecases) getReadablePost(
+	ctx context.Context,
+	postId int64,
+	userId int64,
+	role user.Role,
+) (post.PostModel, error) {
+	model, err := u.PostRepository.GetById(ctx, postId)
+	if err != nil {
+		return post.PostModel{}, err
+	}
+	if !model.IsPublic() && !model.IsManagedBy(userId, role) {
+		return post.PostModel{}, errors.Errorf(errors.NotFoundError, "post with id \"%d\" not found", postId)
+	}
+
+	return model, nil
+}
+
+func (u *commentUsecases) getOwnComment(
+	ctx context.Context,
+	commentId int64,
+	postId int64,
+	userId int64,
+) (comment.CommentModel, error) {
+	model, err := u.CommentRepository.GetById(ctx, commentId)
+	if err != nil {
+		return comment.CommentModel{}, err
+	}
+	if model.PostId != postId || model.IsDeleted() {
+		return comment.CommentModel{}, errors.Errorf(
+			errors.NotFoundError,
+			"comment with id \"%d\" not found",
+			commentId,
+		)
+	}
+	if !model.IsAuthor(userId) {
+		return comment.CommentModel{}, errors.New(errors.ForbiddenError, "only the author can modify a comment")
+	}
+
+	return model, nil
+}
@@ -0,0 +1,366 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/comment"
+	"fibo/internal/post"
+	"fibo/internal/reputation"
+	"fibo/internal/user"
+
+	dbMock "fibo/internal/base/database/mock"
+	eventMock "fibo/internal/base/event/mock"
+	commentMock "fibo/internal/comment/mock"
+	postMock "fibo/internal/post/mock"
+	reputationMock "fibo/internal/reputation/mock"
+)
+
+func TestCommentUsecases_Add(t *testing.T) {
+	postAuthorId := int64(1)
+	commenterId := int64(2)
+
+	getPost := post.PostModel{Id: 10, UserId: postAuthorId, Title: "Title", Content: "Content", State: post.StatePublished}
+
+	in := comment.AddCommentDto{
+		PostId:  getPost.Id,
+		UserId:  commenterId,
+		Content: "Nice post",
+	}
+	createComment := comment.CommentModel{
+		PostId:  in.PostId,
+		UserId:  in.UserId,
+		Content: in.Content,
+	}
+
+	t.Run("expect it adds comment and rewards post author", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.commentRepo.EXPECT().Add(mock.Anything, createComment).Return(int64(5), nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{
+			Name:      event.CommentAdded,
+			UserId:    postAuthorId,
+			ActorId:   commenterId,
+			PostId:    getPost.Id,
+			CommentId: 5,
+		}).Return(nil)
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, reputation.EventModel{
+			UserId: postAuthorId,
+			Event:  reputation.EventCommentReceived,
+			PostId: getPost.Id,
+			Ref:    "comment:5",
+		}).Return(true, nil)
+
+		commentId, err := prep.commentUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(5), commentId)
+	})
+
+	t.Run("expect it does not reward authors for own comments", func(t *testing.T) {
+		prep := newTestPrep()
+		ownIn := in
+		ownIn.UserId = postAuthorId
+		ownComment := createComment
+		ownComment.UserId = postAuthorId
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.commentRepo.EXPECT().Add(mock.Anything, ownComment).Return(int64(6), nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := prep.commentUsecases.Add(prep.ctx, ownIn)
+
+		require.NoError(t, err)
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it does not reward authors for anonymous comments", func(t *testing.T) {
+		prep := newTestPrep()
+		anonymousIn := in
+		anonymousIn.UserId = 0
+		anonymousIn.AuthorName = "Visitor"
+		anonymousComment := createComment
+		anonymousComment.UserId = 0
+		anonymousComment.AuthorName = "Visitor"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.commentRepo.EXPECT().Add(mock.Anything, anonymousComment).Return(int64(7), nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := prep.commentUsecases.Add(prep.ctx, anonymousIn)
+
+		require.NoError(t, err)
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if anonymous comment has no author name", func(t *testing.T) {
+		prep := newTestPrep()
+		anonymousIn := in
+		anonymousIn.UserId = 0
+
+		_, err := prep.commentUsecases.Add(prep.ctx, anonymousIn)
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it fails if parent comment belongs to another post", func(t *testing.T) {
+		prep := newTestPrep()
+		replyIn := in
+		replyIn.ParentId = 3
+		parent := comment.CommentModel{Id: 3, PostId: 99, Content: "Parent"}
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.commentRepo.EXPECT().GetById(mock.Anything, parent.Id).Return(parent, nil)
+
+		_, err := prep.commentUsecases.Add(prep.ctx, replyIn)
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it fails if the post is not public", func(t *testing.T) {
+		prep := newTestPrep()
+		draft := getPost
+		draft.State = post.StateDraft
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(draft, nil)
+
+		_, err := prep.commentUsecases.Add(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		prep.commentRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect a moderator to comment on a post under review", func(t *testing.T) {
+		prep := newTestPrep()
+		submitted := getPost
+		submitted.State = post.StateSubmitted
+		reviewerIn := in
+		reviewerIn.UserRole = user.RoleReviewer
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(submitted, nil)
+		prep.commentRepo.EXPECT().Add(mock.Anything, createComment).Return(int64(8), nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.Anything).Return(nil)
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, mock.Anything).Return(true, nil)
+
+		_, err := prep.commentUsecases.Add(prep.ctx, reviewerIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if post getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("post getting failed")
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(post.PostModel{}, err)
+
+		_, actualErr := prep.commentUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestCommentUsecases_Update(t *testing.T) {
+	getComment := comment.CommentModel{Id: 3, PostId: 10, UserId: 2, Content: "Old"}
+
+	in := comment.UpdateCommentDto{
+		Id:      getComment.Id,
+		PostId:  getComment.PostId,
+		UserId:  getComment.UserId,
+		Content: "New",
+	}
+	updateComment := getComment
+	updateComment.Content = in.Content
+
+	t.Run("expect it updates own comment", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getComment, nil)
+		prep.commentRepo.EXPECT().Update(mock.Anything, updateComment).Return(nil)
+
+		err := prep.commentUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if user is not the author", func(t *testing.T) {
+		prep := newTestPrep()
+		otherIn := in
+		otherIn.UserId = 7
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getComment, nil)
+
+		err := prep.commentUsecases.Update(prep.ctx, otherIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+	})
+}
+
+func TestCommentUsecases_Delete(t *testing.T) {
+	getComment := comment.CommentModel{Id: 3, PostId: 10, UserId: 2, Content: "Old"}
+
+	in := comment.DeleteCommentDto{
+		Id:     getComment.Id,
+		PostId: getComment.PostId,
+		UserId: getComment.UserId,
+	}
+
+	t.Run("expect it deletes own comment", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getComment, nil)
+		prep.commentRepo.EXPECT().Delete(mock.Anything, in.Id).Return(nil)
+
+		err := prep.commentUsecases.Delete(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if comment belongs to another post", func(t *testing.T) {
+		prep := newTestPrep()
+		otherIn := in
+		otherIn.PostId = 11
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getComment, nil)
+
+		err := prep.commentUsecases.Delete(prep.ctx, otherIn)
+
+		require.Error(t, err)
+	})
+}
+
+func TestCommentUsecases_GetByPost(t *testing.T) {
+	models := []comment.CommentModel{
+		{Id: 1, PostId: 10, Content: "Root"},
+		{Id: 2, PostId: 10, ParentId: 1, Content: "Reply"},
+		{Id: 3, PostId: 10, ParentId: 2, Content: "Nested reply"},
+		{Id: 4, PostId: 10, Content: "Deleted", DeletedAt: "2022-01-01T00:00:00Z"},
+		{Id: 5, PostId: 10, Content: "Deleted parent", DeletedAt: "2022-01-01T00:00:00Z"},
+		{Id: 6, PostId: 10, ParentId: 5, Content: "Orphan reply"},
+	}
+
+	published := post.PostModel{Id: 10, UserId: 1, State: post.StatePublished}
+
+	t.Run("expect it builds comment tree", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, int64(10)).Return(published, nil)
+
+		prep.commentRepo.EXPECT().GetThreadsByPost(mock.Anything, int64(10), defaultLimit, uint(0)).Return(models, nil)
+
+		tree, err := prep.commentUsecases.GetByPost(prep.ctx, comment.GetCommentsDto{PostId: 10, Tree: true})
+
+		require.NoError(t, err)
+		require.Len(t, tree, 2)
+		require.Equal(t, int64(3), tree[0].Replies[0].Replies[0].Id)
+		require.True(t, tree[1].IsDeleted)
+		require.Empty(t, tree[1].Content)
+		require.Equal(t, int64(6), tree[1].Replies[0].Id)
+	})
+
+	t.Run("expect it blanks hidden comments", func(t *testing.T) {
+		prep := newTestPrep()
+		hidden := []comment.CommentModel{
+			{Id: 7, PostId: 10, Content: "Reported", HiddenAt: "2022-01-02T00:00:00Z"},
+			{Id: 8, PostId: 10, ParentId: 7, Content: "Reply"},
+			{Id: 9, PostId: 10, Content: "Reported alone", HiddenAt: "2022-01-02T00:00:00Z"},
+		}
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, int64(10)).Return(published, nil)
+		prep.commentRepo.EXPECT().GetThreadsByPost(mock.Anything, int64(10), defaultLimit, uint(0)).Return(hidden, nil)
+
+		tree, err := prep.commentUsecases.GetByPost(prep.ctx, comment.GetCommentsDto{PostId: 10, Tree: true})
+
+		require.NoError(t, err)
+		require.Len(t, tree, 1)
+		require.True(t, tree[0].IsHidden)
+		require.Empty(t, tree[0].Content)
+		require.Equal(t, int64(8), tree[0].Replies[0].Id)
+	})
+
+	t.Run("expect it caps flat list limit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, int64(10)).Return(published, nil)
+		prep.commentRepo.EXPECT().GetByPost(mock.Anything, int64(10), maxLimit, uint(0)).Return(models[:1], nil)
+
+		list, err := prep.commentUsecases.GetByPost(prep.ctx, comment.GetCommentsDto{PostId: 10, Limit: 1000})
+
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	})
+
+	t.Run("expect comments of a hidden post to be not found", func(t *testing.T) {
+		prep := newTestPrep()
+		hidden := published
+		hidden.HiddenAt = "2022-01-02T00:00:00Z"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, int64(10)).Return(hidden, nil)
+
+		_, err := prep.commentUsecases.GetByPost(prep.ctx, comment.GetCommentsDto{PostId: 10, UserId: 2, Tree: true})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+	})
+
+	t.Run("expect the author to read comments of a draft", func(t *testing.T) {
+		prep := newTestPrep()
+		draft := published
+		draft.State = post.StateDraft
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, int64(10)).Return(draft, nil)
+		prep.commentRepo.EXPECT().GetThreadsByPost(mock.Anything, int64(10), defaultLimit, uint(0)).Return(nil, nil)
+
+		_, err := prep.commentUsecases.GetByPost(prep.ctx, comment.GetCommentsDto{PostId: 10, UserId: 1, Tree: true})
+
+		require.NoError(t, err)
+	})
+}
+
+type testPrep struct {
+	ctx            context.Context
+	commentRepo    *commentMock.CommentRepository
+	postRepo       *postMock.PostRepository
+	reputationRepo *reputationMock.ReputationRepository
+	events         *eventMock.Bus
+
+	commentUsecases comment.CommentUsecases
+}
+
+func newTestPrep() testPrep {
+	commentRepo := &commentMock.CommentRepository{}
+	postRepo := &postMock.PostRepository{}
+	reputationRepo := &reputationMock.ReputationRepository{}
+	events := &eventMock.Bus{}
+	txManager := &dbMock.MockTxManager{}
+
+	commentUsecasesOpts := CommentUsecasesOpts{
+		TxManager:            txManager,
+		CommentRepository:    commentRepo,
+		PostRepository:       postRepo,
+		ReputationRepository: reputationRepo,
+		Events:               events,
+	}
+	commentUsecases := NewCommentUsecases(commentUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		commentRepo:     commentRepo,
+		postRepo:        postRepo,
+		reputationRepo:  reputationRepo,
+		events:          events,
+		commentUsecases: commentUsecases,
+	}
+}
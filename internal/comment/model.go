@@ -0,0 +1,82 @@
+package comment
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+type CommentModel struct {
+	Id         int64
+	PostId     int64
+	ParentId   int64
+	UserId     int64
+	AuthorName string
+	Content    string
+	CreatedAt  string
+	UpdatedAt  string
+	DeletedAt  string
+	// HiddenAt is set while moderators keep the comment from readers over
+	// reports against it.
+	HiddenAt string
+}
+
+func NewComment(
+	postId int64,
+	parentId int64,
+	userId int64,
+	authorName string,
+	content string,
+) (CommentModel, error) {
+	comment := CommentModel{
+		PostId:     postId,
+		ParentId:   parentId,
+		UserId:     userId,
+		AuthorName: authorName,
+		Content:    content,
+	}
+
+	if err := comment.Validate(); err != nil {
+		return CommentModel{}, err
+	}
+
+	return comment, nil
+}
+
+func (comment *CommentModel) Update(content string) error {
+	if len(content) > 0 {
+		comment.Content = content
+	}
+
+	return comment.Validate()
+}
+
+func (comment *CommentModel) IsAuthor(userId int64) bool {
+	return userId != 0 && comment.UserId == userId
+}
+
+func (comment *CommentModel) IsDeleted() bool {
+	return comment.DeletedAt != ""
+}
+
+func (comment *CommentModel) IsHidden() bool {
+	return comment.HiddenAt != ""
+}
+
+func (comment *CommentModel) Validate() error {
+	authorNameRules := []validation.Rule{validation.Length(2, 100)}
+	if comment.UserId == 0 {
+		authorNameRules = append(authorNameRules, validation.Required)
+	}
+
+	err := validation.ValidateStruct(comment,
+		validation.Field(&comment.PostId, validation.Required),
+		validation.Field(&comment.Content, validation.Required, validation.Length(1, 5000)),
+		validation.Field(&comment.AuthorName, authorNameRules...),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
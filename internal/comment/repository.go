@@ -0,0 +1,14 @@
+//go:generate mockery --name CommentRepository --filename repository.go --output ./mock --with-expecter
+
+package comment
+
+import "context"
+
+type CommentRepository interface {
+	Add(ctx context.Context, comment CommentModel) (int64, error)
+	Update(ctx context.Context, comment CommentModel) error
+	Delete(ctx context.Context, commentId int64) error
+	GetById(ctx context.Context, commentId int64) (CommentModel, error)
+	GetByPost(ctx context.Context, postId int64, limit, offset uint) ([]CommentModel, error)
+	GetThreadsByPost(ctx context.Context, postId int64, limit, offset uint) ([]CommentModel, error)
+}
@@ -0,0 +1,12 @@
+//go:generate mockery --name CommentUsecases --filename usecase.go --output ./mock --with-expecter
+
+package comment
+
+import "context"
+
+type CommentUsecases interface {
+	Add(ctx context.Context, dto AddCommentDto) (int64, error)
+	Update(ctx context.Context, dto UpdateCommentDto) error
+	Delete(ctx context.Context, dto DeleteCommentDto) error
+	GetByPost(ctx context.Context, dto GetCommentsDto) ([]CommentDto, error)
+}
@@ -0,0 +1,45 @@
+package follow
+
+// FollowDto points to the author or category the user follows or stops
+// following.
+type FollowDto struct {
+	UserId     int64
+	TargetType TargetType
+	TargetId   int64
+}
+
+func (dto FollowDto) MapToModel() (FollowModel, error) {
+	return NewFollow(dto.UserId, dto.TargetType, dto.TargetId)
+}
+
+// FollowedDto tells how many readers follow the target after a change.
+type FollowedDto struct {
+	TargetType TargetType `json:"targetType"`
+	TargetId   int64      `json:"targetId"`
+	Following  bool       `json:"following"`
+	Followers  int64      `json:"followers"`
+}
+
+type FollowingDto struct {
+	TargetType TargetType `json:"targetType"`
+	TargetId   int64      `json:"targetId"`
+	Name       string     `json:"name"`
+	FollowedAt string     `json:"followedAt"`
+}
+
+func (dto FollowingDto) MapFromModel(model FollowingModel) FollowingDto {
+	return FollowingDto{
+		TargetType: model.TargetType,
+		TargetId:   model.TargetId,
+		Name:       model.Name,
+		FollowedAt: model.CreatedAt,
+	}
+}
+
+// GetFeedDto asks for a page of the feed of the user; Cursor is the
+// NextCursor of the previous page.
+type GetFeedDto struct {
+	UserId int64
+	Limit  uint
+	Cursor string
+}
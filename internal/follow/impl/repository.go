@@ -0,0 +1,231 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/follow"
+)
+
+type FollowRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewFollowRepository(opts FollowRepositoryOpts) follow.FollowRepository {
+	return &followRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type followRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *followRepository) Add(ctx context.Context, model follow.FollowModel) (bool, error) {
+	table, column := followTable(model.TargetType)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert(table).
+		Rows(databaseImpl.Record{
+			"follower_id": model.UserId,
+			column:        model.TargetId,
+		}).
+		OnConflict(goqu.DoNothing()).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, parseAddFollowError(model, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *followRepository) Remove(ctx context.Context, model follow.FollowModel) (bool, error) {
+	table, column := followTable(model.TargetType)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete(table).
+		Where(databaseImpl.Ex{
+			"follower_id": model.UserId,
+			column:        model.TargetId,
+		}).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "remove follow failed")
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *followRepository) GetFollowing(ctx context.Context, userId int64) ([]follow.FollowingModel, error) {
+	authors := databaseImpl.QueryBuilder.
+		From("author_follows").
+		Select(
+			goqu.V(follow.TargetAuthor).As("target_type"),
+			goqu.I("author_follows.author_id").As("target_id"),
+			goqu.I("users.firstname").As("name"),
+			goqu.I("author_follows.created_at").As("created_at"),
+		).
+		InnerJoin(goqu.T("users"), goqu.On(goqu.Ex{"users.user_id": goqu.I("author_follows.author_id")})).
+		Where(goqu.Ex{"author_follows.follower_id": userId})
+
+	categories := databaseImpl.QueryBuilder.
+		From("category_follows").
+		Select(
+			goqu.V(follow.TargetCategory),
+			"category_follows.category_id",
+			"categories.name",
+			"category_follows.created_at",
+		).
+		InnerJoin(goqu.T("categories"), goqu.On(goqu.Ex{"categories.id": goqu.I("category_follows.category_id")})).
+		Where(goqu.Ex{
+			"category_follows.follower_id": userId,
+			"categories.deleted_at":        nil,
+		})
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		From(authors.UnionAll(categories).As("following")).
+		Select("target_type", "target_id", "name", "created_at").
+		Order(goqu.I("created_at").Desc(), goqu.I("target_id").Desc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get following failed")
+	}
+	defer rows.Close()
+
+	var models []follow.FollowingModel
+	for rows.Next() {
+		model := follow.FollowingModel{FollowModel: follow.FollowModel{UserId: userId}}
+		var createdAt time.Time
+
+		err := rows.Scan(
+			&model.TargetType,
+			&model.TargetId,
+			&model.Name,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan following failed")
+		}
+		model.CreatedAt = createdAt.Format(time.RFC3339)
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get following failed")
+	}
+
+	return models, nil
+}
+
+func (r *followRepository) RefreshFollowing(ctx context.Context, userId int64) error {
+	authors := databaseImpl.QueryBuilder.
+		From("author_follows").
+		Select(goqu.COUNT("*")).
+		Where(goqu.Ex{"author_follows.follower_id": goqu.I("users.user_id")})
+	categories := databaseImpl.QueryBuilder.
+		From("category_follows").
+		Select(goqu.COUNT("*")).
+		Where(goqu.Ex{"category_follows.follower_id": goqu.I("users.user_id")})
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("users").
+		Set(goqu.Record{"following": goqu.L("? + ?", authors, categories)}).
+		Where(goqu.Ex{"user_id": userId}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "refresh following failed")
+	}
+
+	return nil
+}
+
+func (r *followRepository) RefreshFollowers(ctx context.Context, authorId int64) (int64, error) {
+	followersCount := databaseImpl.QueryBuilder.
+		From("author_follows").
+		Select(goqu.COUNT("*")).
+		Where(goqu.Ex{"author_follows.author_id": goqu.I("users.user_id")})
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("users").
+		Set(goqu.Record{"followers": followersCount}).
+		Where(goqu.Ex{"user_id": authorId}).
+		Returning("followers").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var followers int64
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&followers); err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", authorId)
+		}
+		return 0, errors.Wrap(err, errors.DatabaseError, "refresh followers failed")
+	}
+
+	return followers, nil
+}
+
+func (r *followRepository) CountCategoryFollowers(ctx context.Context, categoryId int64) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("category_follows").
+		Select(goqu.COUNT("*")).
+		Where(goqu.Ex{"category_id": categoryId}).
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var followers int64
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&followers); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count category followers failed")
+	}
+
+	return followers, nil
+}
+
+// followTable names the table holding follows of the target type and its
+// column of the followed id.
+func followTable(targetType follow.TargetType) (string, string) {
+	if targetType == follow.TargetCategory {
+		return "category_follows", "category_id"
+	}
+
+	return "author_follows", "author_id"
+}
+
+func parseAddFollowError(model follow.FollowModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "%s with id \"%d\" not found", model.TargetType, model.TargetId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add follow failed")
+}
@@ -0,0 +1,152 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/follow"
+	"fibo/internal/post"
+)
+
+type FollowUsecasesOpts struct {
+	TxManager        database.TxManager
+	FollowRepository follow.FollowRepository
+	PostRepository   post.PostRepository
+	Events           event.Bus
+}
+
+func NewFollowUsecases(opts FollowUsecasesOpts) follow.FollowUsecases {
+	return &followUsecases{
+		TxManager:        opts.TxManager,
+		FollowRepository: opts.FollowRepository,
+		PostRepository:   opts.PostRepository,
+		Bus:              opts.Events,
+	}
+}
+
+type followUsecases struct {
+	database.TxManager
+	follow.FollowRepository
+	post.PostRepository
+	event.Bus
+}
+
+// Follow starts following the target. Following it twice changes nothing.
+func (u *followUsecases) Follow(ctx context.Context, in follow.FollowDto) (out follow.FollowedDto, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		added, err := u.FollowRepository.Add(ctx, model)
+		if err != nil {
+			return err
+		}
+		if added && model.TargetType == follow.TargetAuthor {
+			err := u.Bus.Publish(ctx, event.Event{
+				Name:    event.UserFollowed,
+				UserId:  model.TargetId,
+				ActorId: model.UserId,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		out, err = u.followed(ctx, model, true)
+		return err
+	})
+	if err != nil {
+		return follow.FollowedDto{}, err
+	}
+
+	return out, nil
+}
+
+func (u *followUsecases) Unfollow(ctx context.Context, in follow.FollowDto) (out follow.FollowedDto, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		removed, err := u.FollowRepository.Remove(ctx, model)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return errors.Errorf(errors.NotFoundError, "%s \"%d\" is not followed", model.TargetType, model.TargetId)
+		}
+
+		out, err = u.followed(ctx, model, false)
+		return err
+	})
+	if err != nil {
+		return follow.FollowedDto{}, err
+	}
+
+	return out, nil
+}
+
+func (u *followUsecases) GetFollowing(ctx context.Context, userId int64) (out []follow.FollowingDto, err error) {
+	following, err := u.FollowRepository.GetFollowing(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []follow.FollowingDto{}
+	for _, model := range following {
+		out = append(out, follow.FollowingDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// GetFeed reads the feed from the follows when asked for rather than
+// writing posts to every follower when they go live, so following someone
+// fills the feed with their past posts too. Posts come in the order they went
+// live, so a scheduled post shows up on top when it is published.
+func (u *followUsecases) GetFeed(ctx context.Context, in follow.GetFeedDto) (post.PostsPageDto, error) {
+	list, err := post.ListPostsDto{
+		State:  post.StatePublished,
+		Sort:   post.SortPublishAt,
+		Limit:  in.Limit,
+		Cursor: in.Cursor,
+	}.MapToModel()
+	if err != nil {
+		return post.PostsPageDto{}, err
+	}
+	list.FollowerId = in.UserId
+	list.PublishedBy = time.Now().UTC()
+	list.Public = true
+
+	page, err := u.PostRepository.ListPosts(ctx, list)
+	if err != nil {
+		return post.PostsPageDto{}, err
+	}
+
+	return post.PostsPageDto{}.MapFromModel(page), nil
+}
+
+// followed refreshes the counts a follow changes and tells how many readers
+// follow the target now.
+func (u *followUsecases) followed(ctx context.Context, model follow.FollowModel, following bool) (out follow.FollowedDto, err error) {
+	if err := u.FollowRepository.RefreshFollowing(ctx, model.UserId); err != nil {
+		return out, err
+	}
+
+	out.TargetType = model.TargetType
+	out.TargetId = model.TargetId
+	out.Following = following
+	if model.TargetType == follow.TargetAuthor {
+		out.Followers, err = u.FollowRepository.RefreshFollowers(ctx, model.TargetId)
+	} else {
+		out.Followers, err = u.FollowRepository.CountCategoryFollowers(ctx, model.TargetId)
+	}
+
+	return out, err
+}
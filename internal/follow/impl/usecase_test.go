@@ -0,0 +1,153 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/follow"
+	"fibo/internal/post"
+
+	dbMock "fibo/internal/base/database/mock"
+	eventMock "fibo/internal/base/event/mock"
+	followMock "fibo/internal/follow/mock"
+	postMock "fibo/internal/post/mock"
+)
+
+func TestFollowUsecases_Follow(t *testing.T) {
+	t.Run("expect it follows the author", func(t *testing.T) {
+		prep := newTestPrep()
+		model := follow.FollowModel{UserId: 5, TargetType: follow.TargetAuthor, TargetId: 2}
+
+		prep.followRepo.EXPECT().Add(mock.Anything, model).Return(true, nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.UserFollowed, UserId: 2, ActorId: 5}).Return(nil)
+		prep.followRepo.EXPECT().RefreshFollowing(mock.Anything, int64(5)).Return(nil)
+		prep.followRepo.EXPECT().RefreshFollowers(mock.Anything, int64(2)).Return(int64(9), nil)
+
+		out, err := prep.followUsecases.Follow(prep.ctx, follow.FollowDto{UserId: 5, TargetType: follow.TargetAuthor, TargetId: 2})
+
+		require.NoError(t, err)
+		require.Equal(t, follow.FollowedDto{TargetType: follow.TargetAuthor, TargetId: 2, Following: true, Followers: 9}, out)
+	})
+
+	t.Run("expect it follows the category", func(t *testing.T) {
+		prep := newTestPrep()
+		model := follow.FollowModel{UserId: 5, TargetType: follow.TargetCategory, TargetId: 3}
+
+		prep.followRepo.EXPECT().Add(mock.Anything, model).Return(false, nil)
+		prep.followRepo.EXPECT().RefreshFollowing(mock.Anything, int64(5)).Return(nil)
+		prep.followRepo.EXPECT().CountCategoryFollowers(mock.Anything, int64(3)).Return(int64(4), nil)
+
+		out, err := prep.followUsecases.Follow(prep.ctx, follow.FollowDto{UserId: 5, TargetType: follow.TargetCategory, TargetId: 3})
+
+		require.NoError(t, err)
+		require.Equal(t, follow.FollowedDto{TargetType: follow.TargetCategory, TargetId: 3, Following: true, Followers: 4}, out)
+		prep.followRepo.AssertNotCalled(t, "RefreshFollowers", mock.Anything, mock.Anything)
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect authors not to follow themselves", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.followUsecases.Follow(prep.ctx, follow.FollowDto{UserId: 5, TargetType: follow.TargetAuthor, TargetId: 5})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.followRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFollowUsecases_Unfollow(t *testing.T) {
+	t.Run("expect it stops following the author", func(t *testing.T) {
+		prep := newTestPrep()
+		model := follow.FollowModel{UserId: 5, TargetType: follow.TargetAuthor, TargetId: 2}
+
+		prep.followRepo.EXPECT().Remove(mock.Anything, model).Return(true, nil)
+		prep.followRepo.EXPECT().RefreshFollowing(mock.Anything, int64(5)).Return(nil)
+		prep.followRepo.EXPECT().RefreshFollowers(mock.Anything, int64(2)).Return(int64(8), nil)
+
+		out, err := prep.followUsecases.Unfollow(prep.ctx, follow.FollowDto{UserId: 5, TargetType: follow.TargetAuthor, TargetId: 2})
+
+		require.NoError(t, err)
+		require.Equal(t, follow.FollowedDto{TargetType: follow.TargetAuthor, TargetId: 2, Following: false, Followers: 8}, out)
+	})
+
+	t.Run("expect it fails if the target is not followed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.followRepo.EXPECT().Remove(mock.Anything, mock.Anything).Return(false, nil)
+
+		_, err := prep.followUsecases.Unfollow(prep.ctx, follow.FollowDto{UserId: 5, TargetType: follow.TargetCategory, TargetId: 3})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		prep.followRepo.AssertNotCalled(t, "RefreshFollowing", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFollowUsecases_GetFeed(t *testing.T) {
+	t.Run("expect it lists published posts of the follows", func(t *testing.T) {
+		prep := newTestPrep()
+		posts := []post.PostModelWithUser{{Id: 1, UserId: 2}}
+
+		prep.postRepo.EXPECT().ListPosts(mock.Anything, mock.MatchedBy(func(list post.ListPostsModel) bool {
+			return list.FollowerId == 5 &&
+				list.Public &&
+				!list.PublishedBy.IsZero() &&
+				len(list.States) == 1 && list.States[0] == post.StatePublished &&
+				list.Sort == post.SortPublishAt && list.Order == post.OrderDesc &&
+				list.Limit == 10
+		})).Return(post.PostsPageModel{Posts: posts}, nil)
+
+		out, err := prep.followUsecases.GetFeed(prep.ctx, follow.GetFeedDto{UserId: 5, Limit: 10})
+
+		require.NoError(t, err)
+		require.Equal(t, post.PostsPageDto{Posts: posts}, out)
+	})
+
+	t.Run("expect a malformed cursor to fail", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.followUsecases.GetFeed(prep.ctx, follow.GetFeedDto{UserId: 5, Cursor: "nope"})
+
+		require.Error(t, err)
+		prep.postRepo.AssertNotCalled(t, "ListPosts", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx            context.Context
+	followRepo     *followMock.FollowRepository
+	postRepo       *postMock.PostRepository
+	events         *eventMock.Bus
+	followUsecases follow.FollowUsecases
+}
+
+func newTestPrep() testPrep {
+	followRepo := &followMock.FollowRepository{}
+	postRepo := &postMock.PostRepository{}
+	events := &eventMock.Bus{}
+	txManager := &dbMock.MockTxManager{}
+
+	followUsecasesOpts := FollowUsecasesOpts{
+		TxManager:        txManager,
+		FollowRepository: followRepo,
+		PostRepository:   postRepo,
+		Events:           events,
+	}
+	followUsecases := NewFollowUsecases(followUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		followRepo:     followRepo,
+		postRepo:       postRepo,
+		events:         events,
+		followUsecases: followUsecases,
+	}
+}
@@ -0,0 +1,68 @@
+package follow
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+type TargetType string
+
+const (
+	TargetAuthor   TargetType = "author"
+	TargetCategory TargetType = "category"
+)
+
+func (t TargetType) IsValid() bool {
+	switch t {
+	case TargetAuthor, TargetCategory:
+		return true
+	}
+
+	return false
+}
+
+// FollowModel is a user following an author or a category.
+type FollowModel struct {
+	UserId     int64
+	TargetType TargetType
+	TargetId   int64
+	CreatedAt  string
+}
+
+func NewFollow(userId int64, targetType TargetType, targetId int64) (FollowModel, error) {
+	follow := FollowModel{
+		UserId:     userId,
+		TargetType: targetType,
+		TargetId:   targetId,
+	}
+
+	if err := follow.Validate(); err != nil {
+		return FollowModel{}, err
+	}
+
+	return follow, nil
+}
+
+func (follow *FollowModel) Validate() error {
+	err := validation.ValidateStruct(follow,
+		validation.Field(&follow.UserId, validation.Required),
+		validation.Field(&follow.TargetType, validation.Required, validation.In(TargetAuthor, TargetCategory)),
+		validation.Field(&follow.TargetId, validation.Required),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	if follow.TargetType == TargetAuthor && follow.TargetId == follow.UserId {
+		return errors.New(errors.ValidationError, "authors cannot follow themselves")
+	}
+
+	return nil
+}
+
+// FollowingModel is a follow with the name of the author or category.
+type FollowingModel struct {
+	FollowModel
+	Name string
+}
@@ -0,0 +1,20 @@
+//go:generate mockery --name FollowRepository --filename repository.go --output ./mock --with-expecter
+
+package follow
+
+import "context"
+
+type FollowRepository interface {
+	// Add reports false if the user already follows the target.
+	Add(ctx context.Context, follow FollowModel) (bool, error)
+	// Remove reports false if the user did not follow the target.
+	Remove(ctx context.Context, follow FollowModel) (bool, error)
+	// GetFollowing returns the authors and categories the user follows, the
+	// latest followed first.
+	GetFollowing(ctx context.Context, userId int64) ([]FollowingModel, error)
+	// RefreshFollowing recounts the authors and categories the user follows.
+	RefreshFollowing(ctx context.Context, userId int64) error
+	// RefreshFollowers recounts the readers following the author.
+	RefreshFollowers(ctx context.Context, authorId int64) (int64, error)
+	CountCategoryFollowers(ctx context.Context, categoryId int64) (int64, error)
+}
@@ -0,0 +1,18 @@
+//go:generate mockery --name FollowUsecases --filename usecase.go --output ./mock --with-expecter
+
+package follow
+
+import (
+	"context"
+
+	"fibo/internal/post"
+)
+
+type FollowUsecases interface {
+	Follow(ctx context.Context, dto FollowDto) (FollowedDto, error)
+	Unfollow(ctx context.Context, dto FollowDto) (FollowedDto, error)
+	GetFollowing(ctx context.Context, userId int64) ([]FollowingDto, error)
+	// GetFeed pages through the newest published posts of the authors and
+	// categories the user follows.
+	GetFeed(ctx context.Context, dto GetFeedDto) (post.PostsPageDto, error)
+}
@@ -0,0 +1,20 @@
+package mail
+
+// SendDto puts an email in the outbox. Data fills the templates of the
+// kind, as VerifyEmailData does for KindVerifyEmail.
+type SendDto struct {
+	Kind Kind
+	To   string
+	Data interface{}
+}
+
+func (dto SendDto) MapToModel() (MessageModel, error) {
+	return NewMessage(dto.Kind, dto.To, dto.Data)
+}
+
+// DispatchDto tells how a dispatch went. Failed emails are tried again
+// later unless they are given up.
+type DispatchDto struct {
+	Sent   int `json:"sent"`
+	Failed int `json:"failed"`
+}
@@ -0,0 +1,162 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/mail"
+)
+
+type OutboxRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewOutboxRepository(opts OutboxRepositoryOpts) mail.OutboxRepository {
+	return &outboxRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type outboxRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *outboxRepository) Add(ctx context.Context, message mail.MessageModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("mail_outbox").
+		Rows(databaseImpl.Record{
+			"kind":      message.Kind,
+			"recipient": message.To,
+			"subject":   message.Subject,
+			"text_body": message.Text,
+			"html_body": message.HTML,
+			"status":    message.Status,
+		}).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&message.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add email to outbox failed")
+	}
+
+	return message.Id, nil
+}
+
+func (r *outboxRepository) Claim(
+	ctx context.Context,
+	dueBy time.Time,
+	claimedUntil time.Time,
+	limit uint,
+) ([]mail.MessageModel, error) {
+	due := databaseImpl.QueryBuilder.
+		From("mail_outbox").
+		Select("id").
+		Where(
+			goqu.Ex{"status": mail.StatusPending},
+			goqu.I("next_attempt_at").Lte(dueBy),
+		).
+		Order(goqu.I("next_attempt_at").Asc(), goqu.I("id").Asc()).
+		Limit(limit).
+		ForUpdate(exp.SkipLocked)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("mail_outbox").
+		Set(databaseImpl.Record{"next_attempt_at": claimedUntil}).
+		Where(goqu.I("id").In(due)).
+		Returning(
+			"id",
+			"kind",
+			"recipient",
+			"subject",
+			"text_body",
+			"html_body",
+			"status",
+			"attempts",
+			"next_attempt_at",
+			"last_error",
+			"created_at",
+			"sent_at",
+		).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "claim due emails failed")
+	}
+	defer rows.Close()
+
+	var messages []mail.MessageModel
+	for rows.Next() {
+		var message mail.MessageModel
+		var createdAt time.Time
+		var sentAt sqlS.NullTime
+
+		err := rows.Scan(
+			&message.Id,
+			&message.Kind,
+			&message.To,
+			&message.Subject,
+			&message.Text,
+			&message.HTML,
+			&message.Status,
+			&message.Attempts,
+			&message.NextAttemptAt,
+			&message.LastError,
+			&createdAt,
+			&sentAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan email failed")
+		}
+		message.CreatedAt = createdAt.Format(time.RFC3339)
+		if sentAt.Valid {
+			message.SentAt = sentAt.Time.Format(time.RFC3339)
+		}
+
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "claim due emails failed")
+	}
+
+	return messages, nil
+}
+
+func (r *outboxRepository) Update(ctx context.Context, message mail.MessageModel) error {
+	record := databaseImpl.Record{
+		"status":          message.Status,
+		"attempts":        message.Attempts,
+		"next_attempt_at": message.NextAttemptAt,
+		"last_error":      message.LastError,
+	}
+	if message.SentAt != "" {
+		record["sent_at"] = message.SentAt
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("mail_outbox").
+		Set(record).
+		Where(databaseImpl.Ex{"id": message.Id}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update email in outbox failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/mail"
+)
+
+// NewSender makes the sender the config picks.
+func NewSender(config mail.SenderConfig) (mail.Sender, error) {
+	switch config.Sender() {
+	case "smtp":
+		return NewSMTPSender(SMTPSenderOpts{
+			Host:     config.SMTPHost(),
+			Port:     config.SMTPPort(),
+			Username: config.SMTPUsername(),
+			Password: config.SMTPPassword(),
+			From:     config.From(),
+		}), nil
+	case "file":
+		return NewFileSender(FileSenderOpts{
+			Dir:  config.Dir(),
+			From: config.From(),
+		}), nil
+	case "log", "":
+		return NewLogSender(LogSenderOpts{
+			Logger: log.Default(),
+			From:   config.From(),
+		}), nil
+	}
+
+	return nil, errors.Errorf(errors.ValidationError, "unknown mail sender \"%s\"", config.Sender())
+}
+
+type SMTPSenderOpts struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// NewSMTPSender sends through the SMTP server, upgrading to TLS when the
+// server offers it. It logs in only if a username is set.
+func NewSMTPSender(opts SMTPSenderOpts) mail.Sender {
+	return &smtpSender{
+		opts: opts,
+	}
+}
+
+type smtpSender struct {
+	opts SMTPSenderOpts
+}
+
+func (s *smtpSender) Send(ctx context.Context, message mail.MessageModel) error {
+	raw, err := buildMessage(s.opts.From, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.opts.Username != "" {
+		auth = smtp.PlainAuth("", s.opts.Username, s.opts.Password, s.opts.Host)
+	}
+
+	addr := net.JoinHostPort(s.opts.Host, strconv.Itoa(s.opts.Port))
+	if err := smtp.SendMail(addr, auth, s.opts.From, []string{message.To}, raw); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "send email \"%d\" over smtp failed", message.Id)
+	}
+
+	return nil
+}
+
+type FileSenderOpts struct {
+	Dir  string
+	From string
+}
+
+// NewFileSender writes every email to an .eml file of the directory, for
+// local development.
+func NewFileSender(opts FileSenderOpts) mail.Sender {
+	return &fileSender{
+		opts: opts,
+	}
+}
+
+type fileSender struct {
+	opts FileSenderOpts
+}
+
+func (s *fileSender) Send(ctx context.Context, message mail.MessageModel) error {
+	raw, err := buildMessage(s.opts.From, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.opts.Dir, 0o755); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "make mail directory \"%s\" failed", s.opts.Dir)
+	}
+
+	name := filepath.Join(s.opts.Dir, fmt.Sprintf("%d-%s.eml", message.Id, message.Kind))
+	if err := os.WriteFile(name, raw, 0o644); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "write email \"%d\" failed", message.Id)
+	}
+
+	return nil
+}
+
+type LogSenderOpts struct {
+	Logger *log.Logger
+	From   string
+}
+
+// NewLogSender prints the text of every email instead of sending it.
+func NewLogSender(opts LogSenderOpts) mail.Sender {
+	return &logSender{
+		opts: opts,
+	}
+}
+
+type logSender struct {
+	opts LogSenderOpts
+}
+
+func (s *logSender) Send(ctx context.Context, message mail.MessageModel) error {
+	s.opts.Logger.Printf(
+		"email %d (%s) from %s to %s: %s\n%s",
+		message.Id,
+		message.Kind,
+		s.opts.From,
+		message.To,
+		message.Subject,
+		message.Text,
+	)
+
+	return nil
+}
+
+// buildMessage makes the MIME message of the email with its text and HTML
+// as alternatives.
+func buildMessage(from string, message mail.MessageModel, now time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", message.Text},
+		{"text/html; charset=UTF-8", message.HTML},
+	} {
+		writer, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError, "build email failed")
+		}
+
+		encoder := quotedprintable.NewWriter(writer)
+		if _, err := encoder.Write([]byte(part.content)); err != nil {
+			return nil, errors.Wrap(err, errors.InternalError, "build email failed")
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, errors.Wrap(err, errors.InternalError, "build email failed")
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "build email failed")
+	}
+
+	var raw bytes.Buffer
+	fmt.Fprintf(&raw, "From: %s\r\n", from)
+	fmt.Fprintf(&raw, "To: %s\r\n", message.To)
+	fmt.Fprintf(&raw, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", message.Subject))
+	fmt.Fprintf(&raw, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&raw, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&raw, "Content-Type: multipart/alternative; boundary=%q\r\n", parts.Boundary())
+	fmt.Fprintf(&raw, "\r\n")
+	raw.Write(body.Bytes())
+
+	return raw.Bytes(), nil
+}
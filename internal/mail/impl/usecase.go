@@ -0,0 +1,227 @@
+package impl
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"fibo/internal/base/crypto"
+	"fibo/internal/base/event"
+	"fibo/internal/mail"
+	"fibo/internal/payroll"
+	"fibo/internal/post"
+	"fibo/internal/user"
+)
+
+const (
+	// dispatchBatchSize is how many due emails a dispatch sends at most.
+	dispatchBatchSize uint = 50
+	// claimTimeout is how long a dispatch has to send the emails it claimed
+	// before others may try them again.
+	claimTimeout = 10 * time.Minute
+)
+
+type MailUsecasesOpts struct {
+	Events            event.Bus
+	OutboxRepository  mail.OutboxRepository
+	Sender            mail.Sender
+	Config            mail.Config
+	UserConfig        user.Config
+	Crypto            crypto.Crypto
+	UserRepository    user.UserRepository
+	PostRepository    post.PostRepository
+	PayrollRepository payroll.PayrollRepository
+}
+
+// NewMailUsecases writes address confirmations, password reset links, review
+// decisions and payroll statements to the outbox as the events of the change come, inside its transaction.
+func NewMailUsecases(opts MailUsecasesOpts) mail.MailUsecases {
+	u := &mailUsecases{
+		OutboxRepository:  opts.OutboxRepository,
+		Sender:            opts.Sender,
+		Config:            opts.Config,
+		UserConfig:        opts.UserConfig,
+		Crypto:            opts.Crypto,
+		UserRepository:    opts.UserRepository,
+		PostRepository:    opts.PostRepository,
+		PayrollRepository: opts.PayrollRepository,
+	}
+
+	opts.Events.Subscribe(event.UserCreated, u.sendVerifyEmail)
+	opts.Events.Subscribe(event.EmailChanged, u.sendVerifyEmail)
+	opts.Events.Subscribe(event.PasswordResetRequested, u.sendResetPassword)
+	opts.Events.Subscribe(event.PostApproved, u.sendReviewDecision)
+	opts.Events.Subscribe(event.PostRejected, u.sendReviewDecision)
+	opts.Events.Subscribe(event.PayrollApproved, u.sendPayrollStatement)
+
+	return u
+}
+
+type mailUsecases struct {
+	mail.OutboxRepository
+	mail.Sender
+	mail.Config
+	UserConfig user.Config
+	crypto.Crypto
+	user.UserRepository
+	post.PostRepository
+	payroll.PayrollRepository
+}
+
+func (u *mailUsecases) Send(ctx context.Context, in mail.SendDto) error {
+	message, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	_, err = u.OutboxRepository.Add(ctx, message)
+	return err
+}
+
+// Dispatch claims a batch of due emails and sends them one by one, outside
+// of any transaction, saving the state of each right after it is sent. An
+// email whose state can't be saved is sent again once its claim runs out,
+// and so is one claimed by an app that stopped before sending it.
+func (u *mailUsecases) Dispatch(ctx context.Context) (out mail.DispatchDto, err error) {
+	now := time.Now().UTC()
+
+	due, err := u.OutboxRepository.Claim(ctx, now, now.Add(claimTimeout), dispatchBatchSize)
+	if err != nil {
+		return mail.DispatchDto{}, err
+	}
+
+	for i := range due {
+		if err := u.Sender.Send(ctx, due[i]); err != nil {
+			due[i].Failed(err, time.Now().UTC())
+			out.Failed++
+		} else {
+			due[i].Sent(time.Now().UTC())
+			out.Sent++
+		}
+
+		if err := u.OutboxRepository.Update(ctx, due[i]); err != nil {
+			return mail.DispatchDto{}, err
+		}
+	}
+
+	return out, nil
+}
+
+func (u *mailUsecases) sendVerifyEmail(ctx context.Context, e event.Event) error {
+	model, err := u.UserRepository.GetById(ctx, e.UserId)
+	if err != nil {
+		return err
+	}
+
+	token, err := model.IssueToken(u.Crypto, u.UserConfig.TokenSecret(), user.TokenVerifyEmail, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return u.Send(ctx, mail.SendDto{
+		Kind: mail.KindVerifyEmail,
+		To:   model.Email,
+		Data: mail.VerifyEmailData{
+			Name: model.FirstName,
+			Link: u.PublicURL() + "/verify-email?token=" + url.QueryEscape(token),
+		},
+	})
+}
+
+func (u *mailUsecases) sendResetPassword(ctx context.Context, e event.Event) error {
+	model, err := u.UserRepository.GetById(ctx, e.UserId)
+	if err != nil {
+		return err
+	}
+
+	token, err := model.IssueToken(u.Crypto, u.UserConfig.TokenSecret(), user.TokenResetPassword, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return u.Send(ctx, mail.SendDto{
+		Kind: mail.KindResetPassword,
+		To:   model.Email,
+		Data: mail.ResetPasswordData{
+			Name:     model.FirstName,
+			Link:     u.PublicURL() + "/reset-password?token=" + url.QueryEscape(token),
+			ValidFor: "1 hour",
+		},
+	})
+}
+
+func (u *mailUsecases) sendReviewDecision(ctx context.Context, e event.Event) error {
+	author, err := u.UserRepository.GetById(ctx, e.UserId)
+	if err != nil {
+		return err
+	}
+
+	model, err := u.PostRepository.GetById(ctx, e.PostId)
+	if err != nil {
+		return err
+	}
+
+	transitions, err := u.PostRepository.GetTransitions(ctx, e.PostId)
+	if err != nil {
+		return err
+	}
+
+	approved := e.Name == event.PostApproved
+	decided := post.StateRejected
+	if approved {
+		decided = post.StateApproved
+	}
+
+	return u.Send(ctx, mail.SendDto{
+		Kind: mail.KindReviewDecision,
+		To:   author.Email,
+		Data: mail.ReviewDecisionData{
+			Name:      author.FirstName,
+			PostTitle: model.Title,
+			PostURL:   u.PublicURL() + "/posts/" + url.PathEscape(model.Slug),
+			Approved:  approved,
+			Notes:     lastNotes(transitions, decided),
+		},
+	})
+}
+
+func (u *mailUsecases) sendPayrollStatement(ctx context.Context, e event.Event) error {
+	author, err := u.UserRepository.GetById(ctx, e.UserId)
+	if err != nil {
+		return err
+	}
+
+	run, err := u.PayrollRepository.GetRunById(ctx, e.RunId)
+	if err != nil {
+		return err
+	}
+
+	data := mail.PayrollStatementData{
+		Name:  author.FirstName,
+		Month: run.Month.Format("January 2006"),
+	}
+	for _, line := range run.Authors {
+		if line.UserId == e.UserId {
+			data.Points = line.Points
+			data.Rate = line.Rate
+			data.Amount = line.Amount
+		}
+	}
+
+	return u.Send(ctx, mail.SendDto{
+		Kind: mail.KindPayrollStatement,
+		To:   author.Email,
+		Data: data,
+	})
+}
+
+// lastNotes returns the notes of the latest move of the post to the state.
+func lastNotes(transitions []post.TransitionModel, state post.State) string {
+	for i := len(transitions) - 1; i >= 0; i-- {
+		if transitions[i].ToState == state {
+			return transitions[i].Notes
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,383 @@
+package impl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/mail"
+	"fibo/internal/payroll"
+	"fibo/internal/post"
+	"fibo/internal/user"
+
+	cryptoMock "fibo/internal/base/crypto/mock"
+	eventMock "fibo/internal/base/event/mock"
+	mailMock "fibo/internal/mail/mock"
+	payrollMock "fibo/internal/payroll/mock"
+	postMock "fibo/internal/post/mock"
+	userMock "fibo/internal/user/mock"
+)
+
+func TestMailUsecases_Send(t *testing.T) {
+	t.Run("expect the rendered email to be put in the outbox", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.outboxRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(message mail.MessageModel) bool {
+			return message.Kind == mail.KindVerifyEmail &&
+				message.To == "ann@example.com" &&
+				message.Status == mail.StatusPending &&
+				message.Subject != "" &&
+				containsAll(message.Text, "Ann", "https://fibo.test/verify?token=abc") &&
+				containsAll(message.HTML, "Ann", "https://fibo.test/verify?token=abc")
+		})).Return(int64(1), nil)
+
+		err := prep.mailUsecases.Send(prep.ctx, mail.SendDto{
+			Kind: mail.KindVerifyEmail,
+			To:   "ann@example.com",
+			Data: mail.VerifyEmailData{Name: "Ann", Link: "https://fibo.test/verify?token=abc"},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a wrong address to be rejected", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := prep.mailUsecases.Send(prep.ctx, mail.SendDto{
+			Kind: mail.KindVerifyEmail,
+			To:   "ann",
+			Data: mail.VerifyEmailData{Name: "Ann"},
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.outboxRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMailUsecases_Dispatch(t *testing.T) {
+	t.Run("expect sent and failed emails to be saved", func(t *testing.T) {
+		prep := newTestPrep()
+		due := []mail.MessageModel{
+			{Id: 1, Kind: mail.KindReviewDecision, To: "ann@example.com", Status: mail.StatusPending},
+			{Id: 2, Kind: mail.KindReviewDecision, To: "bob@example.com", Status: mail.StatusPending, Attempts: 2},
+			{Id: 3, Kind: mail.KindReviewDecision, To: "eve@example.com", Status: mail.StatusPending, Attempts: mail.MaxAttempts - 1},
+		}
+
+		prep.outboxRepo.EXPECT().Claim(
+			mock.Anything,
+			mock.Anything,
+			mock.MatchedBy(func(claimedUntil time.Time) bool {
+				return claimedUntil.After(time.Now().Add(claimTimeout - time.Minute))
+			}),
+			dispatchBatchSize,
+		).Return(due, nil)
+		prep.sender.EXPECT().Send(mock.Anything, due[0]).Return(nil)
+		prep.sender.EXPECT().Send(mock.Anything, due[1]).Return(baseErrors.New(baseErrors.InternalError, "timeout"))
+		prep.sender.EXPECT().Send(mock.Anything, due[2]).Return(baseErrors.New(baseErrors.InternalError, "timeout"))
+
+		saved := map[int64]mail.MessageModel{}
+		prep.outboxRepo.EXPECT().Update(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, message mail.MessageModel) { saved[message.Id] = message }).
+			Return(nil)
+
+		before := time.Now()
+		out, err := prep.mailUsecases.Dispatch(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, mail.DispatchDto{Sent: 1, Failed: 2}, out)
+
+		require.Equal(t, mail.StatusSent, saved[1].Status)
+		require.Equal(t, 1, saved[1].Attempts)
+		require.NotEmpty(t, saved[1].SentAt)
+
+		require.Equal(t, mail.StatusPending, saved[2].Status)
+		require.Equal(t, 3, saved[2].Attempts)
+		require.Equal(t, "timeout", saved[2].LastError)
+		require.True(t, saved[2].NextAttemptAt.After(before.Add(mail.RetryDelay(3)-time.Minute)))
+
+		require.Equal(t, mail.StatusFailed, saved[3].Status)
+		require.Equal(t, mail.MaxAttempts, saved[3].Attempts)
+	})
+
+	t.Run("expect emails saved before a failed save to stay saved", func(t *testing.T) {
+		prep := newTestPrep()
+		due := []mail.MessageModel{
+			{Id: 1, Kind: mail.KindReviewDecision, To: "ann@example.com", Status: mail.StatusPending},
+			{Id: 2, Kind: mail.KindReviewDecision, To: "bob@example.com", Status: mail.StatusPending},
+			{Id: 3, Kind: mail.KindReviewDecision, To: "eve@example.com", Status: mail.StatusPending},
+		}
+
+		prep.outboxRepo.EXPECT().Claim(mock.Anything, mock.Anything, mock.Anything, dispatchBatchSize).Return(due, nil)
+		prep.sender.EXPECT().Send(mock.Anything, mock.Anything).Return(nil)
+		prep.outboxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(message mail.MessageModel) bool {
+			return message.Id == 1
+		})).Return(nil)
+		prep.outboxRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(message mail.MessageModel) bool {
+			return message.Id == 2
+		})).Return(baseErrors.New(baseErrors.DatabaseError, "connection lost"))
+
+		_, err := prep.mailUsecases.Dispatch(prep.ctx)
+
+		require.Error(t, err)
+		prep.sender.AssertNumberOfCalls(t, "Send", 2)
+		prep.outboxRepo.AssertNumberOfCalls(t, "Update", 2)
+	})
+
+	t.Run("expect retries to back off", func(t *testing.T) {
+		require.Equal(t, time.Minute, mail.RetryDelay(1))
+		require.Equal(t, 2*time.Minute, mail.RetryDelay(2))
+		require.Equal(t, 64*time.Minute, mail.RetryDelay(7))
+		require.Equal(t, 6*time.Hour, mail.RetryDelay(20))
+	})
+}
+
+func TestMailUsecases_VerifyEmail(t *testing.T) {
+	ann := user.UserModel{Id: 2, FirstName: "Ann", Email: "ann@example.com"}
+
+	for _, name := range []event.Name{event.UserCreated, event.EmailChanged} {
+		t.Run("expect a signed link to confirm the address on "+string(name), func(t *testing.T) {
+			prep := newTestPrep()
+
+			prep.userRepo.EXPECT().GetById(mock.Anything, int64(2)).Return(ann, nil)
+			prep.userConfig.EXPECT().TokenSecret().Return("secret")
+			prep.config.EXPECT().PublicURL().Return("https://fibo.test")
+			prep.crypto.EXPECT().GenerateJWT(
+				mock.MatchedBy(func(payload map[string]interface{}) bool {
+					return payload["sub"] == "2" && payload["purpose"] == string(user.TokenVerifyEmail)
+				}),
+				"secret",
+				mock.MatchedBy(func(exp time.Time) bool {
+					return exp.After(time.Now().Add(user.VerifyEmailTokenTTL - time.Minute))
+				}),
+			).Return("a.b+c", nil)
+			prep.outboxRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(message mail.MessageModel) bool {
+				return message.Kind == mail.KindVerifyEmail &&
+					message.To == "ann@example.com" &&
+					containsAll(message.Text, "Ann", "https://fibo.test/verify-email?token=a.b%2Bc")
+			})).Return(int64(1), nil)
+
+			err := prep.handlers[name](prep.ctx, event.Event{Name: name, UserId: 2})
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("expect no link without a secret to sign it", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(2)).Return(ann, nil)
+		prep.userConfig.EXPECT().TokenSecret().Return("")
+
+		err := prep.handlers[event.UserCreated](prep.ctx, event.Event{Name: event.UserCreated, UserId: 2})
+
+		require.Error(t, err)
+		prep.outboxRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMailUsecases_ResetPassword(t *testing.T) {
+	t.Run("expect a link to choose a new password valid for an hour", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(2)).
+			Return(user.UserModel{Id: 2, FirstName: "Ann", Email: "ann@example.com", Password: "hash"}, nil)
+		prep.userConfig.EXPECT().TokenSecret().Return("secret")
+		prep.config.EXPECT().PublicURL().Return("https://fibo.test")
+		prep.crypto.EXPECT().GenerateJWT(
+			mock.MatchedBy(func(payload map[string]interface{}) bool {
+				return payload["sub"] == "2" && payload["purpose"] == string(user.TokenResetPassword)
+			}),
+			"secret",
+			mock.MatchedBy(func(exp time.Time) bool {
+				return exp.Before(time.Now().Add(user.ResetPasswordTokenTTL + time.Minute))
+			}),
+		).Return("token", nil)
+		prep.outboxRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(message mail.MessageModel) bool {
+			return message.Kind == mail.KindResetPassword &&
+				message.To == "ann@example.com" &&
+				containsAll(message.Text, "Ann", "https://fibo.test/reset-password?token=token", "1 hour")
+		})).Return(int64(1), nil)
+
+		err := prep.handlers[event.PasswordResetRequested](prep.ctx, event.Event{
+			Name:   event.PasswordResetRequested,
+			UserId: 2,
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestMailUsecases_ReviewDecision(t *testing.T) {
+	rejected := event.Event{Name: event.PostRejected, UserId: 2, ActorId: 3, PostId: 4}
+
+	t.Run("expect the author to get the notes of the reviewer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(2)).
+			Return(user.UserModel{Id: 2, FirstName: "Ann", Email: "ann@example.com"}, nil)
+		prep.postRepo.EXPECT().GetById(mock.Anything, int64(4)).
+			Return(post.PostModel{Id: 4, Title: "Title", Slug: "title"}, nil)
+		prep.postRepo.EXPECT().GetTransitions(mock.Anything, int64(4)).Return([]post.TransitionModel{
+			{ToState: post.StateRejected, Notes: "Too short"},
+			{ToState: post.StateInReview},
+			{ToState: post.StateRejected, Notes: "Add sources"},
+		}, nil)
+		prep.config.EXPECT().PublicURL().Return("https://fibo.test")
+		prep.outboxRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(message mail.MessageModel) bool {
+			return message.Kind == mail.KindReviewDecision &&
+				message.To == "ann@example.com" &&
+				message.Subject == "Your post needs changes: Title" &&
+				containsAll(message.Text, "Add sources", "https://fibo.test/posts/title") &&
+				!containsAll(message.Text, "Too short")
+		})).Return(int64(1), nil)
+
+		err := prep.handlers[event.PostRejected](prep.ctx, rejected)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a missing post to fail the change", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(2)).
+			Return(user.UserModel{Id: 2, FirstName: "Ann", Email: "ann@example.com"}, nil)
+		prep.postRepo.EXPECT().GetById(mock.Anything, int64(4)).
+			Return(post.PostModel{}, baseErrors.New(baseErrors.NotFoundError, "post not found"))
+
+		err := prep.handlers[event.PostRejected](prep.ctx, rejected)
+
+		require.Error(t, err)
+		prep.outboxRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMailUsecases_PayrollStatement(t *testing.T) {
+	t.Run("expect the author to get their line of the run", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(2)).
+			Return(user.UserModel{Id: 2, FirstName: "Ann", Email: "ann@example.com"}, nil)
+		prep.payrollRepo.EXPECT().GetRunById(mock.Anything, int64(7)).Return(payroll.RunModel{
+			Id:    7,
+			Month: time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC),
+			Authors: []payroll.AuthorLineModel{
+				{UserId: 1, Points: 10, Rate: 5, Amount: 50},
+				{UserId: 2, Points: 30, Rate: 7, Amount: 210},
+			},
+		}, nil)
+		prep.outboxRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(message mail.MessageModel) bool {
+			return message.Kind == mail.KindPayrollStatement &&
+				message.Subject == "Your payroll statement for September 2026" &&
+				containsAll(message.Text, "30", "210")
+		})).Return(int64(1), nil)
+
+		err := prep.handlers[event.PayrollApproved](prep.ctx, event.Event{Name: event.PayrollApproved, UserId: 2, RunId: 7})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestFileSender_Send(t *testing.T) {
+	t.Run("expect the email to be written as an eml file", func(t *testing.T) {
+		dir := t.TempDir()
+		sender := NewFileSender(FileSenderOpts{Dir: dir, From: "Fibo <no-reply@fibo.test>"})
+
+		err := sender.Send(context.Background(), mail.MessageModel{
+			Id:      5,
+			Kind:    mail.KindResetPassword,
+			To:      "ann@example.com",
+			Subject: "Reset your password",
+			Text:    "Hi Ann",
+			HTML:    "<p>Hi Ann</p>",
+		})
+
+		require.NoError(t, err)
+		raw, err := os.ReadFile(filepath.Join(dir, "5-reset_password.eml"))
+		require.NoError(t, err)
+		require.True(t, containsAll(string(raw),
+			"From: Fibo <no-reply@fibo.test>",
+			"To: ann@example.com",
+			"multipart/alternative",
+			"Hi Ann",
+			"<p>Hi Ann</p>",
+		))
+	})
+}
+
+func containsAll(s string, parts ...string) bool {
+	for _, part := range parts {
+		if !strings.Contains(s, part) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type testPrep struct {
+	ctx         context.Context
+	outboxRepo  *mailMock.OutboxRepository
+	sender      *mailMock.Sender
+	config      *mailMock.Config
+	userConfig  *userMock.Config
+	crypto      *cryptoMock.Crypto
+	userRepo    *userMock.UserRepository
+	postRepo    *postMock.PostRepository
+	payrollRepo *payrollMock.PayrollRepository
+	handlers    map[event.Name]event.Handler
+
+	mailUsecases mail.MailUsecases
+}
+
+func newTestPrep() testPrep {
+	outboxRepo := &mailMock.OutboxRepository{}
+	sender := &mailMock.Sender{}
+	config := &mailMock.Config{}
+	userConfig := &userMock.Config{}
+	crypto := &cryptoMock.Crypto{}
+	userRepo := &userMock.UserRepository{}
+	postRepo := &postMock.PostRepository{}
+	payrollRepo := &payrollMock.PayrollRepository{}
+	events := &eventMock.Bus{}
+	handlers := map[event.Name]event.Handler{}
+
+	events.EXPECT().Subscribe(mock.Anything, mock.Anything).
+		Run(func(name event.Name, handler event.Handler) { handlers[name] = handler })
+
+	mailUsecasesOpts := MailUsecasesOpts{
+		Events:            events,
+		OutboxRepository:  outboxRepo,
+		Sender:            sender,
+		Config:            config,
+		UserConfig:        userConfig,
+		Crypto:            crypto,
+		UserRepository:    userRepo,
+		PostRepository:    postRepo,
+		PayrollRepository: payrollRepo,
+	}
+	mailUsecases := NewMailUsecases(mailUsecasesOpts)
+
+	return testPrep{
+		ctx:          context.Background(),
+		outboxRepo:   outboxRepo,
+		sender:       sender,
+		config:       config,
+		userConfig:   userConfig,
+		crypto:       crypto,
+		userRepo:     userRepo,
+		postRepo:     postRepo,
+		payrollRepo:  payrollRepo,
+		handlers:     handlers,
+		mailUsecases: mailUsecases,
+	}
+}
@@ -0,0 +1,126 @@
+package mail
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+
+	"fibo/internal/base/errors"
+)
+
+// Kind tells what an email is for. Every kind has templates of its own.
+type Kind string
+
+const (
+	KindVerifyEmail      Kind = "verify_email"
+	KindResetPassword    Kind = "reset_password"
+	KindReviewDecision   Kind = "review_decision"
+	KindPayrollStatement Kind = "payroll_statement"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusSent    Status = "sent"
+	// StatusFailed is an email given up on after MaxAttempts.
+	StatusFailed Status = "failed"
+)
+
+const (
+	// MaxAttempts is how many times an email is tried before it is given up.
+	MaxAttempts = 8
+	// firstRetryDelay doubles with every failed attempt up to maxRetryDelay.
+	firstRetryDelay = time.Minute
+	maxRetryDelay   = 6 * time.Hour
+)
+
+// MessageModel is an email in the outbox. It is rendered when it is written,
+// so it tells what was true at the time of the change that sent it.
+type MessageModel struct {
+	Id            int64
+	Kind          Kind
+	To            string
+	Subject       string
+	Text          string
+	HTML          string
+	Status        Status
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     string
+	SentAt        string
+}
+
+// NewMessage renders the email of the kind for the data of its templates.
+func NewMessage(kind Kind, to string, data interface{}) (MessageModel, error) {
+	message := MessageModel{
+		Kind:   kind,
+		To:     to,
+		Status: StatusPending,
+	}
+
+	if err := message.Validate(); err != nil {
+		return MessageModel{}, err
+	}
+
+	var err error
+	message.Subject, message.Text, message.HTML, err = render(kind, data)
+	if err != nil {
+		return MessageModel{}, err
+	}
+
+	return message, nil
+}
+
+func (message *MessageModel) Validate() error {
+	err := validation.ValidateStruct(message,
+		validation.Field(&message.Kind, validation.Required, validation.In(
+			KindVerifyEmail,
+			KindResetPassword,
+			KindReviewDecision,
+			KindPayrollStatement,
+		)),
+		validation.Field(&message.To, validation.Required, is.Email),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+func (message *MessageModel) Sent(now time.Time) {
+	message.Status = StatusSent
+	message.Attempts++
+	message.LastError = ""
+	message.SentAt = now.UTC().Format(time.RFC3339)
+}
+
+// Failed schedules the next attempt, each one twice as late as the one
+// before, or gives the email up after MaxAttempts.
+func (message *MessageModel) Failed(cause error, now time.Time) {
+	message.Attempts++
+	message.LastError = cause.Error()
+
+	if message.Attempts >= MaxAttempts {
+		message.Status = StatusFailed
+		return
+	}
+
+	message.NextAttemptAt = now.Add(RetryDelay(message.Attempts))
+}
+
+// RetryDelay is how long to wait after the given number of failed attempts.
+func RetryDelay(attempts int) time.Duration {
+	delay := firstRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,18 @@
+//go:generate mockery --name OutboxRepository --filename repository.go --output ./mock --with-expecter
+
+package mail
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxRepository interface {
+	Add(ctx context.Context, message MessageModel) (int64, error)
+	// Claim takes pending emails due by the given time, the oldest first,
+	// and puts their next attempt off until claimedUntil, so that other
+	// dispatchers leave them alone while they are sent. Emails another
+	// transaction is claiming are skipped.
+	Claim(ctx context.Context, dueBy time.Time, claimedUntil time.Time, limit uint) ([]MessageModel, error)
+	Update(ctx context.Context, message MessageModel) error
+}
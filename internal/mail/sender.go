@@ -0,0 +1,24 @@
+//go:generate mockery --name Sender --filename sender.go --output ./mock --with-expecter
+//go:generate mockery --name SenderConfig --filename sender_config.go --output ./mock --with-expecter
+
+package mail
+
+import "context"
+
+// Sender delivers an email. An error leaves the email in the outbox to be
+// tried again.
+type Sender interface {
+	Send(ctx context.Context, message MessageModel) error
+}
+
+type SenderConfig interface {
+	// Sender is "smtp", "file" to write emails to Dir or "log" to print
+	// them.
+	Sender() string
+	From() string
+	SMTPHost() string
+	SMTPPort() int
+	SMTPUsername() string
+	SMTPPassword() string
+	Dir() string
+}
@@ -0,0 +1,93 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	htmlTemplate "html/template"
+	"strings"
+	textTemplate "text/template"
+
+	"fibo/internal/base/errors"
+)
+
+// VerifyEmailData fills the email asking a new user to confirm the address.
+type VerifyEmailData struct {
+	Name string
+	Link string
+}
+
+// ResetPasswordData fills the email with the link to choose a new password.
+type ResetPasswordData struct {
+	Name     string
+	Link     string
+	ValidFor string
+}
+
+// ReviewDecisionData fills the email telling an author how the review of
+// their post ended.
+type ReviewDecisionData struct {
+	Name      string
+	PostTitle string
+	PostURL   string
+	Approved  bool
+	Notes     string
+}
+
+// PayrollStatementData fills the email telling an author what a payroll
+// run pays them.
+type PayrollStatementData struct {
+	Name   string
+	Month  string
+	Points int64
+	Rate   int64
+	Amount int64
+}
+
+//go:embed templates
+var templateFiles embed.FS
+
+// Every kind has a text template defining "subject" and "text" and an HTML
+// one defining "content", which the layout wraps.
+var (
+	textTemplates = map[Kind]*textTemplate.Template{}
+	htmlTemplates = map[Kind]*htmlTemplate.Template{}
+)
+
+func init() {
+	for _, kind := range []Kind{KindVerifyEmail, KindResetPassword, KindReviewDecision, KindPayrollStatement} {
+		textTemplates[kind] = textTemplate.Must(
+			textTemplate.ParseFS(templateFiles, "templates/"+string(kind)+".txt"),
+		)
+		htmlTemplates[kind] = htmlTemplate.Must(
+			htmlTemplate.ParseFS(templateFiles, "templates/layout.html", "templates/"+string(kind)+".html"),
+		)
+	}
+}
+
+func render(kind Kind, data interface{}) (subject string, text string, html string, err error) {
+	textTmpl, htmlTmpl := textTemplates[kind], htmlTemplates[kind]
+	if textTmpl == nil || htmlTmpl == nil {
+		return "", "", "", errors.Errorf(errors.ValidationError, "unknown email kind \"%s\"", kind)
+	}
+
+	var buf bytes.Buffer
+
+	if err := textTmpl.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", "", errors.Wrapf(err, errors.ValidationError, "render subject of %s email failed", kind)
+	}
+	subject = strings.TrimSpace(buf.String())
+	buf.Reset()
+
+	if err := textTmpl.ExecuteTemplate(&buf, "text", data); err != nil {
+		return "", "", "", errors.Wrapf(err, errors.ValidationError, "render text of %s email failed", kind)
+	}
+	text = strings.TrimSpace(buf.String()) + "\n"
+	buf.Reset()
+
+	if err := htmlTmpl.ExecuteTemplate(&buf, "layout", data); err != nil {
+		return "", "", "", errors.Wrapf(err, errors.ValidationError, "render html of %s email failed", kind)
+	}
+	html = buf.String()
+
+	return subject, text, html, nil
+}
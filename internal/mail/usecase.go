@@ -0,0 +1,25 @@
+//go:generate mockery --name MailUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package mail
+
+import (
+	"context"
+	"time"
+)
+
+type MailUsecases interface {
+	// Send puts the email in the outbox. Called inside a transaction, the
+	// email goes out only if the transaction commits.
+	Send(ctx context.Context, dto SendDto) error
+	// Dispatch sends a batch of due emails.
+	Dispatch(ctx context.Context) (DispatchDto, error)
+}
+
+type Config interface {
+	// PublicURL is the address of the site links in emails open, without
+	// a trailing slash.
+	PublicURL() string
+	// DispatchInterval is how often due emails are sent.
+	DispatchInterval() time.Duration
+}
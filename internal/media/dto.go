@@ -0,0 +1,131 @@
+package media
+
+import (
+	"io"
+	"strconv"
+
+	"fibo/internal/base/errors"
+)
+
+// UploadDto uploads the image read from Body. Reading stops past the size
+// limit.
+type UploadDto struct {
+	UserId int64
+	Body   io.Reader
+}
+
+type MediaDto struct {
+	Id          int64                      `json:"id"`
+	URL         string                     `json:"url"`
+	ContentType string                     `json:"contentType"`
+	Size        int64                      `json:"size"`
+	Width       int                        `json:"width"`
+	Height      int                        `json:"height"`
+	Variants    map[VariantName]VariantDto `json:"variants"`
+	CreatedAt   string                     `json:"createdAt"`
+}
+
+// MapFromModel makes the dto of the media with the addresses of its files
+// under the public URL.
+func (dto MediaDto) MapFromModel(model MediaModel, publicURL string) MediaDto {
+	dto = MediaDto{
+		Id:          model.Id,
+		URL:         publicURL + "/" + model.Key,
+		ContentType: model.ContentType,
+		Size:        model.Size,
+		Width:       model.Width,
+		Height:      model.Height,
+		Variants:    map[VariantName]VariantDto{},
+		CreatedAt:   model.CreatedAt,
+	}
+
+	for _, variant := range model.Variants {
+		dto.Variants[variant.Name] = VariantDto{
+			URL:    publicURL + "/" + variant.Key,
+			Size:   variant.Size,
+			Width:  variant.Width,
+			Height: variant.Height,
+		}
+	}
+
+	return dto
+}
+
+type VariantDto struct {
+	URL    string `json:"url"`
+	Size   int64  `json:"size"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// MediaKeyDto names a media of the user.
+type MediaKeyDto struct {
+	Id     int64
+	UserId int64
+}
+
+// GetMediaDto asks for a page of the media of the user; Cursor is the
+// NextCursor of the previous page.
+type GetMediaDto struct {
+	UserId int64
+	Limit  uint
+	Cursor string
+}
+
+func (dto GetMediaDto) MapToModel() (ListMediaModel, error) {
+	var beforeId int64
+	if dto.Cursor != "" {
+		var err error
+		if beforeId, err = parseCursor(dto.Cursor); err != nil {
+			return ListMediaModel{}, err
+		}
+	}
+
+	return NewListMedia(dto.UserId, dto.Limit, beforeId), nil
+}
+
+type MediaPageDto struct {
+	Media      []MediaDto `json:"media"`
+	NextCursor string     `json:"next_cursor"`
+}
+
+// MapFromModels makes the page of the media read with one more than the
+// limit, which tells there is a next page.
+func (dto MediaPageDto) MapFromModels(models []MediaModel, limit uint, publicURL string) MediaPageDto {
+	if uint(len(models)) > limit {
+		models = models[:limit]
+		dto.NextCursor = strconv.FormatInt(models[limit-1].Id, 10)
+	}
+
+	dto.Media = []MediaDto{}
+	for _, model := range models {
+		dto.Media = append(dto.Media, MediaDto{}.MapFromModel(model, publicURL))
+	}
+
+	return dto
+}
+
+// FileDto is a stored file. The body must be closed.
+type FileDto struct {
+	ContentType string
+	Size        int64
+	Body        io.ReadCloser
+}
+
+func (dto FileDto) MapFromModel(model ObjectModel) FileDto {
+	return FileDto{
+		ContentType: model.ContentType,
+		Size:        model.Size,
+		Body:        model.Body,
+	}
+}
+
+// parseCursor reads the id of the last media of the previous page.
+func parseCursor(cursor string) (int64, error) {
+	id, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, errors.New(errors.ValidationError, "cursor: is malformed.")
+	}
+
+	return id, nil
+}
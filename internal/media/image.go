@@ -0,0 +1,107 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	// Registered for image.Decode, uploads of GIFs are resized to PNGs.
+	_ "image/gif"
+
+	"fibo/internal/base/errors"
+)
+
+const jpegQuality = 85
+
+// scale makes a copy of the image the given width wide, cropped to a square
+// from the middle if asked. It returns nil when the copy would not be
+// smaller than the image.
+func scale(img image.Image, width int, square bool) *image.RGBA {
+	bounds := img.Bounds()
+
+	area := bounds
+	if square {
+		side := bounds.Dx()
+		if bounds.Dy() < side {
+			side = bounds.Dy()
+		}
+		min := image.Pt(bounds.Min.X+(bounds.Dx()-side)/2, bounds.Min.Y+(bounds.Dy()-side)/2)
+		area = image.Rectangle{Min: min, Max: min.Add(image.Pt(side, side))}
+	}
+	if area.Dx() <= width {
+		return nil
+	}
+
+	height := area.Dy() * width / area.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	src := image.NewRGBA(area)
+	draw.Draw(src, area, img, area.Min, draw.Src)
+
+	return resize(src, width, height)
+}
+
+// resize scales the image down, every pixel of the copy being the average of
+// the pixels it covers.
+func resize(src *image.RGBA, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	bounds := src.Bounds()
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		if y1 == y0 {
+			y1++
+		}
+
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+			if x1 == x0 {
+				x1++
+			}
+
+			var sum [4]uint64
+			for sy := y0; sy < y1; sy++ {
+				i := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					sum[0] += uint64(src.Pix[i])
+					sum[1] += uint64(src.Pix[i+1])
+					sum[2] += uint64(src.Pix[i+2])
+					sum[3] += uint64(src.Pix[i+3])
+					i += 4
+				}
+			}
+
+			count := uint64((x1 - x0) * (y1 - y0))
+			j := dst.PixOffset(x, y)
+			for k := 0; k < 4; k++ {
+				dst.Pix[j+k] = uint8(sum[k] / count)
+			}
+		}
+	}
+
+	return dst
+}
+
+// encode writes a resized copy as a JPEG for JPEG uploads, as a PNG for the
+// others to keep their transparency.
+func encode(img image.Image, contentType string) ([]byte, string, error) {
+	var buf bytes.Buffer
+
+	if contentType == "image/jpeg" {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, "", errors.Wrap(err, errors.InternalError, "encode image failed")
+		}
+		return buf.Bytes(), "image/jpeg", nil
+	}
+
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, "", errors.Wrap(err, errors.InternalError, "encode image failed")
+	}
+	return buf.Bytes(), "image/png", nil
+}
@@ -0,0 +1,279 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/media"
+)
+
+type MediaRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewMediaRepository(opts MediaRepositoryOpts) media.MediaRepository {
+	return &mediaRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type mediaRepository struct {
+	databaseImpl.ConnManager
+}
+
+var mediaColumns = []interface{}{
+	"id",
+	"user_id",
+	"key",
+	"content_type",
+	"size",
+	"width",
+	"height",
+	"created_at",
+}
+
+// Add stores the media and its variants, it should run in a transaction.
+func (r *mediaRepository) Add(ctx context.Context, model media.MediaModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("media").
+		Rows(databaseImpl.Record{
+			"user_id":      model.UserId,
+			"key":          model.Key,
+			"content_type": model.ContentType,
+			"size":         model.Size,
+			"width":        model.Width,
+			"height":       model.Height,
+		}).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add media failed")
+	}
+
+	if len(model.Variants) == 0 {
+		return model.Id, nil
+	}
+
+	rows := make([]interface{}, 0, len(model.Variants))
+	for _, variant := range model.Variants {
+		rows = append(rows, databaseImpl.Record{
+			"media_id":     model.Id,
+			"name":         variant.Name,
+			"key":          variant.Key,
+			"content_type": variant.ContentType,
+			"size":         variant.Size,
+			"width":        variant.Width,
+			"height":       variant.Height,
+		})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("media_variants").
+		Rows(rows...).
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add media variants failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *mediaRepository) GetById(ctx context.Context, mediaId int64) (media.MediaModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("media").
+		Select(mediaColumns...).
+		Where(goqu.Ex{"id": mediaId}).
+		ToSQL()
+	if err != nil {
+		return media.MediaModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanMedia(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return media.MediaModel{}, errors.Errorf(errors.NotFoundError, "media \"%d\" not found", mediaId)
+		}
+		return media.MediaModel{}, errors.Wrap(err, errors.DatabaseError, "get media failed")
+	}
+
+	variants, err := r.getVariants(ctx, []int64{mediaId})
+	if err != nil {
+		return media.MediaModel{}, err
+	}
+	model.Variants = variants[mediaId]
+
+	return model, nil
+}
+
+func (r *mediaRepository) GetByUser(ctx context.Context, list media.ListMediaModel) ([]media.MediaModel, error) {
+	query := databaseImpl.QueryBuilder.
+		From("media").
+		Select(mediaColumns...).
+		Where(goqu.Ex{"user_id": list.UserId}).
+		Order(goqu.I("id").Desc()).
+		Limit(list.Limit + 1)
+
+	if list.BeforeId != 0 {
+		query = query.Where(goqu.I("id").Lt(list.BeforeId))
+	}
+
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get media failed")
+	}
+	defer rows.Close()
+
+	var models []media.MediaModel
+	var ids []int64
+	for rows.Next() {
+		model, err := scanMedia(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan media failed")
+		}
+
+		models = append(models, model)
+		ids = append(ids, model.Id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get media failed")
+	}
+	rows.Close()
+
+	if len(ids) == 0 {
+		return models, nil
+	}
+
+	variants, err := r.getVariants(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range models {
+		models[i].Variants = variants[models[i].Id]
+	}
+
+	return models, nil
+}
+
+func (r *mediaRepository) Delete(ctx context.Context, mediaId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("media").
+		Where(goqu.Ex{"id": mediaId}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete media failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "media \"%d\" not found", mediaId)
+	}
+
+	return nil
+}
+
+func (r *mediaRepository) HasFile(ctx context.Context, key string) (bool, error) {
+	originals := databaseImpl.QueryBuilder.From("media").Select(goqu.L("1")).Where(goqu.Ex{"key": key})
+	variants := databaseImpl.QueryBuilder.From("media_variants").Select(goqu.L("1")).Where(goqu.Ex{"key": key})
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(goqu.L("EXISTS ? OR EXISTS ?", originals, variants)).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var exists bool
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&exists); err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "get media file failed")
+	}
+
+	return exists, nil
+}
+
+// getVariants reads the variants of the media by media id, smallest first.
+func (r *mediaRepository) getVariants(ctx context.Context, mediaIds []int64) (map[int64][]media.VariantModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("media_variants").
+		Select("media_id", "name", "key", "content_type", "size", "width", "height").
+		Where(goqu.Ex{"media_id": mediaIds}).
+		Order(goqu.I("media_id").Asc(), goqu.I("width").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get media variants failed")
+	}
+	defer rows.Close()
+
+	variants := map[int64][]media.VariantModel{}
+	for rows.Next() {
+		var mediaId int64
+		var variant media.VariantModel
+
+		err := rows.Scan(
+			&mediaId,
+			&variant.Name,
+			&variant.Key,
+			&variant.ContentType,
+			&variant.Size,
+			&variant.Width,
+			&variant.Height,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan media variant failed")
+		}
+
+		variants[mediaId] = append(variants[mediaId], variant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get media variants failed")
+	}
+
+	return variants, nil
+}
+
+func scanMedia(row pgx.Row) (media.MediaModel, error) {
+	var model media.MediaModel
+	var createdAt time.Time
+
+	err := row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.Key,
+		&model.ContentType,
+		&model.Size,
+		&model.Width,
+		&model.Height,
+		&createdAt,
+	)
+	if err != nil {
+		return media.MediaModel{}, err
+	}
+	model.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return model, nil
+}
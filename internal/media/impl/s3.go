@@ -0,0 +1,214 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/media"
+)
+
+// emptyPayloadHash is the SHA-256 of an empty body.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+type S3StorageOpts struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// Client defaults to a client with a minute of timeout.
+	Client *http.Client
+}
+
+// NewS3Storage keeps the files in a bucket of an S3 compatible service, such
+// as AWS S3 or MinIO. Requests are signed with AWS Signature Version 4 and
+// address the bucket by path, which every such service supports.
+func NewS3Storage(opts S3StorageOpts) media.Storage {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: time.Minute}
+	}
+	opts.Endpoint = strings.TrimRight(opts.Endpoint, "/")
+
+	return &s3Storage{
+		opts: opts,
+	}
+}
+
+type s3Storage struct {
+	opts S3StorageOpts
+}
+
+func (s *s3Storage) Put(ctx context.Context, file media.FileModel) error {
+	req, err := s.newRequest(ctx, http.MethodPut, file.Key, file.Body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", file.ContentType)
+
+	resp, err := s.do(req, file.Body)
+	if err != nil {
+		return errors.Wrapf(err, errors.InternalError, "put \"%s\" to s3 failed", file.Key)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf(errors.InternalError, "put \"%s\" to s3 failed with %s", file.Key, resp.Status)
+	}
+
+	return nil
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) (media.ObjectModel, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return media.ObjectModel{}, err
+	}
+
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return media.ObjectModel{}, errors.Wrapf(err, errors.InternalError, "get \"%s\" from s3 failed", key)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return media.ObjectModel{}, errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+	}
+	if resp.StatusCode/100 != 2 {
+		resp.Body.Close()
+		return media.ObjectModel{}, errors.Errorf(errors.InternalError, "get \"%s\" from s3 failed with %s", key, resp.Status)
+	}
+
+	return media.ObjectModel{
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        resp.ContentLength,
+		Body:        resp.Body,
+	}, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return errors.Wrapf(err, errors.InternalError, "delete \"%s\" from s3 failed", key)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 && resp.StatusCode != http.StatusNotFound {
+		return errors.Errorf(errors.InternalError, "delete \"%s\" from s3 failed with %s", key, resp.Status)
+	}
+
+	return nil
+}
+
+func (s *s3Storage) newRequest(ctx context.Context, method string, key string, body []byte) (*http.Request, error) {
+	if !media.ValidKey(key) {
+		return nil, errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.opts.Endpoint+"/"+s.opts.Bucket+"/"+key, reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InternalError, "make s3 request for \"%s\" failed", key)
+	}
+
+	return req, nil
+}
+
+func (s *s3Storage) do(req *http.Request, body []byte) (*http.Response, error) {
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		sum := sha256.Sum256(body)
+		payloadHash = hex.EncodeToString(sum[:])
+	}
+
+	signV4(req, payloadHash, s.opts.AccessKey, s.opts.SecretKey, s.opts.Region, time.Now())
+
+	return s.opts.Client.Do(req)
+}
+
+// signV4 signs the request for S3 with AWS Signature Version 4. The host and
+// every header already set are signed.
+func signV4(req *http.Request, payloadHash, accessKey, secretKey, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		trimmed := make([]string, 0, len(values))
+		for _, value := range values {
+			trimmed = append(trimmed, strings.TrimSpace(value))
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), day)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKey+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
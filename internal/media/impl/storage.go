@@ -0,0 +1,132 @@
+package impl
+
+import (
+	"context"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/media"
+)
+
+// NewStorage makes the storage the config picks.
+func NewStorage(config media.StorageConfig) (media.Storage, error) {
+	switch config.Storage() {
+	case "local", "":
+		return NewLocalStorage(LocalStorageOpts{
+			Dir: config.Dir(),
+		}), nil
+	case "s3":
+		return NewS3Storage(S3StorageOpts{
+			Endpoint:  config.S3Endpoint(),
+			Region:    config.S3Region(),
+			Bucket:    config.S3Bucket(),
+			AccessKey: config.S3AccessKey(),
+			SecretKey: config.S3SecretKey(),
+		}), nil
+	}
+
+	return nil, errors.Errorf(errors.ValidationError, "unknown media storage \"%s\"", config.Storage())
+}
+
+type LocalStorageOpts struct {
+	Dir string
+}
+
+// NewLocalStorage keeps the files in the directory, a file per key.
+func NewLocalStorage(opts LocalStorageOpts) media.Storage {
+	return &localStorage{
+		opts: opts,
+	}
+}
+
+type localStorage struct {
+	opts LocalStorageOpts
+}
+
+// Put writes the file next to its place and moves it there, so a file is
+// never read half written.
+func (s *localStorage) Put(ctx context.Context, file media.FileModel) error {
+	path, err := s.path(file.Key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "make directory of \"%s\" failed", file.Key)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.Wrapf(err, errors.InternalError, "write \"%s\" failed", file.Key)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(file.Body); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, errors.InternalError, "write \"%s\" failed", file.Key)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "write \"%s\" failed", file.Key)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "write \"%s\" failed", file.Key)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "write \"%s\" failed", file.Key)
+	}
+
+	return nil
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) (media.ObjectModel, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return media.ObjectModel{}, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return media.ObjectModel{}, errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+		}
+		return media.ObjectModel{}, errors.Wrapf(err, errors.InternalError, "read \"%s\" failed", key)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return media.ObjectModel{}, errors.Wrapf(err, errors.InternalError, "read \"%s\" failed", key)
+	}
+
+	return media.ObjectModel{
+		ContentType: mime.TypeByExtension(filepath.Ext(path)),
+		Size:        info.Size(),
+		Body:        file,
+	}, nil
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, errors.InternalError, "delete \"%s\" failed", key)
+	}
+
+	return nil
+}
+
+// path is where the file of the key is. Only media keys are accepted, other
+// keys could point outside of the directory.
+func (s *localStorage) path(key string) (string, error) {
+	if !media.ValidKey(key) {
+		return "", errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+	}
+
+	return filepath.Join(s.opts.Dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,161 @@
+package impl
+
+import (
+	"context"
+	"io"
+
+	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/media"
+)
+
+type MediaUsecasesOpts struct {
+	TxManager       database.TxManager
+	MediaRepository media.MediaRepository
+	Storage         media.Storage
+	Config          media.Config
+}
+
+func NewMediaUsecases(opts MediaUsecasesOpts) media.MediaUsecases {
+	return &mediaUsecases{
+		TxManager:       opts.TxManager,
+		MediaRepository: opts.MediaRepository,
+		Storage:         opts.Storage,
+		Config:          opts.Config,
+	}
+}
+
+type mediaUsecases struct {
+	database.TxManager
+	media.MediaRepository
+	media.Storage
+	media.Config
+}
+
+// Upload stores the files before the media, so a stored media always has
+// its files. Files left by a failed upload are deleted.
+func (u *mediaUsecases) Upload(ctx context.Context, in media.UploadDto) (media.MediaDto, error) {
+	data, err := io.ReadAll(io.LimitReader(in.Body, u.MaxSize()+1))
+	if err != nil {
+		return media.MediaDto{}, errors.Wrap(err, errors.BadRequestError, "read upload failed")
+	}
+
+	model, files, err := media.NewMedia(in.UserId, data, u.MaxSize())
+	if err != nil {
+		return media.MediaDto{}, err
+	}
+
+	keys := model.Keys()
+	for i, file := range files {
+		if err := u.Storage.Put(ctx, file); err != nil {
+			u.deleteFiles(ctx, keys[:i])
+			return media.MediaDto{}, err
+		}
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		mediaId, err := u.MediaRepository.Add(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		model, err = u.MediaRepository.GetById(ctx, mediaId)
+		return err
+	})
+	if err != nil {
+		u.deleteFiles(ctx, keys)
+		return media.MediaDto{}, err
+	}
+
+	return media.MediaDto{}.MapFromModel(model, u.PublicURL()), nil
+}
+
+func (u *mediaUsecases) GetMedia(ctx context.Context, in media.MediaKeyDto) (media.MediaDto, error) {
+	model, err := u.getOwned(ctx, in)
+	if err != nil {
+		return media.MediaDto{}, err
+	}
+
+	return media.MediaDto{}.MapFromModel(model, u.PublicURL()), nil
+}
+
+func (u *mediaUsecases) GetMyMedia(ctx context.Context, in media.GetMediaDto) (media.MediaPageDto, error) {
+	list, err := in.MapToModel()
+	if err != nil {
+		return media.MediaPageDto{}, err
+	}
+
+	models, err := u.MediaRepository.GetByUser(ctx, list)
+	if err != nil {
+		return media.MediaPageDto{}, err
+	}
+
+	return media.MediaPageDto{}.MapFromModels(models, list.Limit, u.PublicURL()), nil
+}
+
+func (u *mediaUsecases) DeleteMedia(ctx context.Context, in media.MediaKeyDto) error {
+	var model media.MediaModel
+
+	err := u.RunTx(ctx, func(ctx context.Context) error {
+		var err error
+		if model, err = u.getOwned(ctx, in); err != nil {
+			return err
+		}
+
+		return u.MediaRepository.Delete(ctx, model.Id)
+	})
+	if err != nil {
+		return err
+	}
+
+	u.deleteFiles(ctx, model.Keys())
+
+	return nil
+}
+
+func (u *mediaUsecases) GetFile(ctx context.Context, key string) (media.FileDto, error) {
+	if !media.ValidKey(key) {
+		return media.FileDto{}, errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+	}
+
+	// Files of deleted media or of uploads that failed may still be in the
+	// storage.
+	exists, err := u.MediaRepository.HasFile(ctx, key)
+	if err != nil {
+		return media.FileDto{}, err
+	}
+	if !exists {
+		return media.FileDto{}, errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+	}
+
+	object, err := u.Storage.Get(ctx, key)
+	if err != nil {
+		return media.FileDto{}, err
+	}
+
+	return media.FileDto{}.MapFromModel(object), nil
+}
+
+// getOwned reads the media of the user. Media of other users are not found,
+// so their ids tell nothing.
+func (u *mediaUsecases) getOwned(ctx context.Context, in media.MediaKeyDto) (media.MediaModel, error) {
+	model, err := u.MediaRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return media.MediaModel{}, err
+	}
+
+	if model.UserId != in.UserId {
+		return media.MediaModel{}, errors.Errorf(errors.NotFoundError, "media \"%d\" not found", in.Id)
+	}
+
+	return model, nil
+}
+
+// deleteFiles deletes the files as far as it can. A file left behind is no
+// longer served by GetFile, having no media, but stays in the storage, where
+// a MEDIA_PUBLIC_URL pointing at the bucket still reaches it.
+func (u *mediaUsecases) deleteFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		_ = u.Storage.Delete(ctx, key)
+	}
+}
@@ -0,0 +1,196 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"net/http"
+	"regexp"
+
+	"github.com/gofrs/uuid"
+
+	"fibo/internal/base/errors"
+)
+
+// ContentTypes are the image types that can be uploaded, with the extension
+// of the files they are stored in.
+var ContentTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+const (
+	// MaxPixels turns away images that are small files but huge once decoded.
+	MaxPixels = 25 * 1000 * 1000
+
+	DefaultListLimit uint = 20
+	MaxListLimit     uint = 100
+)
+
+type VariantName string
+
+const (
+	VariantOriginal VariantName = "original"
+	VariantThumb    VariantName = "thumb"
+	VariantMedium   VariantName = "medium"
+	VariantLarge    VariantName = "large"
+)
+
+// variantSizes are the resized copies made of every image. Thumbnails are
+// cropped to a square, the others keep the aspect ratio. Copies that would
+// not be smaller than the image are not made.
+var variantSizes = []struct {
+	name   VariantName
+	width  int
+	square bool
+}{
+	{VariantThumb, 200, true},
+	{VariantMedium, 800, false},
+	{VariantLarge, 1600, false},
+}
+
+// keyPattern matches the keys NewMedia makes: "<user id>/<uuid>/<variant>.<ext>".
+var keyPattern = regexp.MustCompile(`^[0-9]+/[0-9a-f-]{36}/[a-z]+\.(jpg|png|gif)$`)
+
+// MediaModel is an uploaded image. Key, ContentType and the sizes are of the
+// file as it was uploaded.
+type MediaModel struct {
+	Id          int64
+	UserId      int64
+	Key         string
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	Variants    []VariantModel
+	CreatedAt   string
+}
+
+type VariantModel struct {
+	Name        VariantName
+	Key         string
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+}
+
+// FileModel is a file to store for a media.
+type FileModel struct {
+	Key         string
+	ContentType string
+	Body        []byte
+}
+
+// NewMedia checks the uploaded image by its content, never by its name, and
+// makes its resized copies. It returns the media with the files to store
+// for it, the upload first.
+func NewMedia(userId int64, data []byte, maxSize int64) (MediaModel, []FileModel, error) {
+	if len(data) == 0 {
+		return MediaModel{}, nil, errors.New(errors.ValidationError, "file: cannot be blank.")
+	}
+	if int64(len(data)) > maxSize {
+		return MediaModel{}, nil, errors.Errorf(errors.ValidationError, "file: must be at most %d bytes.", maxSize)
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := ContentTypes[contentType]
+	if !ok {
+		return MediaModel{}, nil, errors.New(errors.ValidationError, "file: must be a JPEG, PNG or GIF image.")
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return MediaModel{}, nil, errors.New(errors.ValidationError, "file: is not a readable image.")
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > MaxPixels {
+		return MediaModel{}, nil, errors.Errorf(errors.ValidationError, "file: must have at most %d pixels.", MaxPixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return MediaModel{}, nil, errors.New(errors.ValidationError, "file: is not a readable image.")
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return MediaModel{}, nil, errors.Wrap(err, errors.InternalError, "make media key failed")
+	}
+	prefix := fmt.Sprintf("%d/%s/", userId, id)
+
+	media := MediaModel{
+		UserId:      userId,
+		Key:         prefix + string(VariantOriginal) + "." + ext,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Width:       config.Width,
+		Height:      config.Height,
+	}
+	files := []FileModel{{Key: media.Key, ContentType: contentType, Body: data}}
+
+	for _, size := range variantSizes {
+		resized := scale(img, size.width, size.square)
+		if resized == nil {
+			continue
+		}
+
+		body, variantType, err := encode(resized, contentType)
+		if err != nil {
+			return MediaModel{}, nil, err
+		}
+
+		variant := VariantModel{
+			Name:        size.name,
+			Key:         prefix + string(size.name) + "." + ContentTypes[variantType],
+			ContentType: variantType,
+			Size:        int64(len(body)),
+			Width:       resized.Bounds().Dx(),
+			Height:      resized.Bounds().Dy(),
+		}
+		media.Variants = append(media.Variants, variant)
+		files = append(files, FileModel{Key: variant.Key, ContentType: variantType, Body: body})
+	}
+
+	return media, files, nil
+}
+
+// Keys returns the keys of every file of the media.
+func (m MediaModel) Keys() []string {
+	keys := []string{m.Key}
+	for _, variant := range m.Variants {
+		keys = append(keys, variant.Key)
+	}
+
+	return keys
+}
+
+// ValidKey tells whether the key can be one of a media file, so a key from
+// a request can't reach other files of the storage.
+func ValidKey(key string) bool {
+	return keyPattern.MatchString(key)
+}
+
+// ListMediaModel selects a page of the media of a user, the latest first.
+// The page starts right after the BeforeId one.
+type ListMediaModel struct {
+	UserId   int64
+	Limit    uint
+	BeforeId int64
+}
+
+func NewListMedia(userId int64, limit uint, beforeId int64) ListMediaModel {
+	list := ListMediaModel{
+		UserId:   userId,
+		Limit:    limit,
+		BeforeId: beforeId,
+	}
+	if list.Limit == 0 {
+		list.Limit = DefaultListLimit
+	}
+	if list.Limit > MaxListLimit {
+		list.Limit = MaxListLimit
+	}
+
+	return list
+}
@@ -0,0 +1,16 @@
+//go:generate mockery --name MediaRepository --filename repository.go --output ./mock --with-expecter
+
+package media
+
+import (
+	"context"
+)
+
+type MediaRepository interface {
+	Add(ctx context.Context, media MediaModel) (int64, error)
+	GetById(ctx context.Context, mediaId int64) (MediaModel, error)
+	GetByUser(ctx context.Context, list ListMediaModel) ([]MediaModel, error)
+	Delete(ctx context.Context, mediaId int64) error
+	// HasFile tells whether the key is of a file of a media on record.
+	HasFile(ctx context.Context, key string) (bool, error)
+}
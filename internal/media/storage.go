@@ -0,0 +1,39 @@
+//go:generate mockery --name Storage --filename storage.go --output ./mock --with-expecter
+//go:generate mockery --name StorageConfig --filename storage_config.go --output ./mock --with-expecter
+
+package media
+
+import (
+	"context"
+	"io"
+)
+
+// Storage keeps the files of media under their keys.
+type Storage interface {
+	Put(ctx context.Context, file FileModel) error
+	// Get fails with a NotFoundError for a key that holds no file. The body
+	// must be closed.
+	Get(ctx context.Context, key string) (ObjectModel, error)
+	// Delete succeeds for a key that holds no file.
+	Delete(ctx context.Context, key string) error
+}
+
+type ObjectModel struct {
+	ContentType string
+	Size        int64
+	Body        io.ReadCloser
+}
+
+type StorageConfig interface {
+	// Storage is "local" to keep files in Dir or "s3" for an S3 compatible
+	// bucket.
+	Storage() string
+	Dir() string
+	// S3Endpoint is the address of the S3 API, buckets are addressed by path
+	// under it.
+	S3Endpoint() string
+	S3Region() string
+	S3Bucket() string
+	S3AccessKey() string
+	S3SecretKey() string
+}
@@ -0,0 +1,26 @@
+//go:generate mockery --name MediaUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package media
+
+import (
+	"context"
+)
+
+type MediaUsecases interface {
+	Upload(ctx context.Context, in UploadDto) (MediaDto, error)
+	GetMedia(ctx context.Context, in MediaKeyDto) (MediaDto, error)
+	GetMyMedia(ctx context.Context, in GetMediaDto) (MediaPageDto, error)
+	// DeleteMedia removes the media and its files. Posts showing it are left
+	// with broken images.
+	DeleteMedia(ctx context.Context, in MediaKeyDto) error
+	GetFile(ctx context.Context, key string) (FileDto, error)
+}
+
+type Config interface {
+	// MaxSize is the largest upload in bytes.
+	MaxSize() int64
+	// PublicURL is the address files are served under, without a trailing
+	// slash. A file is at PublicURL() + "/" + key.
+	PublicURL() string
+}
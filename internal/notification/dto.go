@@ -0,0 +1,121 @@
+package notification
+
+import (
+	"strconv"
+
+	"fibo/internal/base/errors"
+)
+
+type NotificationDto struct {
+	Id        int64  `json:"id"`
+	Type      Type   `json:"type"`
+	ActorId   int64  `json:"actorId,omitempty"`
+	ActorName string `json:"actorName,omitempty"`
+	PostId    int64  `json:"postId,omitempty"`
+	PostTitle string `json:"postTitle,omitempty"`
+	CommentId int64  `json:"commentId,omitempty"`
+	RunId     int64  `json:"runId,omitempty"`
+	Read      bool   `json:"read"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func (dto NotificationDto) MapFromModel(model NotificationModel) NotificationDto {
+	return NotificationDto{
+		Id:        model.Id,
+		Type:      model.Type,
+		ActorId:   model.ActorId,
+		ActorName: model.ActorName,
+		PostId:    model.PostId,
+		PostTitle: model.PostTitle,
+		CommentId: model.CommentId,
+		RunId:     model.RunId,
+		Read:      model.IsRead(),
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+// GetNotificationsDto asks for a page of notifications; Cursor is the
+// NextCursor of the previous page.
+type GetNotificationsDto struct {
+	UserId     int64
+	UnreadOnly bool
+	Limit      uint
+	Cursor     string
+}
+
+func (dto GetNotificationsDto) MapToModel() (ListNotificationsModel, error) {
+	var beforeId int64
+	if dto.Cursor != "" {
+		var err error
+		if beforeId, err = parseCursor(dto.Cursor); err != nil {
+			return ListNotificationsModel{}, err
+		}
+	}
+
+	return NewListNotifications(dto.UserId, dto.UnreadOnly, dto.Limit, beforeId), nil
+}
+
+type NotificationsPageDto struct {
+	Notifications []NotificationDto `json:"notifications"`
+	NextCursor    string            `json:"next_cursor"`
+}
+
+// MapFromModels makes the page of the notifications read with one more than
+// the limit, which tells there is a next page.
+func (dto NotificationsPageDto) MapFromModels(models []NotificationModel, limit uint) NotificationsPageDto {
+	if uint(len(models)) > limit {
+		models = models[:limit]
+		dto.NextCursor = strconv.FormatInt(models[limit-1].Id, 10)
+	}
+
+	dto.Notifications = []NotificationDto{}
+	for _, model := range models {
+		dto.Notifications = append(dto.Notifications, NotificationDto{}.MapFromModel(model))
+	}
+
+	return dto
+}
+
+// MarkReadDto marks the notifications of the user read, every one of them
+// when Ids is empty.
+type MarkReadDto struct {
+	UserId int64   `json:"-"`
+	Ids    []int64 `json:"ids"`
+}
+
+type UnreadDto struct {
+	Unread int64 `json:"unread"`
+}
+
+type PreferenceDto struct {
+	Type    Type `json:"type"`
+	Enabled bool `json:"enabled"`
+}
+
+func (dto PreferenceDto) MapFromModel(model PreferenceModel) PreferenceDto {
+	return PreferenceDto{
+		Type:    model.Type,
+		Enabled: model.Enabled,
+	}
+}
+
+func (dto PreferenceDto) MapToModel() (PreferenceModel, error) {
+	return NewPreference(dto.Type, dto.Enabled)
+}
+
+// UpdatePreferencesDto changes the preferences of the listed types and
+// leaves the others be.
+type UpdatePreferencesDto struct {
+	UserId      int64           `json:"-"`
+	Preferences []PreferenceDto `json:"preferences"`
+}
+
+// parseCursor reads the id of the last notification of the previous page.
+func parseCursor(cursor string) (int64, error) {
+	id, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, errors.New(errors.ValidationError, "cursor: is malformed.")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,250 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/notification"
+)
+
+type NotificationRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewNotificationRepository(opts NotificationRepositoryOpts) notification.NotificationRepository {
+	return &notificationRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type notificationRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *notificationRepository) Add(ctx context.Context, model notification.NotificationModel) (int64, error) {
+	record := databaseImpl.Record{
+		"user_id": model.UserId,
+		"type":    model.Type,
+	}
+	if model.ActorId != 0 {
+		record["actor_id"] = model.ActorId
+	}
+	if model.PostId != 0 {
+		record["post_id"] = model.PostId
+	}
+	if model.CommentId != 0 {
+		record["comment_id"] = model.CommentId
+	}
+	if model.RunId != 0 {
+		record["run_id"] = model.RunId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("notifications").
+		Rows(record).
+		OnConflict(goqu.DoNothing()).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&model.Id); err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, errors.DatabaseError, "add notification failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *notificationRepository) GetNotifications(
+	ctx context.Context,
+	list notification.ListNotificationsModel,
+) ([]notification.NotificationModel, error) {
+	query := databaseImpl.QueryBuilder.
+		From("notifications").
+		Select(
+			"notifications.id",
+			"notifications.user_id",
+			"notifications.type",
+			"notifications.actor_id",
+			"notifications.post_id",
+			"notifications.comment_id",
+			"notifications.run_id",
+			"notifications.read_at",
+			"notifications.created_at",
+			"users.firstname",
+			"posts.title",
+		).
+		LeftJoin(goqu.T("users"), goqu.On(goqu.Ex{"users.user_id": goqu.I("notifications.actor_id")})).
+		LeftJoin(goqu.T("posts"), goqu.On(goqu.Ex{"posts.id": goqu.I("notifications.post_id")})).
+		Where(goqu.Ex{"notifications.user_id": list.UserId}).
+		Order(goqu.I("notifications.id").Desc()).
+		Limit(list.Limit + 1)
+
+	if list.UnreadOnly {
+		query = query.Where(goqu.Ex{"notifications.read_at": nil})
+	}
+	if list.BeforeId != 0 {
+		query = query.Where(goqu.I("notifications.id").Lt(list.BeforeId))
+	}
+
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get notifications failed")
+	}
+	defer rows.Close()
+
+	var models []notification.NotificationModel
+	for rows.Next() {
+		var model notification.NotificationModel
+		var actorId, postId, commentId, runId sqlS.NullInt64
+		var readAt sqlS.NullTime
+		var createdAt time.Time
+		var actorName, postTitle sqlS.NullString
+
+		err := rows.Scan(
+			&model.Id,
+			&model.UserId,
+			&model.Type,
+			&actorId,
+			&postId,
+			&commentId,
+			&runId,
+			&readAt,
+			&createdAt,
+			&actorName,
+			&postTitle,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan notification failed")
+		}
+		model.ActorId = actorId.Int64
+		model.PostId = postId.Int64
+		model.CommentId = commentId.Int64
+		model.RunId = runId.Int64
+		if readAt.Valid {
+			model.ReadAt = readAt.Time.Format(time.RFC3339)
+		}
+		model.CreatedAt = createdAt.Format(time.RFC3339)
+		model.ActorName = actorName.String
+		model.PostTitle = postTitle.String
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get notifications failed")
+	}
+
+	return models, nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userId int64) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("notifications").
+		Select(goqu.COUNT("*")).
+		Where(goqu.Ex{"user_id": userId, "read_at": nil}).
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var unread int64
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&unread); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count unread notifications failed")
+	}
+
+	return unread, nil
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userId int64, ids []int64) error {
+	where := databaseImpl.Ex{"user_id": userId, "read_at": nil}
+	if len(ids) > 0 {
+		where["id"] = ids
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("notifications").
+		Set(databaseImpl.Record{"read_at": goqu.L("CURRENT_TIMESTAMP")}).
+		Where(where).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "mark notifications read failed")
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) GetDisabledTypes(ctx context.Context, userId int64) ([]notification.Type, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("notification_preferences").
+		Select("type").
+		Where(goqu.Ex{"user_id": userId, "enabled": false}).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get notification preferences failed")
+	}
+	defer rows.Close()
+
+	var disabled []notification.Type
+	for rows.Next() {
+		var notificationType notification.Type
+		if err := rows.Scan(&notificationType); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan notification preference failed")
+		}
+
+		disabled = append(disabled, notificationType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get notification preferences failed")
+	}
+
+	return disabled, nil
+}
+
+func (r *notificationRepository) SetPreference(
+	ctx context.Context,
+	userId int64,
+	preference notification.PreferenceModel,
+) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("notification_preferences").
+		Rows(databaseImpl.Record{
+			"user_id": userId,
+			"type":    preference.Type,
+			"enabled": preference.Enabled,
+		}).
+		OnConflict(goqu.DoUpdate("user_id, type", goqu.Record{"enabled": preference.Enabled})).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set notification preference failed")
+	}
+
+	return nil
+}
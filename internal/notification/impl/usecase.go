@@ -0,0 +1,155 @@
+package impl
+
+import (
+	"context"
+
+	"fibo/internal/base/database"
+	"fibo/internal/base/event"
+	"fibo/internal/notification"
+)
+
+type NotificationUsecasesOpts struct {
+	TxManager              database.TxManager
+	Events                 event.Bus
+	NotificationRepository notification.NotificationRepository
+}
+
+// NewNotificationUsecases records a notification for every event users are
+// told about. It is written in the transaction of the change, so users are
+// not told about changes that are rolled back.
+func NewNotificationUsecases(opts NotificationUsecasesOpts) notification.NotificationUsecases {
+	u := &notificationUsecases{
+		TxManager:              opts.TxManager,
+		NotificationRepository: opts.NotificationRepository,
+	}
+
+	for _, notificationType := range notification.Types {
+		opts.Events.Subscribe(event.Name(notificationType), u.notify)
+	}
+
+	return u
+}
+
+type notificationUsecases struct {
+	database.TxManager
+	notification.NotificationRepository
+}
+
+func (u *notificationUsecases) GetNotifications(
+	ctx context.Context,
+	in notification.GetNotificationsDto,
+) (out notification.NotificationsPageDto, err error) {
+	list, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	models, err := u.NotificationRepository.GetNotifications(ctx, list)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModels(models, list.Limit), nil
+}
+
+func (u *notificationUsecases) GetUnread(ctx context.Context, userId int64) (out notification.UnreadDto, err error) {
+	out.Unread, err = u.NotificationRepository.CountUnread(ctx, userId)
+	if err != nil {
+		return notification.UnreadDto{}, err
+	}
+
+	return out, nil
+}
+
+// MarkRead returns how many notifications are left unread.
+func (u *notificationUsecases) MarkRead(ctx context.Context, in notification.MarkReadDto) (out notification.UnreadDto, err error) {
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.NotificationRepository.MarkRead(ctx, in.UserId, in.Ids); err != nil {
+			return err
+		}
+
+		out.Unread, err = u.NotificationRepository.CountUnread(ctx, in.UserId)
+		return err
+	})
+	if err != nil {
+		return notification.UnreadDto{}, err
+	}
+
+	return out, nil
+}
+
+func (u *notificationUsecases) GetPreferences(ctx context.Context, userId int64) ([]notification.PreferenceDto, error) {
+	disabled, err := u.NotificationRepository.GetDisabledTypes(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPreferences(notification.Preferences(disabled)), nil
+}
+
+func (u *notificationUsecases) UpdatePreferences(
+	ctx context.Context,
+	in notification.UpdatePreferencesDto,
+) (out []notification.PreferenceDto, err error) {
+	preferences := make([]notification.PreferenceModel, 0, len(in.Preferences))
+	for _, dto := range in.Preferences {
+		preference, err := dto.MapToModel()
+		if err != nil {
+			return nil, err
+		}
+
+		preferences = append(preferences, preference)
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		for _, preference := range preferences {
+			if err := u.NotificationRepository.SetPreference(ctx, in.UserId, preference); err != nil {
+				return err
+			}
+		}
+
+		disabled, err := u.NotificationRepository.GetDisabledTypes(ctx, in.UserId)
+		if err != nil {
+			return err
+		}
+
+		out = mapPreferences(notification.Preferences(disabled))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// notify records the notification of the event unless its user turned the
+// type off.
+func (u *notificationUsecases) notify(ctx context.Context, e event.Event) error {
+	model, ok := notification.NewNotification(e)
+	if !ok {
+		return nil
+	}
+
+	disabled, err := u.NotificationRepository.GetDisabledTypes(ctx, model.UserId)
+	if err != nil {
+		return err
+	}
+	for _, t := range disabled {
+		if t == model.Type {
+			return nil
+		}
+	}
+
+	_, err = u.NotificationRepository.Add(ctx, model)
+	return err
+}
+
+func mapPreferences(preferences []notification.PreferenceModel) []notification.PreferenceDto {
+	out := make([]notification.PreferenceDto, 0, len(preferences))
+	for _, preference := range preferences {
+		out = append(out, notification.PreferenceDto{}.MapFromModel(preference))
+	}
+
+	return out
+}
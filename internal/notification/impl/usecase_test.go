@@ -0,0 +1,200 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/notification"
+
+	dbMock "fibo/internal/base/database/mock"
+	eventMock "fibo/internal/base/event/mock"
+	notificationMock "fibo/internal/notification/mock"
+)
+
+func TestNotificationUsecases_Notify(t *testing.T) {
+	commented := event.Event{Name: event.CommentAdded, UserId: 2, ActorId: 3, PostId: 4, CommentId: 5}
+
+	t.Run("expect it subscribes to every type", func(t *testing.T) {
+		prep := newTestPrep()
+
+		for _, notificationType := range notification.Types {
+			require.Contains(t, prep.handlers, event.Name(notificationType))
+		}
+		require.NotContains(t, prep.handlers, event.PostPublished)
+	})
+
+	t.Run("expect it tells the author about the comment", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.notificationRepo.EXPECT().GetDisabledTypes(mock.Anything, int64(2)).Return(nil, nil)
+		prep.notificationRepo.EXPECT().Add(mock.Anything, notification.NotificationModel{
+			UserId:    2,
+			Type:      notification.TypeCommentAdded,
+			ActorId:   3,
+			PostId:    4,
+			CommentId: 5,
+		}).Return(int64(1), nil)
+
+		err := prep.handlers[event.CommentAdded](prep.ctx, commented)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a like given again to be told once", func(t *testing.T) {
+		prep := newTestPrep()
+		liked := event.Event{Name: event.PostLiked, UserId: 2, ActorId: 3, PostId: 4}
+		like := notification.NotificationModel{UserId: 2, Type: notification.TypePostLiked, ActorId: 3, PostId: 4}
+
+		prep.notificationRepo.EXPECT().GetDisabledTypes(mock.Anything, int64(2)).Return(nil, nil)
+		prep.notificationRepo.EXPECT().Add(mock.Anything, like).Return(int64(1), nil).Once()
+		prep.notificationRepo.EXPECT().Add(mock.Anything, like).Return(int64(0), nil).Once()
+
+		require.NoError(t, prep.handlers[event.PostLiked](prep.ctx, liked))
+		require.NoError(t, prep.handlers[event.PostLiked](prep.ctx, liked))
+	})
+
+	t.Run("expect users not to be told what they did", func(t *testing.T) {
+		prep := newTestPrep()
+		own := commented
+		own.ActorId = own.UserId
+
+		err := prep.handlers[event.CommentAdded](prep.ctx, own)
+
+		require.NoError(t, err)
+		prep.notificationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect turned off types to be skipped", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.notificationRepo.EXPECT().GetDisabledTypes(mock.Anything, int64(2)).
+			Return([]notification.Type{notification.TypePostLiked, notification.TypeCommentAdded}, nil)
+
+		err := prep.handlers[event.CommentAdded](prep.ctx, commented)
+
+		require.NoError(t, err)
+		prep.notificationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestNotificationUsecases_GetNotifications(t *testing.T) {
+	t.Run("expect it pages through the notifications", func(t *testing.T) {
+		prep := newTestPrep()
+		models := []notification.NotificationModel{
+			{Id: 9, UserId: 2, Type: notification.TypePostLiked, PostId: 4, PostTitle: "Title"},
+			{Id: 7, UserId: 2, Type: notification.TypeUserFollowed, ActorId: 3, ReadAt: "2026-10-17T10:00:00Z"},
+			{Id: 6, UserId: 2, Type: notification.TypePostApproved, PostId: 4},
+		}
+
+		prep.notificationRepo.EXPECT().GetNotifications(mock.Anything, notification.ListNotificationsModel{
+			UserId:   2,
+			Limit:    2,
+			BeforeId: 10,
+		}).Return(models, nil)
+
+		out, err := prep.notificationUsecases.GetNotifications(prep.ctx, notification.GetNotificationsDto{UserId: 2, Limit: 2, Cursor: "10"})
+
+		require.NoError(t, err)
+		require.Len(t, out.Notifications, 2)
+		require.False(t, out.Notifications[0].Read)
+		require.True(t, out.Notifications[1].Read)
+		require.Equal(t, "7", out.NextCursor)
+	})
+
+	t.Run("expect a malformed cursor to fail", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.notificationUsecases.GetNotifications(prep.ctx, notification.GetNotificationsDto{UserId: 2, Cursor: "abc"})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+}
+
+func TestNotificationUsecases_MarkRead(t *testing.T) {
+	t.Run("expect it returns what is left unread", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.notificationRepo.EXPECT().MarkRead(mock.Anything, int64(2), []int64{6, 7}).Return(nil)
+		prep.notificationRepo.EXPECT().CountUnread(mock.Anything, int64(2)).Return(int64(3), nil)
+
+		out, err := prep.notificationUsecases.MarkRead(prep.ctx, notification.MarkReadDto{UserId: 2, Ids: []int64{6, 7}})
+
+		require.NoError(t, err)
+		require.Equal(t, notification.UnreadDto{Unread: 3}, out)
+	})
+}
+
+func TestNotificationUsecases_UpdatePreferences(t *testing.T) {
+	t.Run("expect it turns the type off", func(t *testing.T) {
+		prep := newTestPrep()
+		off := notification.PreferenceModel{Type: notification.TypePostLiked, Enabled: false}
+
+		prep.notificationRepo.EXPECT().SetPreference(mock.Anything, int64(2), off).Return(nil)
+		prep.notificationRepo.EXPECT().GetDisabledTypes(mock.Anything, int64(2)).
+			Return([]notification.Type{notification.TypePostLiked}, nil)
+
+		out, err := prep.notificationUsecases.UpdatePreferences(prep.ctx, notification.UpdatePreferencesDto{
+			UserId:      2,
+			Preferences: []notification.PreferenceDto{{Type: notification.TypePostLiked}},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out, len(notification.Types))
+		for _, preference := range out {
+			require.Equal(t, preference.Type != notification.TypePostLiked, preference.Enabled)
+		}
+	})
+
+	t.Run("expect an unknown type to fail", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.notificationUsecases.UpdatePreferences(prep.ctx, notification.UpdatePreferencesDto{
+			UserId:      2,
+			Preferences: []notification.PreferenceDto{{Type: "post_published"}},
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.notificationRepo.AssertNotCalled(t, "SetPreference", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx              context.Context
+	notificationRepo *notificationMock.NotificationRepository
+	handlers         map[event.Name]event.Handler
+
+	notificationUsecases notification.NotificationUsecases
+}
+
+func newTestPrep() testPrep {
+	notificationRepo := &notificationMock.NotificationRepository{}
+	txManager := &dbMock.MockTxManager{}
+	events := &eventMock.Bus{}
+	handlers := map[event.Name]event.Handler{}
+
+	events.EXPECT().Subscribe(mock.Anything, mock.Anything).
+		Run(func(name event.Name, handler event.Handler) { handlers[name] = handler })
+
+	notificationUsecasesOpts := NotificationUsecasesOpts{
+		TxManager:              txManager,
+		Events:                 events,
+		NotificationRepository: notificationRepo,
+	}
+	notificationUsecases := NewNotificationUsecases(notificationUsecasesOpts)
+
+	return testPrep{
+		ctx:                  context.Background(),
+		notificationRepo:     notificationRepo,
+		handlers:             handlers,
+		notificationUsecases: notificationUsecases,
+	}
+}
@@ -0,0 +1,140 @@
+package notification
+
+import (
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
+)
+
+// Type tells what a notification is about. It is named after the event the
+// notification comes from.
+type Type string
+
+const (
+	TypePostApproved    = Type(event.PostApproved)
+	TypePostRejected    = Type(event.PostRejected)
+	TypePostLiked       = Type(event.PostLiked)
+	TypeCommentAdded    = Type(event.CommentAdded)
+	TypeUserFollowed    = Type(event.UserFollowed)
+	TypePayrollApproved = Type(event.PayrollApproved)
+)
+
+// Types lists every type of notification in the order settings show them.
+var Types = []Type{
+	TypePostApproved,
+	TypePostRejected,
+	TypeCommentAdded,
+	TypePostLiked,
+	TypeUserFollowed,
+	TypePayrollApproved,
+}
+
+func (t Type) IsValid() bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	DefaultListLimit uint = 20
+	MaxListLimit     uint = 100
+)
+
+// NotificationModel tells a user about something that happened to them.
+// The zero ids don't point to anything. ActorName and PostTitle are read
+// along for listings.
+type NotificationModel struct {
+	Id        int64
+	UserId    int64
+	Type      Type
+	ActorId   int64
+	PostId    int64
+	CommentId int64
+	RunId     int64
+	ReadAt    string
+	CreatedAt string
+	ActorName string
+	PostTitle string
+}
+
+// NewNotification makes the notification the event sends to the user it is
+// about. It reports false when there is nobody to tell, users aren't told
+// what they did themselves.
+func NewNotification(e event.Event) (NotificationModel, bool) {
+	notificationType := Type(e.Name)
+	if !notificationType.IsValid() || e.UserId == 0 || e.UserId == e.ActorId {
+		return NotificationModel{}, false
+	}
+
+	return NotificationModel{
+		UserId:    e.UserId,
+		Type:      notificationType,
+		ActorId:   e.ActorId,
+		PostId:    e.PostId,
+		CommentId: e.CommentId,
+		RunId:     e.RunId,
+	}, true
+}
+
+func (n *NotificationModel) IsRead() bool {
+	return n.ReadAt != ""
+}
+
+// ListNotificationsModel selects a page of notifications of a user, the
+// latest first. The page starts right after the BeforeId one.
+type ListNotificationsModel struct {
+	UserId     int64
+	UnreadOnly bool
+	Limit      uint
+	BeforeId   int64
+}
+
+func NewListNotifications(userId int64, unreadOnly bool, limit uint, beforeId int64) ListNotificationsModel {
+	list := ListNotificationsModel{
+		UserId:     userId,
+		UnreadOnly: unreadOnly,
+		Limit:      limit,
+		BeforeId:   beforeId,
+	}
+	if list.Limit == 0 {
+		list.Limit = DefaultListLimit
+	}
+	if list.Limit > MaxListLimit {
+		list.Limit = MaxListLimit
+	}
+
+	return list
+}
+
+// PreferenceModel tells whether a user wants notifications of the type.
+type PreferenceModel struct {
+	Type    Type
+	Enabled bool
+}
+
+func NewPreference(notificationType Type, enabled bool) (PreferenceModel, error) {
+	if !notificationType.IsValid() {
+		return PreferenceModel{}, errors.Errorf(errors.ValidationError, "unknown notification type \"%s\"", notificationType)
+	}
+
+	return PreferenceModel{Type: notificationType, Enabled: enabled}, nil
+}
+
+// Preferences returns the preference of every type given the types the user
+// turned off. Users get every type until they do.
+func Preferences(disabled []Type) []PreferenceModel {
+	off := map[Type]bool{}
+	for _, t := range disabled {
+		off[t] = true
+	}
+
+	preferences := make([]PreferenceModel, 0, len(Types))
+	for _, t := range Types {
+		preferences = append(preferences, PreferenceModel{Type: t, Enabled: !off[t]})
+	}
+
+	return preferences
+}
@@ -0,0 +1,20 @@
+//go:generate mockery --name NotificationRepository --filename repository.go --output ./mock --with-expecter
+
+package notification
+
+import "context"
+
+type NotificationRepository interface {
+	// Add returns a zero id when the notification is already there: likes
+	// are told once per post and actor, however often the like is toggled.
+	Add(ctx context.Context, notification NotificationModel) (int64, error)
+	// GetNotifications reads one notification more than the limit, so the
+	// caller knows whether there is a next page.
+	GetNotifications(ctx context.Context, list ListNotificationsModel) ([]NotificationModel, error)
+	CountUnread(ctx context.Context, userId int64) (int64, error)
+	// MarkRead marks the notifications of the user read, every one of them
+	// for no ids. Notifications of other users are left alone.
+	MarkRead(ctx context.Context, userId int64, ids []int64) error
+	GetDisabledTypes(ctx context.Context, userId int64) ([]Type, error)
+	SetPreference(ctx context.Context, userId int64, preference PreferenceModel) error
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name NotificationUsecases --filename usecase.go --output ./mock --with-expecter
+
+package notification
+
+import "context"
+
+type NotificationUsecases interface {
+	GetNotifications(ctx context.Context, dto GetNotificationsDto) (NotificationsPageDto, error)
+	GetUnread(ctx context.Context, userId int64) (UnreadDto, error)
+	MarkRead(ctx context.Context, dto MarkReadDto) (UnreadDto, error)
+	GetPreferences(ctx context.Context, userId int64) ([]PreferenceDto, error)
+	UpdatePreferences(ctx context.Context, dto UpdatePreferencesDto) ([]PreferenceDto, error)
+}
@@ -0,0 +1,76 @@
+package payroll
+
+type ComputeRunDto struct {
+	Month   string `json:"month"`
+	Tiers   string `json:"tiers"`
+	ActorId int64  `json:"-"`
+}
+
+type ApproveRunDto struct {
+	RunId   int64 `json:"-"`
+	ActorId int64 `json:"-"`
+}
+
+type RunDto struct {
+	Id          int64           `json:"id"`
+	Month       string          `json:"month"`
+	Status      RunStatus       `json:"status"`
+	Tiers       string          `json:"tiers"`
+	TotalAmount int64           `json:"totalAmount"`
+	CreatedBy   int64           `json:"createdBy"`
+	ApprovedBy  int64           `json:"approvedBy"`
+	CreatedAt   string          `json:"createdAt"`
+	ApprovedAt  string          `json:"approvedAt"`
+	Authors     []AuthorLineDto `json:"authors,omitempty"`
+}
+
+func (dto RunDto) MapFromModel(model RunModel) RunDto {
+	dto.Id = model.Id
+	dto.Month = FormatMonth(model.Month)
+	dto.Status = model.Status
+	dto.Tiers = model.Tiers.String()
+	dto.TotalAmount = model.TotalAmount
+	dto.CreatedBy = model.CreatedBy
+	dto.ApprovedBy = model.ApprovedBy
+	dto.CreatedAt = model.CreatedAt
+	dto.ApprovedAt = model.ApprovedAt
+
+	for _, author := range model.Authors {
+		dto.Authors = append(dto.Authors, AuthorLineDto{}.MapFromModel(author))
+	}
+
+	return dto
+}
+
+type AuthorLineDto struct {
+	UserId     int64         `json:"userId"`
+	Reputation int64         `json:"reputation"`
+	Points     int64         `json:"points"`
+	Rate       int64         `json:"rate"`
+	Amount     int64         `json:"amount"`
+	Posts      []PostLineDto `json:"posts"`
+}
+
+func (dto AuthorLineDto) MapFromModel(model AuthorLineModel) AuthorLineDto {
+	dto.UserId = model.UserId
+	dto.Reputation = model.Reputation
+	dto.Points = model.Points
+	dto.Rate = model.Rate
+	dto.Amount = model.Amount
+	dto.Posts = []PostLineDto{}
+
+	for _, post := range model.Posts {
+		dto.Posts = append(dto.Posts, PostLineDto(post))
+	}
+
+	return dto
+}
+
+type PostLineDto struct {
+	PostId   int64  `json:"postId"`
+	Title    string `json:"title"`
+	Likes    int64  `json:"likes"`
+	Comments int64  `json:"comments"`
+	Points   int64  `json:"points"`
+	Amount   int64  `json:"amount"`
+}
@@ -0,0 +1,508 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/payroll"
+	"fibo/internal/reputation"
+)
+
+type PayrollRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewPayrollRepository(opts PayrollRepositoryOpts) payroll.PayrollRepository {
+	return &payrollRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type payrollRepository struct {
+	databaseImpl.ConnManager
+}
+
+// GetPostPerformance counts the likes and comments the reputation ledger
+// recorded for every post in [from, to). The ledger is append-only, so later
+// unlikes, deleted comments or purged posts don't change a past month. Likes
+// taken back within the month cancel out, but never below zero.
+func (r *payrollRepository) GetPostPerformance(
+	ctx context.Context,
+	from, to time.Time,
+) ([]payroll.PostPerformanceModel, error) {
+	likes := goqu.L(
+		"GREATEST(COUNT(*) FILTER (WHERE reputation_events.event = ?) - COUNT(*) FILTER (WHERE reputation_events.event = ?), 0)",
+		reputation.EventPostLiked,
+		reputation.EventPostUnliked,
+	)
+	comments := goqu.L(
+		"COUNT(*) FILTER (WHERE reputation_events.event = ?)",
+		reputation.EventCommentReceived,
+	)
+	// Likes taken back cost what they earned, but a post earns no less than
+	// nothing from likes.
+	points := goqu.L(
+		"GREATEST(COALESCE(SUM(reputation_rules.points) FILTER (WHERE reputation_events.event IN (?, ?)), 0), 0)"+
+			" + COALESCE(SUM(reputation_rules.points) FILTER (WHERE reputation_events.event = ?), 0)",
+		reputation.EventPostLiked,
+		reputation.EventPostUnliked,
+		reputation.EventCommentReceived,
+	)
+
+	performance := databaseImpl.QueryBuilder.
+		From("reputation_events").
+		Select(
+			goqu.I("reputation_events.post_id").As("post_id"),
+			goqu.I("reputation_events.user_id").As("user_id"),
+			goqu.COALESCE(goqu.MAX("posts.title"), "").As("title"),
+			likes.As("likes"),
+			comments.As("comments"),
+			points.As("points"),
+		).
+		InnerJoin(
+			goqu.T("reputation_rules"),
+			goqu.On(goqu.Ex{"reputation_events.event": goqu.I("reputation_rules.event")}),
+		).
+		LeftJoin(goqu.T("posts"), goqu.On(goqu.Ex{"reputation_events.post_id": goqu.I("posts.id")})).
+		Where(
+			goqu.I("reputation_events.post_id").IsNotNull(),
+			goqu.I("reputation_events.event").In(
+				reputation.EventPostLiked,
+				reputation.EventPostUnliked,
+				reputation.EventCommentReceived,
+			),
+			goqu.I("reputation_events.created_at").Gte(from),
+			goqu.I("reputation_events.created_at").Lt(to),
+		).
+		GroupBy("reputation_events.post_id", "reputation_events.user_id")
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		From(performance.As("performance")).
+		Select("post_id", "user_id", "title", "likes", "comments", "points").
+		Where(goqu.Or(goqu.I("likes").Gt(0), goqu.I("comments").Gt(0))).
+		Order(goqu.I("user_id").Asc(), goqu.I("post_id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get post performance failed")
+	}
+	defer rows.Close()
+
+	var models []payroll.PostPerformanceModel
+	for rows.Next() {
+		var model payroll.PostPerformanceModel
+
+		err := rows.Scan(&model.PostId, &model.UserId, &model.Title, &model.Likes, &model.Comments, &model.Points)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan post performance failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get post performance failed")
+	}
+
+	return models, nil
+}
+
+// GetReputations sums the ledger of the users up to before with the current
+// rules, which is the reputation they had at that time.
+func (r *payrollRepository) GetReputations(
+	ctx context.Context,
+	userIds []int64,
+	before time.Time,
+) (map[int64]int64, error) {
+	reputations := map[int64]int64{}
+	if len(userIds) == 0 {
+		return reputations, nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("reputation_events").
+		Select("reputation_events.user_id", goqu.COALESCE(goqu.SUM("reputation_rules.points"), 0)).
+		InnerJoin(
+			goqu.T("reputation_rules"),
+			goqu.On(goqu.Ex{"reputation_events.event": goqu.I("reputation_rules.event")}),
+		).
+		Where(
+			goqu.I("reputation_events.user_id").In(userIds),
+			goqu.I("reputation_events.created_at").Lt(before),
+		).
+		GroupBy("reputation_events.user_id").
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reputations failed")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userId, points int64
+
+		if err := rows.Scan(&userId, &points); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan reputation failed")
+		}
+
+		reputations[userId] = points
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reputations failed")
+	}
+
+	return reputations, nil
+}
+
+func (r *payrollRepository) AddRun(ctx context.Context, run payroll.RunModel) (int64, error) {
+	record := databaseImpl.Record{
+		"month":        run.Month.Format("2006-01-02"),
+		"status":       run.Status,
+		"tiers":        run.Tiers.String(),
+		"total_amount": run.TotalAmount,
+	}
+	if run.CreatedBy != 0 {
+		record["created_by"] = run.CreatedBy
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("payroll_runs").
+		Rows(record).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&run.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add payroll run failed")
+	}
+
+	if len(run.Authors) == 0 {
+		return run.Id, nil
+	}
+
+	var authors, items []interface{}
+	for _, author := range run.Authors {
+		authors = append(authors, databaseImpl.Record{
+			"run_id":     run.Id,
+			"user_id":    author.UserId,
+			"reputation": author.Reputation,
+			"points":     author.Points,
+			"rate":       author.Rate,
+			"amount":     author.Amount,
+		})
+
+		for _, line := range author.Posts {
+			items = append(items, databaseImpl.Record{
+				"run_id":   run.Id,
+				"user_id":  author.UserId,
+				"post_id":  line.PostId,
+				"title":    line.Title,
+				"likes":    line.Likes,
+				"comments": line.Comments,
+				"points":   line.Points,
+				"amount":   line.Amount,
+			})
+		}
+	}
+
+	if err := r.insert(ctx, "payroll_authors", authors); err != nil {
+		return 0, err
+	}
+	if err := r.insert(ctx, "payroll_items", items); err != nil {
+		return 0, err
+	}
+
+	return run.Id, nil
+}
+
+func (r *payrollRepository) insert(ctx context.Context, table string, rows []interface{}) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert(table).
+		Rows(rows...).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrapf(err, errors.DatabaseError, "add %s failed", table)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) UpdateRunStatus(ctx context.Context, run payroll.RunModel) error {
+	record := databaseImpl.Record{"status": run.Status}
+	if run.Status == payroll.RunApproved {
+		record["approved_at"] = goqu.L("CURRENT_TIMESTAMP")
+		if run.ApprovedBy != 0 {
+			record["approved_by"] = run.ApprovedBy
+		}
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("payroll_runs").
+		Set(record).
+		Where(databaseImpl.Ex{"id": run.Id}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return parseUpdateRunStatusError(run, err)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) SupersedeDrafts(ctx context.Context, month time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("payroll_runs").
+		Set(databaseImpl.Record{"status": payroll.RunSuperseded}).
+		Where(databaseImpl.Ex{"month": month.Format("2006-01-02"), "status": payroll.RunDraft}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "supersede payroll runs failed")
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) GetRunById(ctx context.Context, runId int64) (payroll.RunModel, error) {
+	sql, _, err := selectRuns().
+		Where(databaseImpl.Ex{"id": runId}).
+		ToSQL()
+	if err != nil {
+		return payroll.RunModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	run, err := scanRun(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return payroll.RunModel{}, parseGetRunError(runId, err)
+	}
+
+	run.Authors, err = r.getRunLines(ctx, runId)
+	if err != nil {
+		return payroll.RunModel{}, err
+	}
+
+	return run, nil
+}
+
+func (r *payrollRepository) getRunLines(ctx context.Context, runId int64) ([]payroll.AuthorLineModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("payroll_authors").
+		Select("user_id", "reputation", "points", "rate", "amount").
+		Where(databaseImpl.Ex{"run_id": runId}).
+		Order(goqu.I("user_id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get payroll authors failed")
+	}
+	defer rows.Close()
+
+	authors := []payroll.AuthorLineModel{}
+	index := map[int64]int{}
+	for rows.Next() {
+		var author payroll.AuthorLineModel
+
+		if err := rows.Scan(&author.UserId, &author.Reputation, &author.Points, &author.Rate, &author.Amount); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan payroll author failed")
+		}
+
+		index[author.UserId] = len(authors)
+		authors = append(authors, author)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get payroll authors failed")
+	}
+	rows.Close()
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		From("payroll_items").
+		Select("user_id", "post_id", "title", "likes", "comments", "points", "amount").
+		Where(databaseImpl.Ex{"run_id": runId}).
+		Order(goqu.I("user_id").Asc(), goqu.I("post_id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err = r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get payroll items failed")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userId int64
+		var line payroll.PostLineModel
+
+		err := rows.Scan(&userId, &line.PostId, &line.Title, &line.Likes, &line.Comments, &line.Points, &line.Amount)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan payroll item failed")
+		}
+
+		if i, ok := index[userId]; ok {
+			authors[i].Posts = append(authors[i].Posts, line)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get payroll items failed")
+	}
+
+	return authors, nil
+}
+
+func (r *payrollRepository) GetRunsByMonth(ctx context.Context, month time.Time) ([]payroll.RunModel, error) {
+	sql, _, err := selectRuns().
+		Where(databaseImpl.Ex{"month": month.Format("2006-01-02")}).
+		Order(goqu.I("id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.queryRuns(ctx, sql)
+}
+
+func (r *payrollRepository) GetRuns(ctx context.Context) ([]payroll.RunModel, error) {
+	sql, _, err := selectRuns().
+		Order(goqu.I("month").Desc(), goqu.I("id").Desc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.queryRuns(ctx, sql)
+}
+
+func (r *payrollRepository) queryRuns(ctx context.Context, sql string) ([]payroll.RunModel, error) {
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get payroll runs failed")
+	}
+	defer rows.Close()
+
+	var models []payroll.RunModel
+	for rows.Next() {
+		model, err := scanRun(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan payroll run failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get payroll runs failed")
+	}
+
+	return models, nil
+}
+
+func selectRuns() *goqu.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		From("payroll_runs").
+		Select(
+			"id",
+			"month",
+			"status",
+			"tiers",
+			"total_amount",
+			"created_by",
+			"approved_by",
+			"created_at",
+			"approved_at",
+		)
+}
+
+func scanRun(row pgx.Row) (payroll.RunModel, error) {
+	var model payroll.RunModel
+	var tiers string
+	var createdBy sqlS.NullInt64
+	var approvedBy sqlS.NullInt64
+	var createdAt time.Time
+	var approvedAt sqlS.NullTime
+
+	err := row.Scan(
+		&model.Id,
+		&model.Month,
+		&model.Status,
+		&tiers,
+		&model.TotalAmount,
+		&createdBy,
+		&approvedBy,
+		&createdAt,
+		&approvedAt,
+	)
+	if err != nil {
+		return payroll.RunModel{}, err
+	}
+
+	model.Tiers, err = payroll.ParseTiers(tiers)
+	if err != nil {
+		return payroll.RunModel{}, err
+	}
+
+	model.CreatedBy = createdBy.Int64
+	model.ApprovedBy = approvedBy.Int64
+	model.CreatedAt = createdAt.Format(time.RFC3339)
+	if approvedAt.Valid {
+		model.ApprovedAt = approvedAt.Time.Format(time.RFC3339)
+	}
+
+	return model, nil
+}
+
+func parseGetRunError(runId int64, err error) error {
+	if err == pgx.ErrNoRows {
+		return errors.Wrapf(err, errors.NotFoundError, "payroll run with id \"%d\" not found", runId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get payroll run failed")
+}
+
+func parseUpdateRunStatusError(run payroll.RunModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(
+			err,
+			errors.AlreadyExistsError,
+			"payroll for %s is already approved",
+			payroll.FormatMonth(run.Month),
+		)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "update payroll run failed")
+}
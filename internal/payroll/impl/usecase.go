@@ -0,0 +1,188 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/payroll"
+)
+
+type PayrollUsecasesOpts struct {
+	TxManager         database.TxManager
+	PayrollRepository payroll.PayrollRepository
+	Config            payroll.Config
+	Events            event.Bus
+}
+
+func NewPayrollUsecases(opts PayrollUsecasesOpts) payroll.PayrollUsecases {
+	return &payrollUsecases{
+		TxManager:         opts.TxManager,
+		PayrollRepository: opts.PayrollRepository,
+		Config:            opts.Config,
+		Bus:               opts.Events,
+	}
+}
+
+type payrollUsecases struct {
+	database.TxManager
+	payroll.PayrollRepository
+	payroll.Config
+	event.Bus
+}
+
+func (u *payrollUsecases) Preview(ctx context.Context, in payroll.ComputeRunDto) (out payroll.RunDto, err error) {
+	run, err := u.compute(ctx, in)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(run), nil
+}
+
+// Compute stores a new draft run for a finished month. Earlier drafts of the
+// month are superseded; an approved month can't be computed again.
+func (u *payrollUsecases) Compute(ctx context.Context, in payroll.ComputeRunDto) (out payroll.RunDto, err error) {
+	month, err := payroll.ParseMonth(in.Month)
+	if err != nil {
+		return out, err
+	}
+	if month.AddDate(0, 1, 0).After(time.Now().UTC()) {
+		return out, errors.Errorf(
+			errors.ValidationError,
+			"payroll for %s can be computed once the month is over",
+			in.Month,
+		)
+	}
+
+	var run payroll.RunModel
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		runs, err := u.PayrollRepository.GetRunsByMonth(ctx, month)
+		if err != nil {
+			return err
+		}
+		for _, existing := range runs {
+			if existing.Status == payroll.RunApproved {
+				return errors.Errorf(errors.AlreadyExistsError, "payroll for %s is already approved", in.Month)
+			}
+		}
+
+		if err := u.PayrollRepository.SupersedeDrafts(ctx, month); err != nil {
+			return err
+		}
+
+		run, err = u.compute(ctx, in)
+		if err != nil {
+			return err
+		}
+
+		runId, err := u.PayrollRepository.AddRun(ctx, run)
+		if err != nil {
+			return err
+		}
+
+		run, err = u.PayrollRepository.GetRunById(ctx, runId)
+		return err
+	})
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(run), nil
+}
+
+func (u *payrollUsecases) Approve(ctx context.Context, in payroll.ApproveRunDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		run, err := u.PayrollRepository.GetRunById(ctx, in.RunId)
+		if err != nil {
+			return err
+		}
+		if err := run.Approve(in.ActorId); err != nil {
+			return err
+		}
+
+		if err := u.PayrollRepository.UpdateRunStatus(ctx, run); err != nil {
+			return err
+		}
+
+		for _, author := range run.Authors {
+			err := u.Bus.Publish(ctx, event.Event{
+				Name:    event.PayrollApproved,
+				UserId:  author.UserId,
+				ActorId: in.ActorId,
+				RunId:   run.Id,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (u *payrollUsecases) GetRun(ctx context.Context, runId int64) (out payroll.RunDto, err error) {
+	run, err := u.PayrollRepository.GetRunById(ctx, runId)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(run), nil
+}
+
+func (u *payrollUsecases) GetRuns(ctx context.Context) (out []payroll.RunDto, err error) {
+	runs, err := u.PayrollRepository.GetRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []payroll.RunDto{}
+	for _, run := range runs {
+		out = append(out, payroll.RunDto{}.MapFromModel(run))
+	}
+
+	return out, nil
+}
+
+// compute builds the run from the month's performance and the reputation of
+// its authors at the end of the month. The tiers of the request override the
+// configured ones.
+func (u *payrollUsecases) compute(ctx context.Context, in payroll.ComputeRunDto) (payroll.RunModel, error) {
+	month, err := payroll.ParseMonth(in.Month)
+	if err != nil {
+		return payroll.RunModel{}, err
+	}
+
+	tiersValue := in.Tiers
+	if tiersValue == "" {
+		tiersValue = u.Config.Tiers()
+	}
+	tiers, err := payroll.ParseTiers(tiersValue)
+	if err != nil {
+		return payroll.RunModel{}, err
+	}
+
+	to := month.AddDate(0, 1, 0)
+	performance, err := u.PayrollRepository.GetPostPerformance(ctx, month, to)
+	if err != nil {
+		return payroll.RunModel{}, err
+	}
+
+	userIds := []int64{}
+	seen := map[int64]bool{}
+	for _, p := range performance {
+		if !seen[p.UserId] {
+			seen[p.UserId] = true
+			userIds = append(userIds, p.UserId)
+		}
+	}
+	reputations, err := u.PayrollRepository.GetReputations(ctx, userIds, to)
+	if err != nil {
+		return payroll.RunModel{}, err
+	}
+
+	return payroll.NewRun(month, tiers, in.ActorId, performance, reputations)
+}
@@ -0,0 +1,265 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/payroll"
+
+	dbMock "fibo/internal/base/database/mock"
+	eventMock "fibo/internal/base/event/mock"
+	payrollMock "fibo/internal/payroll/mock"
+)
+
+func TestPayrollUsecases_Preview(t *testing.T) {
+	month := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	to := month.AddDate(0, 1, 0)
+	tiers := "0:100,50:150"
+
+	performance := []payroll.PostPerformanceModel{
+		{PostId: 4, UserId: 2, Title: "Second", Likes: 30, Comments: 10, Points: 40},
+		{PostId: 7, UserId: 3, Title: "Other", Likes: 5, Comments: 0, Points: 5},
+		{PostId: 1, UserId: 2, Title: "First", Likes: 15, Comments: 5, Points: 20},
+		{PostId: 9, UserId: 3, Title: "Quiet", Likes: 0, Comments: 0},
+	}
+	reputations := map[int64]int64{2: 80, 3: 12}
+
+	t.Run("expect it applies author tier to every post", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().Tiers().Return(tiers)
+		prep.payrollRepo.EXPECT().GetPostPerformance(mock.Anything, month, to).Return(performance, nil)
+		prep.payrollRepo.EXPECT().GetReputations(mock.Anything, []int64{2, 3}, to).Return(reputations, nil)
+
+		out, err := prep.payrollUsecases.Preview(prep.ctx, payroll.ComputeRunDto{Month: "2026-09"})
+
+		require.NoError(t, err)
+		require.Equal(t, payroll.RunDto{
+			Month:       "2026-09",
+			Status:      payroll.RunDraft,
+			Tiers:       tiers,
+			TotalAmount: 60*150 + 5*100,
+			Authors: []payroll.AuthorLineDto{
+				{
+					UserId:     2,
+					Reputation: 80,
+					Points:     60,
+					Rate:       150,
+					Amount:     60 * 150,
+					Posts: []payroll.PostLineDto{
+						{PostId: 1, Title: "First", Likes: 15, Comments: 5, Points: 20, Amount: 20 * 150},
+						{PostId: 4, Title: "Second", Likes: 30, Comments: 10, Points: 40, Amount: 40 * 150},
+					},
+				},
+				{
+					UserId:     3,
+					Reputation: 12,
+					Points:     5,
+					Rate:       100,
+					Amount:     5 * 100,
+					Posts: []payroll.PostLineDto{
+						{PostId: 7, Title: "Other", Likes: 5, Points: 5, Amount: 5 * 100},
+					},
+				},
+			},
+		}, out)
+	})
+
+	t.Run("expect tier to follow reputation rather than points", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().Tiers().Return(tiers)
+		prep.payrollRepo.EXPECT().GetPostPerformance(mock.Anything, month, to).Return(performance, nil)
+		prep.payrollRepo.EXPECT().GetReputations(mock.Anything, []int64{2, 3}, to).
+			Return(map[int64]int64{2: 10, 3: 50}, nil)
+
+		out, err := prep.payrollUsecases.Preview(prep.ctx, payroll.ComputeRunDto{Month: "2026-09"})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(100), out.Authors[0].Rate)
+		require.Equal(t, int64(150), out.Authors[1].Rate)
+		require.Equal(t, int64(60*100+5*150), out.TotalAmount)
+	})
+
+	t.Run("expect same performance to give the same run", func(t *testing.T) {
+		prep := newTestPrep()
+		reversed := make([]payroll.PostPerformanceModel, len(performance))
+		for i, p := range performance {
+			reversed[len(performance)-1-i] = p
+		}
+
+		prep.config.EXPECT().Tiers().Return(tiers)
+		prep.payrollRepo.EXPECT().GetPostPerformance(mock.Anything, month, to).Return(performance, nil).Once()
+		prep.payrollRepo.EXPECT().GetPostPerformance(mock.Anything, month, to).Return(reversed, nil).Once()
+		prep.payrollRepo.EXPECT().GetReputations(mock.Anything, mock.Anything, to).Return(reputations, nil)
+
+		first, err := prep.payrollUsecases.Preview(prep.ctx, payroll.ComputeRunDto{Month: "2026-09"})
+		require.NoError(t, err)
+		second, err := prep.payrollUsecases.Preview(prep.ctx, payroll.ComputeRunDto{Month: "2026-09"})
+		require.NoError(t, err)
+
+		require.Equal(t, first, second)
+	})
+
+	t.Run("expect request tiers to override configured ones", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.payrollRepo.EXPECT().GetPostPerformance(mock.Anything, month, to).Return(performance, nil)
+		prep.payrollRepo.EXPECT().GetReputations(mock.Anything, []int64{2, 3}, to).Return(reputations, nil)
+
+		out, err := prep.payrollUsecases.Preview(prep.ctx, payroll.ComputeRunDto{Month: "2026-09", Tiers: "0:10"})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(65*10), out.TotalAmount)
+	})
+
+	t.Run("expect posts to be paid the points the rules give", func(t *testing.T) {
+		prep := newTestPrep()
+		valued := []payroll.PostPerformanceModel{{PostId: 1, UserId: 2, Title: "First", Likes: 3, Comments: 1, Points: 11}}
+
+		prep.payrollRepo.EXPECT().GetPostPerformance(mock.Anything, month, to).Return(valued, nil)
+		prep.payrollRepo.EXPECT().GetReputations(mock.Anything, []int64{2}, to).Return(reputations, nil)
+
+		out, err := prep.payrollUsecases.Preview(prep.ctx, payroll.ComputeRunDto{Month: "2026-09", Tiers: "0:10"})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(11), out.Authors[0].Posts[0].Points)
+		require.Equal(t, int64(11*10), out.TotalAmount)
+	})
+
+	t.Run("expect it fails if tiers are malformed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.payrollUsecases.Preview(prep.ctx, payroll.ComputeRunDto{Month: "2026-09", Tiers: "0-10"})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+}
+
+func TestPayrollUsecases_Compute(t *testing.T) {
+	month := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	in := payroll.ComputeRunDto{Month: "2026-09", Tiers: "0:100", ActorId: 1}
+	performance := []payroll.PostPerformanceModel{{PostId: 1, UserId: 2, Title: "First", Likes: 3, Points: 3}}
+
+	t.Run("expect it supersedes drafts and stores a new run", func(t *testing.T) {
+		prep := newTestPrep()
+		stored := payroll.RunModel{Id: 8, Month: month, Status: payroll.RunDraft}
+
+		prep.payrollRepo.EXPECT().GetRunsByMonth(mock.Anything, month).
+			Return([]payroll.RunModel{{Id: 5, Month: month, Status: payroll.RunDraft}}, nil)
+		prep.payrollRepo.EXPECT().SupersedeDrafts(mock.Anything, month).Return(nil)
+		prep.payrollRepo.EXPECT().GetPostPerformance(mock.Anything, month, month.AddDate(0, 1, 0)).Return(performance, nil)
+		prep.payrollRepo.EXPECT().GetReputations(mock.Anything, []int64{2}, month.AddDate(0, 1, 0)).
+			Return(map[int64]int64{2: 30}, nil)
+		prep.payrollRepo.EXPECT().AddRun(mock.Anything, mock.MatchedBy(func(run payroll.RunModel) bool {
+			return run.TotalAmount == 300 && run.CreatedBy == in.ActorId && len(run.Authors) == 1
+		})).Return(stored.Id, nil)
+		prep.payrollRepo.EXPECT().GetRunById(mock.Anything, stored.Id).Return(stored, nil)
+
+		out, err := prep.payrollUsecases.Compute(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, stored.Id, out.Id)
+	})
+
+	t.Run("expect it fails if month is already approved", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.payrollRepo.EXPECT().GetRunsByMonth(mock.Anything, month).
+			Return([]payroll.RunModel{{Id: 5, Month: month, Status: payroll.RunApproved}}, nil)
+
+		_, err := prep.payrollUsecases.Compute(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.AlreadyExistsError, baseErr.Status())
+	})
+
+	t.Run("expect it fails if month is not over", func(t *testing.T) {
+		prep := newTestPrep()
+		current := in
+		current.Month = payroll.FormatMonth(time.Now().UTC())
+
+		_, err := prep.payrollUsecases.Compute(prep.ctx, current)
+
+		require.Error(t, err)
+	})
+}
+
+func TestPayrollUsecases_Approve(t *testing.T) {
+	in := payroll.ApproveRunDto{RunId: 5, ActorId: 1}
+	getRun := payroll.RunModel{
+		Id:      in.RunId,
+		Status:  payroll.RunDraft,
+		Authors: []payroll.AuthorLineModel{{UserId: 2}, {UserId: 3}},
+	}
+
+	t.Run("expect it approves draft run", func(t *testing.T) {
+		prep := newTestPrep()
+		approved := getRun
+		approved.Status = payroll.RunApproved
+		approved.ApprovedBy = in.ActorId
+
+		prep.payrollRepo.EXPECT().GetRunById(mock.Anything, in.RunId).Return(getRun, nil)
+		prep.payrollRepo.EXPECT().UpdateRunStatus(mock.Anything, approved).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.PayrollApproved, UserId: 2, ActorId: 1, RunId: 5}).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.PayrollApproved, UserId: 3, ActorId: 1, RunId: 5}).Return(nil)
+
+		err := prep.payrollUsecases.Approve(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if run is superseded", func(t *testing.T) {
+		prep := newTestPrep()
+		superseded := getRun
+		superseded.Status = payroll.RunSuperseded
+
+		prep.payrollRepo.EXPECT().GetRunById(mock.Anything, in.RunId).Return(superseded, nil)
+
+		err := prep.payrollUsecases.Approve(prep.ctx, in)
+
+		require.Error(t, err)
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx         context.Context
+	config      *payrollMock.Config
+	payrollRepo *payrollMock.PayrollRepository
+	events      *eventMock.Bus
+
+	payrollUsecases payroll.PayrollUsecases
+}
+
+func newTestPrep() testPrep {
+	config := &payrollMock.Config{}
+	payrollRepo := &payrollMock.PayrollRepository{}
+	events := &eventMock.Bus{}
+	txManager := &dbMock.MockTxManager{}
+
+	payrollUsecasesOpts := PayrollUsecasesOpts{
+		TxManager:         txManager,
+		PayrollRepository: payrollRepo,
+		Config:            config,
+		Events:            events,
+	}
+	payrollUsecases := NewPayrollUsecases(payrollUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		config:          config,
+		payrollRepo:     payrollRepo,
+		events:          events,
+		payrollUsecases: payrollUsecases,
+	}
+}
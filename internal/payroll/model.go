@@ -0,0 +1,162 @@
+package payroll
+
+import (
+	"sort"
+	"time"
+
+	"fibo/internal/base/errors"
+)
+
+const monthLayout = "2006-01"
+
+type RunStatus string
+
+const (
+	RunDraft      RunStatus = "draft"
+	RunApproved   RunStatus = "approved"
+	RunSuperseded RunStatus = "superseded"
+)
+
+// PostPerformanceModel is what a post collected during the month, as recorded
+// in the reputation ledger. Points are what the likes and comments are worth
+// by the reputation rules.
+type PostPerformanceModel struct {
+	PostId   int64
+	UserId   int64
+	Title    string
+	Likes    int64
+	Comments int64
+	Points   int64
+}
+
+// RunModel is a computed payroll for one month. Line items are never changed
+// once stored; recomputing a month produces a new run.
+type RunModel struct {
+	Id          int64
+	Month       time.Time
+	Status      RunStatus
+	Tiers       Tiers
+	TotalAmount int64
+	CreatedBy   int64
+	ApprovedBy  int64
+	CreatedAt   string
+	ApprovedAt  string
+	Authors     []AuthorLineModel
+}
+
+type AuthorLineModel struct {
+	UserId     int64
+	Reputation int64
+	Points     int64
+	Rate       int64
+	Amount     int64
+	Posts      []PostLineModel
+}
+
+type PostLineModel struct {
+	PostId   int64
+	Title    string
+	Likes    int64
+	Comments int64
+	Points   int64
+	Amount   int64
+}
+
+// NewRun computes the payroll of the month. Every author is paid the rate of
+// the tier their reputation at the end of the month reaches. Authors and posts
+// are ordered by id so the same performance always yields the same run.
+func NewRun(
+	month time.Time,
+	tiers Tiers,
+	createdBy int64,
+	performance []PostPerformanceModel,
+	reputations map[int64]int64,
+) (RunModel, error) {
+	if len(tiers) == 0 {
+		return RunModel{}, errors.New(errors.ValidationError, "payroll tiers are empty")
+	}
+
+	sorted := make([]PostPerformanceModel, 0, len(performance))
+	for _, p := range performance {
+		if p.Points > 0 {
+			sorted = append(sorted, p)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].UserId != sorted[j].UserId {
+			return sorted[i].UserId < sorted[j].UserId
+		}
+		return sorted[i].PostId < sorted[j].PostId
+	})
+
+	run := RunModel{
+		Month:     StartOfMonth(month),
+		Status:    RunDraft,
+		Tiers:     tiers,
+		CreatedBy: createdBy,
+		Authors:   []AuthorLineModel{},
+	}
+
+	for i := 0; i < len(sorted); {
+		author := AuthorLineModel{UserId: sorted[i].UserId, Reputation: reputations[sorted[i].UserId]}
+
+		j := i
+		for ; j < len(sorted) && sorted[j].UserId == author.UserId; j++ {
+			author.Points += sorted[j].Points
+		}
+		author.Rate = tiers.RateFor(author.Reputation)
+
+		for _, p := range sorted[i:j] {
+			line := PostLineModel{
+				PostId:   p.PostId,
+				Title:    p.Title,
+				Likes:    p.Likes,
+				Comments: p.Comments,
+				Points:   p.Points,
+				Amount:   p.Points * author.Rate,
+			}
+			author.Amount += line.Amount
+			author.Posts = append(author.Posts, line)
+		}
+
+		run.TotalAmount += author.Amount
+		run.Authors = append(run.Authors, author)
+		i = j
+	}
+
+	return run, nil
+}
+
+func (run *RunModel) Approve(actorId int64) error {
+	if run.Status != RunDraft {
+		return errors.Errorf(
+			errors.ValidationError,
+			"payroll run \"%d\" is %s and cannot be approved",
+			run.Id,
+			run.Status,
+		)
+	}
+
+	run.Status = RunApproved
+	run.ApprovedBy = actorId
+
+	return nil
+}
+
+// ParseMonth reads a month written as "YYYY-MM".
+func ParseMonth(value string) (time.Time, error) {
+	month, err := time.Parse(monthLayout, value)
+	if err != nil {
+		return time.Time{}, errors.Errorf(errors.ValidationError, "month \"%s\" must look like \"YYYY-MM\"", value)
+	}
+
+	return month, nil
+}
+
+func StartOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func FormatMonth(t time.Time) string {
+	return t.Format(monthLayout)
+}
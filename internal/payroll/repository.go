@@ -0,0 +1,19 @@
+//go:generate mockery --name PayrollRepository --filename repository.go --output ./mock --with-expecter
+
+package payroll
+
+import (
+	"context"
+	"time"
+)
+
+type PayrollRepository interface {
+	GetPostPerformance(ctx context.Context, from, to time.Time) ([]PostPerformanceModel, error)
+	GetReputations(ctx context.Context, userIds []int64, before time.Time) (map[int64]int64, error)
+	AddRun(ctx context.Context, run RunModel) (int64, error)
+	UpdateRunStatus(ctx context.Context, run RunModel) error
+	SupersedeDrafts(ctx context.Context, month time.Time) error
+	GetRunById(ctx context.Context, runId int64) (RunModel, error)
+	GetRunsByMonth(ctx context.Context, month time.Time) ([]RunModel, error)
+	GetRuns(ctx context.Context) ([]RunModel, error)
+}
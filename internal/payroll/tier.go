@@ -0,0 +1,85 @@
+package payroll
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"fibo/internal/base/errors"
+)
+
+// Tier pays RatePerPoint for every point earned during the month by an author
+// whose reputation is at least MinReputation.
+type Tier struct {
+	MinReputation int64
+	RatePerPoint  int64
+}
+
+// Tiers is a rate table ordered by MinReputation.
+type Tiers []Tier
+
+// ParseTiers reads a rate table written as "minReputation:rate" pairs separated
+// by commas, e.g. "0:100,50:150,200:200".
+func ParseTiers(value string) (Tiers, error) {
+	var tiers Tiers
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 {
+			return nil, errors.Errorf(errors.ValidationError, "payroll tier \"%s\" must look like \"minReputation:rate\"", pair)
+		}
+
+		minReputation, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+		if err != nil || minReputation < 0 {
+			return nil, errors.Errorf(errors.ValidationError, "payroll tier \"%s\" has invalid minimum reputation", pair)
+		}
+		rate, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil || rate < 0 {
+			return nil, errors.Errorf(errors.ValidationError, "payroll tier \"%s\" has invalid rate", pair)
+		}
+
+		tiers = append(tiers, Tier{MinReputation: minReputation, RatePerPoint: rate})
+	}
+
+	if len(tiers) == 0 {
+		return nil, errors.New(errors.ValidationError, "payroll tiers are empty")
+	}
+
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].MinReputation < tiers[j].MinReputation })
+	for i := 1; i < len(tiers); i++ {
+		if tiers[i].MinReputation == tiers[i-1].MinReputation {
+			return nil, errors.Errorf(errors.ValidationError, "payroll tier for %d reputation is set twice", tiers[i].MinReputation)
+		}
+	}
+
+	return tiers, nil
+}
+
+// RateFor returns the rate of the highest tier the reputation reaches.
+func (tiers Tiers) RateFor(reputation int64) int64 {
+	var rate int64
+
+	for _, tier := range tiers {
+		if reputation < tier.MinReputation {
+			break
+		}
+		rate = tier.RatePerPoint
+	}
+
+	return rate
+}
+
+func (tiers Tiers) String() string {
+	pairs := make([]string, 0, len(tiers))
+	for _, tier := range tiers {
+		pairs = append(pairs, fmt.Sprintf("%d:%d", tier.MinReputation, tier.RatePerPoint))
+	}
+
+	return strings.Join(pairs, ",")
+}
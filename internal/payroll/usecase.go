@@ -0,0 +1,18 @@
+//go:generate mockery --name PayrollUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package payroll
+
+import "context"
+
+type PayrollUsecases interface {
+	Preview(ctx context.Context, dto ComputeRunDto) (RunDto, error)
+	Compute(ctx context.Context, dto ComputeRunDto) (RunDto, error)
+	Approve(ctx context.Context, dto ApproveRunDto) error
+	GetRun(ctx context.Context, runId int64) (RunDto, error)
+	GetRuns(ctx context.Context) ([]RunDto, error)
+}
+
+type Config interface {
+	Tiers() string
+}
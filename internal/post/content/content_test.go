@@ -0,0 +1,222 @@
+package content
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name   string
+		inline string
+		want   string
+	}{
+		{"expect plain text to be escaped", `a < b & c`, `a &lt; b &amp; c`},
+		{"expect allowed tags to be kept", `<b>bold</b> <i>it</i>`, `<b>bold</b> <i>it</i>`},
+		{"expect script to be dropped with its content", `Hi<script>alert(1)</script> there`, `Hi there`},
+		{"expect style to be dropped with its content", `<style>p { color: red }</style>Text`, `Text`},
+		{"expect iframe to be dropped with its content", `<iframe src="https://evil.com">inner</iframe>ok`, `ok`},
+		{"expect unknown tags to keep their text", `<div><span>text</span></div>`, `text`},
+		{"expect javascript link to lose its tag", `<a href="javascript:alert(1)">x</a>`, `x`},
+		{"expect javascript link in any case to lose its tag", `<a href=" JavaScript:alert(1)">x</a>`, `x`},
+		{"expect protocol-relative link to lose its tag", `<a href="//evil.com">x</a>`, `x`},
+		{
+			"expect safe link to be kept without other attributes",
+			`<a href="https://example.com" onclick="steal()">x</a>`,
+			`<a href="https://example.com" rel="nofollow noopener">x</a>`,
+		},
+		{
+			"expect quotes in a link not to open attributes",
+			`<a href="https://example.com/&quot;onmouseover=&quot;x">y</a>`,
+			`<a href="https://example.com/&#34;onmouseover=&#34;x" rel="nofollow noopener">y</a>`,
+		},
+		{"expect event attributes to be dropped", `<b onmouseover="steal()">bold</b>`, `<b>bold</b>`},
+		{"expect unsafe class to be dropped", `<code class="x&quot; onclick=&quot;y">c</code>`, `<code>c</code>`},
+		{"expect editor class to be kept", `<mark class="cdx-marker">m</mark>`, `<mark class="cdx-marker">m</mark>`},
+		{"expect unclosed tags to be closed", `<b>bold <i>both`, `<b>bold <i>both</i></b>`},
+		{"expect stray closing tags to be dropped", `text</b></i>`, `text`},
+		{"expect misnested tags to be balanced", `<b><i>x</b>y</i>`, `<b><i>x</i></b>y`},
+		{"expect line breaks to be kept", `one<br/>two<br>`, `one<br>two<br>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sanitize(tt.inline)
+
+			require.Equal(t, tt.want, got)
+			require.Equal(t, got, Sanitize(got))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr bool
+		want    []Block
+	}{
+		{
+			name: "expect inline HTML of blocks to be sanitized",
+			raw:  `{"blocks":[{"type":"paragraph","data":{"text":"<script>x</script>Hi <b>there"}}]}`,
+			want: []Block{{Type: BlockParagraph, Data: BlockData{Text: "Hi <b>there</b>"}}},
+		},
+		{
+			name: "expect plain string list items to be read",
+			raw:  `{"blocks":[{"type":"list","data":{"items":["One","Two"]}}]}`,
+			want: []Block{{Type: BlockList, Data: BlockData{
+				Style: ListUnordered,
+				Items: []ListItem{{Content: "One"}, {Content: "Two"}},
+			}}},
+		},
+		{name: "expect legacy HTML to fail", raw: `<p>Written before EditorJS</p>`, wantErr: true},
+		{name: "expect malformed JSON to fail", raw: `{"blocks":[`, wantErr: true},
+		{name: "expect JSON without blocks to fail", raw: `{"text":"Hi"}`, wantErr: true},
+		{name: "expect unknown block type to fail", raw: `{"blocks":[{"type":"table","data":{}}]}`, wantErr: true},
+		{name: "expect header level out of range to fail", raw: `{"blocks":[{"type":"header","data":{"text":"H","level":7}}]}`, wantErr: true},
+		{name: "expect blank header to fail", raw: `{"blocks":[{"type":"header","data":{"text":"<script>x</script>","level":2}}]}`, wantErr: true},
+		{name: "expect unknown list style to fail", raw: `{"blocks":[{"type":"list","data":{"style":"dotted","items":["One"]}}]}`, wantErr: true},
+		{name: "expect blank code to fail", raw: `{"blocks":[{"type":"code","data":{"code":"  "}}]}`, wantErr: true},
+		{name: "expect image without web url to fail", raw: `{"blocks":[{"type":"image","data":{"file":{"url":"javascript:alert(1)"}}}]}`, wantErr: true},
+		{name: "expect lists at the depth limit to pass", raw: listDocument(maxListDepth), want: nil},
+		{name: "expect lists deeper than the limit to fail", raw: listDocument(maxListDepth + 1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.raw)
+
+			if tt.wantErr {
+				var baseErr *baseErrors.Error
+				require.ErrorAs(t, err, &baseErr)
+				require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.want != nil {
+				require.Equal(t, tt.want, doc.Blocks)
+			}
+		})
+	}
+}
+
+func TestDocument_Render(t *testing.T) {
+	raw := `{"blocks":[
+		{"type":"header","data":{"text":"Title","level":2}},
+		{"type":"paragraph","data":{"text":"Some <b>bold</b> and <a href=\"https://example.com\">link</a>"}},
+		{"type":"paragraph","data":{"text":"1 * 2 [x]"}},
+		{"type":"list","data":{"style":"ordered","items":[{"content":"One","items":[{"content":"Nested"}]},"Two"]}},
+		{"type":"code","data":{"code":"x < y"}},
+		{"type":"quote","data":{"text":"Quote","caption":"Author"}},
+		{"type":"image","data":{"file":{"url":"https://example.com/a.png"},"caption":"Pic"}}
+	]}`
+
+	tests := []struct {
+		name   string
+		render func(Document) string
+		want   string
+	}{
+		{
+			name:   "expect HTML",
+			render: Document.HTML,
+			want: strings.Join([]string{
+				`<h2>Title</h2>`,
+				`<p>Some <b>bold</b> and <a href="https://example.com" rel="nofollow noopener">link</a></p>`,
+				`<p>1 * 2 [x]</p>`,
+				`<ol><li>One<ol><li>Nested</li></ol></li><li>Two</li></ol>`,
+				`<pre><code>x &lt; y</code></pre>`,
+				`<blockquote><p>Quote</p><cite>Author</cite></blockquote>`,
+				`<figure><img src="https://example.com/a.png" alt="Pic"><figcaption>Pic</figcaption></figure>`,
+			}, "\n"),
+		},
+		{
+			name:   "expect Markdown",
+			render: Document.Markdown,
+			want: strings.Join([]string{
+				"## Title",
+				"Some **bold** and [link](https://example.com)",
+				`1 \* 2 \[x\]`,
+				"1. One\n   1. Nested\n2. Two",
+				"```\nx < y\n```",
+				"> Quote\n> \n> — Author",
+				"![Pic](https://example.com/a.png)",
+			}, "\n\n"),
+		},
+		{
+			name:   "expect plain text",
+			render: Document.PlainText,
+			want:   "Title\nSome bold and link\n1 * 2 [x]\nOne\nNested\nTwo\nx < y\nQuote\nAuthor\nPic",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(raw)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.want, tt.render(doc))
+
+			// A stored document reads back to the same output.
+			stored, err := json.Marshal(doc)
+			require.NoError(t, err)
+			again, err := Parse(string(stored))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tt.render(again))
+		})
+	}
+}
+
+func TestDocument_JSON(t *testing.T) {
+	doc, err := Parse(`{"blocks":[{"type":"paragraph","data":{"text":"<i>a</i> &amp; <script>b</script>c","extra":1}}]}`)
+	require.NoError(t, err)
+
+	stored, err := doc.JSON()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"blocks":[{"type":"paragraph","data":{"text":"<i>a</i> &amp; c"}}]}`, stored)
+	again, err := Parse(stored)
+	require.NoError(t, err)
+	require.Equal(t, doc, again)
+}
+
+func TestLegacyContent(t *testing.T) {
+	legacy := `<p>Old <b>post</b> &amp; <script>alert(1)</script></p><pre>code</pre>`
+
+	tests := []struct {
+		name   string
+		render func(string) string
+		want   string
+	}{
+		{"expect plain text without tags", PlainText, "Old post & alert(1) code"},
+		{
+			"expect excerpt to be escaped text",
+			func(raw string) string { return Excerpt(raw, 3) },
+			"Old post &amp;…",
+		},
+		{"expect no first image", FirstImage, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.render(legacy))
+		})
+	}
+
+	require.Equal(t, 1, CodeBlocks(legacy))
+}
+
+// listDocument builds a document of a list nested to the given depth.
+func listDocument(depth int) string {
+	items := `[{"content":"Item"}]`
+	for i := 1; i < depth; i++ {
+		items = `[{"content":"Item","items":` + items + `}]`
+	}
+
+	return `{"blocks":[{"type":"list","data":{"items":` + items + `}}]}`
+}
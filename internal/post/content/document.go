@@ -0,0 +1,206 @@
+package content
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"fibo/internal/base/errors"
+)
+
+type BlockType string
+
+const (
+	BlockParagraph BlockType = "paragraph"
+	BlockHeader    BlockType = "header"
+	BlockList      BlockType = "list"
+	BlockCode      BlockType = "code"
+	BlockQuote     BlockType = "quote"
+	BlockImage     BlockType = "image"
+)
+
+type ListStyle string
+
+const (
+	ListOrdered   ListStyle = "ordered"
+	ListUnordered ListStyle = "unordered"
+)
+
+const (
+	maxBlocks    = 2000
+	maxListDepth = 8
+)
+
+// Document is an EditorJS document. Inline HTML of a parsed document is
+// sanitized, so it is safe to render.
+type Document struct {
+	Time    int64   `json:"time,omitempty"`
+	Blocks  []Block `json:"blocks"`
+	Version string  `json:"version,omitempty"`
+}
+
+type Block struct {
+	Id   string    `json:"id,omitempty"`
+	Type BlockType `json:"type"`
+	Data BlockData `json:"data"`
+}
+
+// BlockData holds the data of every supported block type. Which fields are
+// used depends on the type of the block.
+type BlockData struct {
+	// Text is inline HTML of paragraphs, headers and quotes.
+	Text string `json:"text,omitempty"`
+	// Level is the level of a header, from 1 to 6.
+	Level int        `json:"level,omitempty"`
+	Style ListStyle  `json:"style,omitempty"`
+	Items []ListItem `json:"items,omitempty"`
+	// Code is plain text, it is never read as HTML.
+	Code string `json:"code,omitempty"`
+	// Caption is inline HTML of quotes and images.
+	Caption   string `json:"caption,omitempty"`
+	Alignment string `json:"alignment,omitempty"`
+	File      *File  `json:"file,omitempty"`
+}
+
+type File struct {
+	URL string `json:"url"`
+}
+
+// ListItem is an item of a list. Older editors save items as plain strings,
+// newer ones as objects with nested items.
+type ListItem struct {
+	Content string     `json:"content"`
+	Items   []ListItem `json:"items,omitempty"`
+}
+
+func (item *ListItem) UnmarshalJSON(raw []byte) error {
+	var content string
+	if err := json.Unmarshal(raw, &content); err == nil {
+		*item = ListItem{Content: content}
+		return nil
+	}
+
+	type listItem ListItem
+	var nested listItem
+	if err := json.Unmarshal(raw, &nested); err != nil {
+		return err
+	}
+	*item = ListItem(nested)
+
+	return nil
+}
+
+// Parse reads an EditorJS document. It fails on malformed JSON and unknown
+// or incomplete blocks, and sanitizes the inline HTML of the blocks.
+func Parse(raw string) (Document, error) {
+	doc, ok := decode(raw)
+	if !ok {
+		return Document{}, errors.New(errors.ValidationError, "content: must be an EditorJS document.")
+	}
+	if len(doc.Blocks) > maxBlocks {
+		return Document{}, errors.Errorf(errors.ValidationError, "content: must have at most %d blocks.", maxBlocks)
+	}
+
+	for i := range doc.Blocks {
+		if err := doc.Blocks[i].normalize(); err != nil {
+			return Document{}, errors.Errorf(errors.ValidationError, "content: block %d: %s", i+1, err)
+		}
+	}
+
+	return doc, nil
+}
+
+// JSON returns the document to store in place of the raw content it was
+// parsed from.
+func (doc Document) JSON() (string, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return "", errors.Wrap(err, errors.ValidationError, "content: encode document failed")
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// decode reads the document without validating it.
+func decode(raw string) (Document, bool) {
+	var doc Document
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil || doc.Blocks == nil {
+		return Document{}, false
+	}
+
+	return doc, true
+}
+
+// normalize validates the block and sanitizes its inline HTML.
+func (block *Block) normalize() error {
+	data := &block.Data
+
+	switch block.Type {
+	case BlockParagraph:
+		data.Text = Sanitize(data.Text)
+	case BlockHeader:
+		if data.Level < 1 || data.Level > 6 {
+			return errors.New(errors.ValidationError, "header level must be from 1 to 6.")
+		}
+		if data.Text = Sanitize(data.Text); data.Text == "" {
+			return errors.New(errors.ValidationError, "header cannot be blank.")
+		}
+	case BlockList:
+		if data.Style == "" {
+			data.Style = ListUnordered
+		}
+		if data.Style != ListOrdered && data.Style != ListUnordered {
+			return errors.Errorf(errors.ValidationError, "unknown list style \"%s\".", data.Style)
+		}
+		if len(data.Items) == 0 {
+			return errors.New(errors.ValidationError, "list must have items.")
+		}
+		return normalizeItems(data.Items, 1)
+	case BlockCode:
+		if strings.TrimSpace(data.Code) == "" {
+			return errors.New(errors.ValidationError, "code cannot be blank.")
+		}
+	case BlockQuote:
+		data.Caption = Sanitize(data.Caption)
+		if data.Text = Sanitize(data.Text); data.Text == "" {
+			return errors.New(errors.ValidationError, "quote cannot be blank.")
+		}
+	case BlockImage:
+		if data.File == nil || !isWebURL(data.File.URL) {
+			return errors.New(errors.ValidationError, "image must have an http(s) url.")
+		}
+		data.Caption = Sanitize(data.Caption)
+	default:
+		return errors.Errorf(errors.ValidationError, "unknown block type \"%s\".", block.Type)
+	}
+
+	return nil
+}
+
+func normalizeItems(items []ListItem, depth int) error {
+	if len(items) > 0 && depth > maxListDepth {
+		return errors.Errorf(errors.ValidationError, "lists can nest at most %d levels.", maxListDepth)
+	}
+
+	for i := range items {
+		items[i].Content = Sanitize(items[i].Content)
+		if err := normalizeItems(items[i].Items, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isWebURL(value string) bool {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
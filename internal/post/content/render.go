@@ -0,0 +1,278 @@
+package content
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	markdownSpecial = regexp.MustCompile("([\\\\`*_\\[\\]<>])")
+	htmlTagPattern  = regexp.MustCompile(`<[^>]*>`)
+	spacesPattern   = regexp.MustCompile(`[ \t]+`)
+	htmlCodeBlock   = regexp.MustCompile(`(?i)<pre[\s>]`)
+	urlEscaper      = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E")
+)
+
+// HTML renders the document as an HTML fragment.
+func (doc Document) HTML() string {
+	parts := make([]string, 0, len(doc.Blocks))
+	for _, block := range doc.Blocks {
+		data := block.Data
+
+		switch block.Type {
+		case BlockParagraph:
+			parts = append(parts, "<p>"+data.Text+"</p>")
+		case BlockHeader:
+			parts = append(parts, fmt.Sprintf("<h%d>%s</h%d>", data.Level, data.Text, data.Level))
+		case BlockList:
+			parts = append(parts, listHTML(data.Style, data.Items))
+		case BlockCode:
+			parts = append(parts, "<pre><code>"+html.EscapeString(data.Code)+"</code></pre>")
+		case BlockQuote:
+			quote := "<blockquote><p>" + data.Text + "</p>"
+			if data.Caption != "" {
+				quote += "<cite>" + data.Caption + "</cite>"
+			}
+			parts = append(parts, quote+"</blockquote>")
+		case BlockImage:
+			image := `<figure><img src="` + html.EscapeString(data.File.URL) +
+				`" alt="` + html.EscapeString(InlineText(data.Caption)) + `">`
+			if data.Caption != "" {
+				image += "<figcaption>" + data.Caption + "</figcaption>"
+			}
+			parts = append(parts, image+"</figure>")
+		}
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+func listHTML(style ListStyle, items []ListItem) string {
+	tag := "ul"
+	if style == ListOrdered {
+		tag = "ol"
+	}
+
+	var out strings.Builder
+	out.WriteString("<" + tag + ">")
+	for _, item := range items {
+		out.WriteString("<li>" + item.Content)
+		if len(item.Items) > 0 {
+			out.WriteString(listHTML(style, item.Items))
+		}
+		out.WriteString("</li>")
+	}
+	out.WriteString("</" + tag + ">")
+
+	return out.String()
+}
+
+// Markdown renders the document as CommonMark.
+func (doc Document) Markdown() string {
+	parts := make([]string, 0, len(doc.Blocks))
+	for _, block := range doc.Blocks {
+		data := block.Data
+
+		switch block.Type {
+		case BlockParagraph:
+			parts = append(parts, inlineMarkdown(data.Text))
+		case BlockHeader:
+			parts = append(parts, strings.Repeat("#", data.Level)+" "+inlineMarkdown(data.Text))
+		case BlockList:
+			parts = append(parts, strings.TrimRight(listMarkdown(data.Style, data.Items, ""), "\n"))
+		case BlockCode:
+			fence := "```"
+			for strings.Contains(data.Code, fence) {
+				fence += "`"
+			}
+			parts = append(parts, fence+"\n"+data.Code+"\n"+fence)
+		case BlockQuote:
+			quote := inlineMarkdown(data.Text)
+			if data.Caption != "" {
+				quote += "\n\n— " + inlineMarkdown(data.Caption)
+			}
+			parts = append(parts, "> "+strings.ReplaceAll(quote, "\n", "\n> "))
+		case BlockImage:
+			parts = append(parts, "!["+escapeMarkdown(InlineText(data.Caption))+"]("+markdownURL(data.File.URL)+")")
+		}
+	}
+
+	return strings.Join(parts, "\n\n")
+}
+
+func listMarkdown(style ListStyle, items []ListItem, indent string) string {
+	var out strings.Builder
+	for i, item := range items {
+		marker := "- "
+		if style == ListOrdered {
+			marker = fmt.Sprintf("%d. ", i+1)
+		}
+
+		out.WriteString(indent + marker + inlineMarkdown(item.Content) + "\n")
+		out.WriteString(listMarkdown(style, item.Items, indent+strings.Repeat(" ", len(marker))))
+	}
+
+	return out.String()
+}
+
+// inlineMarkdown converts sanitized inline HTML to Markdown. Tags without a
+// Markdown counterpart keep their text only.
+func inlineMarkdown(inline string) string {
+	var out strings.Builder
+	var links []string
+	inCode := false
+
+	for _, t := range tokenize(inline) {
+		switch t.kind {
+		case textToken:
+			// Code spans are literal, escapes would show up in them.
+			if inCode {
+				out.WriteString(strings.ReplaceAll(t.text, "`", "'"))
+			} else {
+				out.WriteString(escapeMarkdown(t.text))
+			}
+		case startToken, endToken:
+			switch t.tag {
+			case "b", "strong":
+				out.WriteString("**")
+			case "i", "em":
+				out.WriteString("_")
+			case "s":
+				out.WriteString("~~")
+			case "code":
+				inCode = t.kind == startToken
+				out.WriteString("`")
+			case "br":
+				out.WriteString("  \n")
+			case "a":
+				if t.kind == startToken {
+					links = append(links, t.href)
+					out.WriteString("[")
+				} else {
+					out.WriteString("](" + markdownURL(links[len(links)-1]) + ")")
+					links = links[:len(links)-1]
+				}
+			}
+		}
+	}
+
+	return strings.TrimSpace(out.String())
+}
+
+func markdownURL(link string) string {
+	return urlEscaper.Replace(link)
+}
+
+func escapeMarkdown(text string) string {
+	return markdownSpecial.ReplaceAllString(text, "\\$1")
+}
+
+// PlainText renders the text of the document, a line per piece of text.
+func (doc Document) PlainText() string {
+	var parts []string
+	for _, block := range doc.Blocks {
+		data := block.Data
+
+		for _, text := range []string{InlineText(data.Text), data.Code, InlineText(data.Caption)} {
+			if text != "" {
+				parts = append(parts, text)
+			}
+		}
+		parts = append(parts, itemsText(data.Items)...)
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+func itemsText(items []ListItem) []string {
+	var parts []string
+	for _, item := range items {
+		if text := InlineText(item.Content); text != "" {
+			parts = append(parts, text)
+		}
+		parts = append(parts, itemsText(item.Items)...)
+	}
+
+	return parts
+}
+
+// PlainText renders the text of raw post content. Posts written before
+// content was validated may hold blocks Parse rejects, which still give their
+// text, or HTML.
+func PlainText(raw string) string {
+	if doc, ok := decode(raw); ok {
+		return doc.PlainText()
+	}
+
+	text := html.UnescapeString(htmlTagPattern.ReplaceAllString(raw, " "))
+	return strings.TrimSpace(spacesPattern.ReplaceAllString(text, " "))
+}
+
+// CodeBlocks counts the code blocks of raw post content, or the <pre>
+// elements of HTML content.
+func CodeBlocks(raw string) int {
+	doc, ok := decode(raw)
+	if !ok {
+		return len(htmlCodeBlock.FindAllStringIndex(raw, -1))
+	}
+
+	count := 0
+	for _, block := range doc.Blocks {
+		if block.Type == BlockCode {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Excerpt renders the opening of raw post content as HTML: whole blocks
+// until about the given number of words. Content Parse rejects is cut as
+// escaped plain text instead, so no unsanitized HTML gets through.
+func Excerpt(raw string, words int) string {
+	doc, err := Parse(raw)
+	if err != nil {
+		return html.EscapeString(Summary(raw, words))
+	}
+
+	var blocks []Block
+	count := 0
+	for _, block := range doc.Blocks {
+		if count >= words {
+			break
+		}
+		blocks = append(blocks, block)
+		count += len(strings.Fields(Document{Blocks: []Block{block}}.PlainText()))
+	}
+
+	return Document{Blocks: blocks}.HTML()
+}
+
+// Summary cuts the text of raw post content to the given number of words.
+func Summary(raw string, words int) string {
+	fields := strings.Fields(PlainText(raw))
+	if len(fields) <= words {
+		return strings.Join(fields, " ")
+	}
+
+	return strings.Join(fields[:words], " ") + "…"
+}
+
+// FirstImage returns the address of the first image of raw post content, or
+// an empty string when it has none.
+func FirstImage(raw string) string {
+	doc, ok := decode(raw)
+	if !ok {
+		return ""
+	}
+
+	for _, block := range doc.Blocks {
+		if block.Type == BlockImage && block.Data.File != nil && isWebURL(block.Data.File.URL) {
+			return block.Data.File.URL
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,203 @@
+package content
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// allowedTags are the inline tags the editor produces. Anything else is
+// dropped, keeping the text inside it.
+var allowedTags = map[string]bool{
+	"a":      true,
+	"b":      true,
+	"br":     true,
+	"code":   true,
+	"em":     true,
+	"i":      true,
+	"mark":   true,
+	"s":      true,
+	"strong": true,
+	"u":      true,
+}
+
+// droppedTags are removed along with everything inside them.
+var droppedTags = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"iframe":   true,
+	"object":   true,
+	"template": true,
+}
+
+var (
+	tagPattern       = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+	attrPattern      = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>` + "`" + `]+))`)
+	classPattern     = regexp.MustCompile(`^[a-zA-Z0-9_ -]*$`)
+	safeLinkPrefixes = []string{"http://", "https://", "mailto:", "/", "#"}
+)
+
+type tokenKind int
+
+const (
+	textToken tokenKind = iota
+	startToken
+	endToken
+)
+
+// token is a piece of sanitized inline HTML. Text is unescaped.
+type token struct {
+	kind  tokenKind
+	tag   string
+	text  string
+	href  string
+	class string
+}
+
+// Sanitize cleans inline HTML: only the tags of allowedTags with safe
+// attributes are kept, tags are balanced and text is escaped.
+func Sanitize(inline string) string {
+	return renderHTML(tokenize(inline))
+}
+
+// InlineText returns the text of inline HTML.
+func InlineText(inline string) string {
+	var text strings.Builder
+	for _, t := range tokenize(inline) {
+		if t.kind == textToken {
+			text.WriteString(t.text)
+		} else if t.kind == startToken && t.tag == "br" {
+			text.WriteString("\n")
+		}
+	}
+
+	return strings.TrimSpace(text.String())
+}
+
+// tokenize splits inline HTML into sanitized, balanced tokens.
+func tokenize(inline string) []token {
+	var tokens []token
+	var open []string
+	dropping := ""
+
+	addText := func(text string) {
+		if dropping != "" || text == "" {
+			return
+		}
+		tokens = append(tokens, token{kind: textToken, text: html.UnescapeString(text)})
+	}
+
+	rest := inline
+	for {
+		loc := tagPattern.FindStringSubmatchIndex(rest)
+		if loc == nil {
+			addText(rest)
+			break
+		}
+		addText(rest[:loc[0]])
+
+		closing := loc[3] > loc[2]
+		tag := strings.ToLower(rest[loc[4]:loc[5]])
+		attrs := rest[loc[6]:loc[7]]
+		rest = rest[loc[1]:]
+
+		switch {
+		case dropping != "":
+			if closing && tag == dropping {
+				dropping = ""
+			}
+		case droppedTags[tag]:
+			if !closing {
+				dropping = tag
+			}
+		case !allowedTags[tag]:
+		case tag == "br":
+			if !closing {
+				tokens = append(tokens, token{kind: startToken, tag: tag})
+			}
+		case closing:
+			// Tags left open inside the closed one are closed with it.
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != tag {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					tokens = append(tokens, token{kind: endToken, tag: open[j]})
+				}
+				open = open[:i]
+				break
+			}
+		default:
+			t := token{kind: startToken, tag: tag}
+			readAttrs(&t, attrs)
+			if tag == "a" && t.href == "" {
+				continue
+			}
+			tokens = append(tokens, t)
+			open = append(open, tag)
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		tokens = append(tokens, token{kind: endToken, tag: open[i]})
+	}
+
+	return tokens
+}
+
+// readAttrs keeps the attributes the renderers use: safe links and the
+// classes the editor styles inline code and marks with.
+func readAttrs(t *token, attrs string) {
+	for _, match := range attrPattern.FindAllStringSubmatch(attrs, -1) {
+		name := strings.ToLower(match[1])
+		value := html.UnescapeString(match[2] + match[3] + match[4])
+
+		switch {
+		case name == "href" && t.tag == "a" && isSafeLink(value):
+			t.href = value
+		case name == "class" && (t.tag == "code" || t.tag == "mark") && classPattern.MatchString(value):
+			t.class = value
+		}
+	}
+}
+
+func isSafeLink(link string) bool {
+	link = strings.TrimSpace(link)
+	if strings.HasPrefix(link, "//") {
+		return false
+	}
+
+	lower := strings.ToLower(link)
+	for _, prefix := range safeLinkPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func renderHTML(tokens []token) string {
+	var out strings.Builder
+	for _, t := range tokens {
+		switch t.kind {
+		case textToken:
+			out.WriteString(html.EscapeString(t.text))
+		case endToken:
+			out.WriteString("</" + t.tag + ">")
+		case startToken:
+			switch {
+			case t.tag == "br":
+				out.WriteString("<br>")
+			case t.href != "":
+				out.WriteString(`<a href="` + html.EscapeString(t.href) + `" rel="nofollow noopener">`)
+			case t.class != "":
+				out.WriteString("<" + t.tag + ` class="` + html.EscapeString(t.class) + `">`)
+			default:
+				out.WriteString("<" + t.tag + ">")
+			}
+		}
+	}
+
+	return strings.TrimSpace(out.String())
+}
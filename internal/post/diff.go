@@ -0,0 +1,110 @@
+package post
+
+import "strings"
+
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// maxDiffCells bounds the memory of the line table. Texts beyond it are
+// reported as fully replaced.
+const maxDiffCells = 4 << 20
+
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// DiffLines compares two texts line by line using their longest common
+// subsequence.
+func DiffLines(from string, to string) []DiffLine {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	// Common head and tail don't take part in the table.
+	head := 0
+	for head < len(a) && head < len(b) && a[head] == b[head] {
+		head++
+	}
+	tail := 0
+	for tail < len(a)-head && tail < len(b)-head && a[len(a)-1-tail] == b[len(b)-1-tail] {
+		tail++
+	}
+
+	lines := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a[:head] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+	lines = append(lines, diffMiddle(a[head:len(a)-tail], b[head:len(b)-tail])...)
+	for _, line := range a[len(a)-tail:] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+
+	return lines
+}
+
+func diffMiddle(a []string, b []string) []DiffLine {
+	var lines []DiffLine
+
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, line := range a {
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: line})
+		}
+		for _, line := range b {
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: line})
+		}
+		return lines
+	}
+
+	// lcs[i][j] is the length of the common subsequence of a[i:] and b[j:].
+	width := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			case lcs[(i+1)*width+j] >= lcs[i*width+j+1]:
+				lcs[i*width+j] = lcs[(i+1)*width+j]
+			default:
+				lcs[i*width+j] = lcs[i*width+j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, DiffLine{Op: DiffEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[(i+1)*width+j] >= lcs[i*width+j+1]:
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, DiffLine{Op: DiffDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, DiffLine{Op: DiffInsert, Text: b[j]})
+	}
+
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
@@ -1,49 +1,282 @@
 package post
 
+import "fibo/internal/user"
+
 type LikePostDto struct {
-	Likes int64 `json:"likes"`
+	PostId    int64  `json:"postId"`
+	UserId    int64  `json:"userId"`
+	VisitorId string `json:"visitorId"`
+}
+
+func (p LikePostDto) MapToModel() (LikeModel, error) {
+	return NewLike(p.PostId, p.UserId, p.VisitorId)
+}
+
+type PostLikesDto struct {
+	PostId int64 `json:"postId"`
+	Likes  int64 `json:"likes"`
+	Liked  bool  `json:"liked"`
 }
 
 type PostDto struct {
-	Id          int64  `json:"id"`
-	UserId      int64  `json:"userId"`
-	Title       string `json:"title"`
-	Content     string `json:"content"`
-	CategoryId  int64  `json:"category_id"`
-	IsPublished bool   `json:"is_published"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
-	Likes       int64  `json:"likes"`
+	Id             int64    `json:"id"`
+	UserId         int64    `json:"userId"`
+	Title          string   `json:"title"`
+	Slug           string   `json:"slug"`
+	Content        string   `json:"content"`
+	CategoryId     int64    `json:"category_id"`
+	State          State    `json:"state"`
+	ReviewNotes    string   `json:"review_notes"`
+	PublishAt      string   `json:"publishAt"`
+	HiddenAt       string   `json:"hiddenAt"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+	Likes          int64    `json:"likes"`
+	Views          int64    `json:"views"`
+	Saves          int64    `json:"saves"`
+	WordCount      int64    `json:"wordCount"`
+	ReadingMinutes int64    `json:"readingMinutes"`
+	CodeBlocks     int64    `json:"codeBlocks"`
+	Tags           []string `json:"tags"`
+}
+
+// ViewPostDto is a read of a post by a user or, when UserId is zero, an
+// anonymous visitor. Hidden posts are shown to their authors and
+// moderators only.
+type ViewPostDto struct {
+	PostId    int64     `json:"postId"`
+	UserId    int64     `json:"userId"`
+	UserRole  user.Role `json:"-"`
+	VisitorId string    `json:"visitorId"`
+}
+
+func (p ViewPostDto) MapToModel() (ViewModel, error) {
+	return NewView(p.PostId, p.UserId, p.VisitorId)
+}
+
+// ViewPostBySlugDto is a read of the post with the slug, see ViewPostDto.
+type ViewPostBySlugDto struct {
+	Slug      string    `json:"slug"`
+	UserId    int64     `json:"userId"`
+	UserRole  user.Role `json:"-"`
+	VisitorId string    `json:"visitorId"`
+}
+
+// PostBySlugDto holds the post a slug points to. When the slug is one the
+// post had before its title changed, only RedirectTo is set, to the slug the
+// post has now.
+type PostBySlugDto struct {
+	Post       *PostModel `json:"post,omitempty"`
+	RedirectTo string     `json:"redirectTo,omitempty"`
 }
 
 type AddPostDto struct {
-	UserId      int64  `json:"userId"`
-	Title       string `json:"title"`
-	Content     string `json:"content"`
-	IsPublished bool   `json:"is_published"`
-	CategoryId  int64  `json:"category_id"`
+	UserId     int64  `json:"userId"`
+	Title      string `json:"title"`
+	Content    string `json:"content"`
+	CategoryId int64  `json:"category_id"`
+	// Tags are names of new or existing tags.
+	Tags []string `json:"tags"`
+	// Submit sends the new post straight to the review queue instead of
+	// keeping it as a draft.
+	Submit bool `json:"submit"`
 }
 
 func (p AddPostDto) MapToModel() (PostModel, error) {
-	return NewPost(p.UserId, p.Title, p.Content, p.IsPublished, p.CategoryId)
+	return NewPost(p.UserId, p.Title, p.Content, p.CategoryId)
 }
 
 type UpdatePostDto struct {
-	Id          int64  `json:"id"`
-	Title       string `json:"title"`
-	Content     string `json:"content"`
-	IsPublished bool   `json:"is_published"`
-	Likes       int64  `json:"likes"`
-	CategoryId  int64  `json:"category_id"`
+	Id         int64     `json:"id"`
+	Title      string    `json:"title"`
+	Content    string    `json:"content"`
+	CategoryId int64     `json:"category_id"`
+	UserId     int64     `json:"-"`
+	UserRole   user.Role `json:"-"`
+	// Tags replace the tags of the post. Leaving them out keeps the tags,
+	// an empty list removes them.
+	Tags []string `json:"tags"`
 }
 
 func (p UpdatePostDto) MapToModel() PostModel {
 	return PostModel{
-		Id:          p.Id,
-		Title:       p.Title,
-		Content:     p.Content,
-		IsPublished: p.IsPublished,
-		Likes:       p.Likes,
-		CategoryId:  p.CategoryId,
+		Id:         p.Id,
+		Title:      p.Title,
+		Content:    p.Content,
+		CategoryId: p.CategoryId,
+	}
+}
+
+// DeletePostDto asks to move a post to the trash or back on behalf of
+// UserId.
+type DeletePostDto struct {
+	PostId   int64     `json:"-"`
+	UserId   int64     `json:"-"`
+	UserRole user.Role `json:"-"`
+}
+
+type PurgePostsDto struct {
+	Purged        int64  `json:"purged"`
+	DeletedBefore string `json:"deletedBefore"`
+}
+
+// TransitionPostDto asks to move a post through the review pipeline on
+// behalf of ActorId. PublishAt is an RFC3339 time an approved post goes
+// live at, it goes live right away when it is empty or past.
+type TransitionPostDto struct {
+	PostId    int64     `json:"postId"`
+	ActorId   int64     `json:"actorId"`
+	ActorRole user.Role `json:"-"`
+	Notes     string    `json:"notes"`
+	PublishAt string    `json:"publishAt"`
+}
+
+// PublishScheduledDto reports the scheduled posts that went live.
+type PublishScheduledDto struct {
+	Published []int64 `json:"published"`
+}
+
+// GetTransitionsDto asks for the review history of the post on behalf of
+// the user.
+type GetTransitionsDto struct {
+	PostId   int64     `json:"-"`
+	UserId   int64     `json:"-"`
+	UserRole user.Role `json:"-"`
+}
+
+type TransitionDto struct {
+	Id        int64  `json:"id"`
+	PostId    int64  `json:"postId"`
+	FromState State  `json:"fromState"`
+	ToState   State  `json:"toState"`
+	ActorId   int64  `json:"actorId"`
+	Notes     string `json:"notes"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func (dto TransitionDto) MapFromModel(model TransitionModel) TransitionDto {
+	dto.Id = model.Id
+	dto.PostId = model.PostId
+	dto.FromState = model.FromState
+	dto.ToState = model.ToState
+	dto.ActorId = model.ActorId
+	dto.Notes = model.Notes
+	dto.CreatedAt = model.CreatedAt
+
+	return dto
+}
+
+type RevisionDto struct {
+	Id         int64  `json:"id"`
+	PostId     int64  `json:"postId"`
+	Revision   int64  `json:"revision"`
+	Title      string `json:"title"`
+	Content    string `json:"content"`
+	CategoryId int64  `json:"category_id"`
+	UserId     int64  `json:"userId"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+func (dto RevisionDto) MapFromModel(model RevisionModel) RevisionDto {
+	dto.Id = model.Id
+	dto.PostId = model.PostId
+	dto.Revision = model.Revision
+	dto.Title = model.Title
+	dto.Content = model.Content
+	dto.CategoryId = model.CategoryId
+	dto.UserId = model.UserId
+	dto.CreatedAt = model.CreatedAt
+
+	return dto
+}
+
+type GetRevisionsDto struct {
+	PostId   int64     `json:"-"`
+	UserId   int64     `json:"-"`
+	UserRole user.Role `json:"-"`
+}
+
+// DiffRevisionsDto asks to compare two revisions of a post. Zero revisions
+// fall back to the defaults of PostModel.DiffRange.
+type DiffRevisionsDto struct {
+	PostId   int64     `json:"-"`
+	From     int64     `json:"from"`
+	To       int64     `json:"to"`
+	UserId   int64     `json:"-"`
+	UserRole user.Role `json:"-"`
+}
+
+type DiffLineDto struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+type RevisionDiffDto struct {
+	PostId          int64         `json:"postId"`
+	From            int64         `json:"from"`
+	To              int64         `json:"to"`
+	Title           []DiffLineDto `json:"title"`
+	Content         []DiffLineDto `json:"content"`
+	CategoryChanged bool          `json:"categoryChanged"`
+}
+
+func (dto RevisionDiffDto) MapFromModel(model RevisionDiffModel) RevisionDiffDto {
+	dto.PostId = model.To.PostId
+	dto.From = model.From.Revision
+	dto.To = model.To.Revision
+	dto.Title = mapDiffLines(model.Title)
+	dto.Content = mapDiffLines(model.Content)
+	dto.CategoryChanged = model.CategoryChanged
+
+	return dto
+}
+
+func mapDiffLines(lines []DiffLine) []DiffLineDto {
+	out := make([]DiffLineDto, 0, len(lines))
+	for _, line := range lines {
+		out = append(out, DiffLineDto{Op: line.Op, Text: line.Text})
 	}
+
+	return out
+}
+
+type RestoreRevisionDto struct {
+	PostId   int64     `json:"-"`
+	Revision int64     `json:"-"`
+	UserId   int64     `json:"-"`
+	UserRole user.Role `json:"-"`
+}
+
+// ListPostsDto carries the query of a post listing. Dates are inclusive and
+// look like "YYYY-MM-DD"; Cursor is the NextCursor of the previous page.
+type ListPostsDto struct {
+	CategoryId int64     `json:"category_id"`
+	AuthorId   int64     `json:"authorId"`
+	State      State     `json:"state"`
+	Tag        string    `json:"tag"`
+	From       string    `json:"from"`
+	To         string    `json:"to"`
+	Sort       SortField `json:"sort"`
+	Order      SortOrder `json:"order"`
+	Limit      uint      `json:"limit"`
+	Cursor     string    `json:"cursor"`
+}
+
+func (p ListPostsDto) MapToModel() (ListPostsModel, error) {
+	return NewListPosts(p.CategoryId, p.AuthorId, p.State, p.Tag, p.From, p.To, p.Sort, p.Order, p.Limit, p.Cursor)
+}
+
+type PostsPageDto struct {
+	Posts      []PostModelWithUser `json:"posts"`
+	NextCursor string              `json:"next_cursor"`
+}
+
+func (dto PostsPageDto) MapFromModel(model PostsPageModel) PostsPageDto {
+	dto.Posts = model.Posts
+	if dto.Posts == nil {
+		dto.Posts = []PostModelWithUser{}
+	}
+	dto.NextCursor = model.Next.Encode()
+
+	return dto
 }
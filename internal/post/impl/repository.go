@@ -3,16 +3,20 @@ package impl
 import (
 	"context"
 	sqlS "database/sql"
-	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
 
 	databaseImpl "fibo/internal/base/database/impl"
 	"fibo/internal/base/errors"
 	"fibo/internal/post"
+	"fibo/internal/post/content"
 )
 
 type PostRepositoryOpts struct {
@@ -29,27 +33,172 @@ type postRepository struct {
 	databaseImpl.ConnManager
 }
 
-func (p *postRepository) LikePost(
+func (p *postRepository) AddLike(ctx context.Context, like post.LikeModel) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("post_likes").
+		Rows(likeRecord(like)).
+		OnConflict(goqu.DoNothing()).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := p.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, parseAddLikeError(&like, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (p *postRepository) RemoveLike(ctx context.Context, like post.LikeModel) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("post_likes").
+		Where(goqu.Ex(likeRecord(like))).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := p.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "remove like failed")
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (p *postRepository) RefreshLikes(ctx context.Context, postId int64) (int64, error) {
+	likesCount := databaseImpl.QueryBuilder.
+		From("post_likes").
+		Select(goqu.COUNT("*")).
+		Where(goqu.Ex{"post_likes.post_id": goqu.I("posts.id")})
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("posts").
+		Set(goqu.Record{"likes": likesCount}).
+		Where(goqu.Ex{"id": postId}, notDeleted).
+		Returning("likes").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var likes int64
+	if err := p.Conn(ctx).QueryRow(ctx, sql).Scan(&likes); err != nil {
+		return 0, parseGetPostError(postId, err)
+	}
+
+	return likes, nil
+}
+
+// RecordView stores the read and reports whether it counts as a new view,
+// which it does unless the reader viewed the post within the window.
+func (p *postRepository) RecordView(ctx context.Context, view post.ViewModel, window time.Duration) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("post_views").
+		Rows(goqu.Record{"post_id": view.PostId, "viewer": view.Viewer()}).
+		OnConflict(goqu.DoUpdate(
+			"post_id, viewer",
+			goqu.Record{"viewed_at": goqu.L("CURRENT_TIMESTAMP")},
+		).Where(goqu.L(
+			"post_views.viewed_at <= CURRENT_TIMESTAMP - make_interval(secs => ?)",
+			int64(window.Seconds()),
+		))).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := p.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "record view failed")
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// AddViews adds buffered views to the post counters in a single statement.
+// Posts are updated in id order, so concurrent flushes can't deadlock.
+func (p *postRepository) AddViews(
 	ctx context.Context,
-	postId int64,
-	likes post.LikePostDto,
-) error {
+	views map[int64]post.AddedViewsModel,
+) ([]post.ViewCountModel, error) {
+	if len(views) == 0 {
+		return nil, nil
+	}
+
+	postIds := make([]int64, 0, len(views))
+	for postId := range views {
+		postIds = append(postIds, postId)
+	}
+	sort.Slice(postIds, func(i, j int) bool { return postIds[i] < postIds[j] })
+
+	rows := make([]string, 0, len(postIds))
+	args := make([]interface{}, 0, len(postIds)*3)
+	for _, postId := range postIds {
+		rows = append(rows, "(?::BIGINT, ?::BIGINT, ?::BIGINT)")
+		args = append(args, postId, views[postId].Views, views[postId].UserViews)
+	}
+	batch := goqu.L("(VALUES "+strings.Join(rows, ", ")+") AS batch (id, added, user_added)", args...)
+
 	sql, _, err := databaseImpl.QueryBuilder.
 		Update("posts").
-		Set(goqu.Record{"likes": likes.Likes}).
-		Where(goqu.Ex{"id": postId}).
+		Set(goqu.Record{
+			"views":      goqu.L("posts.views + batch.added"),
+			"user_views": goqu.L("posts.user_views + batch.user_added"),
+		}).
+		From(batch).
+		Where(goqu.Ex{"posts.id": goqu.I("batch.id")}, notDeleted).
+		Returning(
+			"posts.id",
+			"posts.user_id",
+			"posts.views",
+			"batch.added",
+			"posts.user_views",
+			"batch.user_added",
+		).
 		ToSQL()
-	fmt.Println(sql)
 	if err != nil {
-		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
 	}
 
-	_, err = p.Conn(ctx).Exec(ctx, sql)
+	result, err := p.Conn(ctx).Query(ctx, sql)
 	if err != nil {
-		return errors.Wrap(err, errors.DatabaseError, "like post failed")
+		return nil, errors.Wrap(err, errors.DatabaseError, "add views failed")
 	}
+	defer result.Close()
 
-	return nil
+	var counts []post.ViewCountModel
+	for result.Next() {
+		var count post.ViewCountModel
+		err := result.Scan(
+			&count.PostId,
+			&count.UserId,
+			&count.Views,
+			&count.Added,
+			&count.UserViews,
+			&count.UserAdded,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan view count failed")
+		}
+
+		counts = append(counts, count)
+	}
+	if err := result.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "add views failed")
+	}
+
+	return counts, nil
+}
+
+func likeRecord(like post.LikeModel) goqu.Record {
+	if like.UserId != 0 {
+		return goqu.Record{"post_id": like.PostId, "user_id": like.UserId}
+	}
+
+	return goqu.Record{"post_id": like.PostId, "visitor_id": like.VisitorId}
 }
 
 func (p *postRepository) Update(
@@ -58,10 +207,18 @@ func (p *postRepository) Update(
 ) (int64, error) {
 	sql, _, err := databaseImpl.QueryBuilder.
 		Update("posts").
-		Set(goqu.Record{"title": post.Title, "content": post.Content, "is_published": post.IsPublished, "likes": post.Likes, "category_id": post.CategoryId}).
-		Where(goqu.Ex{"id": post.Id}).
+		Set(withSearchIndex(goqu.Record{
+			"title":           post.Title,
+			"content":         post.Content,
+			"category_id":     post.CategoryId,
+			"revision":        post.Revision,
+			"word_count":      post.WordCount,
+			"reading_minutes": post.ReadingMinutes,
+			"code_blocks":     post.CodeBlocks,
+			"updated_at":      goqu.L("CURRENT_TIMESTAMP"),
+		}, post)).
+		Where(goqu.Ex{"id": post.Id}, notDeleted).
 		ToSQL()
-	fmt.Println(sql)
 	if err != nil {
 		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
 	}
@@ -74,15 +231,338 @@ func (p *postRepository) Update(
 	return post.Id, nil
 }
 
+func (p *postRepository) UpdateState(ctx context.Context, post post.PostModel) error {
+	record := goqu.Record{
+		"state":            post.State,
+		"review_notes":     post.ReviewNotes,
+		"state_changed_at": goqu.L("CURRENT_TIMESTAMP"),
+		"publish_at":       nil,
+	}
+	if post.PublishAt != "" {
+		record["publish_at"] = post.PublishAt
+	}
+	if post.ApprovedRevision != 0 {
+		record["approved_revision"] = post.ApprovedRevision
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("posts").
+		Set(record).
+		Where(goqu.Ex{"id": post.Id}, notDeleted).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = p.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update post state failed")
+	}
+
+	return nil
+}
+
+func (p *postRepository) AddTransition(ctx context.Context, transition post.TransitionModel) (int64, error) {
+	record := goqu.Record{
+		"post_id":    transition.PostId,
+		"from_state": transition.FromState,
+		"to_state":   transition.ToState,
+		"notes":      transition.Notes,
+	}
+	if transition.ActorId != 0 {
+		record["actor_id"] = transition.ActorId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("post_transitions").
+		Rows(record).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := p.Conn(ctx).QueryRow(ctx, sql).Scan(&transition.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add post transition failed")
+	}
+
+	return transition.Id, nil
+}
+
+func (p *postRepository) GetTransitions(ctx context.Context, postId int64) ([]post.TransitionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("post_transitions").
+		Select("id", "post_id", "from_state", "to_state", "actor_id", "notes", "created_at").
+		Where(goqu.Ex{"post_id": postId}).
+		Order(goqu.I("created_at").Asc(), goqu.I("id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := p.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get post transitions failed")
+	}
+	defer rows.Close()
+
+	var transitions []post.TransitionModel
+	for rows.Next() {
+		var t post.TransitionModel
+		var actorId sqlS.NullInt64
+		var createdAt time.Time
+		if err := rows.Scan(&t.Id, &t.PostId, &t.FromState, &t.ToState, &actorId, &t.Notes, &createdAt); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan post transition failed")
+		}
+		t.ActorId = actorId.Int64
+		t.CreatedAt = createdAt.Format(time.RFC3339)
+
+		transitions = append(transitions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get post transitions failed")
+	}
+
+	return transitions, nil
+}
+
+func (p *postRepository) AddRevision(ctx context.Context, revision post.RevisionModel) (int64, error) {
+	record := goqu.Record{
+		"post_id":  revision.PostId,
+		"revision": revision.Revision,
+		"title":    revision.Title,
+		"content":  revision.Content,
+	}
+	if revision.CategoryId != 0 {
+		record["category_id"] = revision.CategoryId
+	}
+	if revision.UserId != 0 {
+		record["user_id"] = revision.UserId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("post_revisions").
+		Rows(record).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := p.Conn(ctx).QueryRow(ctx, sql).Scan(&revision.Id); err != nil {
+		return 0, parseAddRevisionError(&revision, err)
+	}
+
+	return revision.Id, nil
+}
+
+func (p *postRepository) GetRevisions(ctx context.Context, postId int64) ([]post.RevisionModel, error) {
+	sql, _, err := selectRevisions().
+		Where(goqu.Ex{"post_id": postId}).
+		Order(goqu.I("revision").Desc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := p.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get post revisions failed")
+	}
+	defer rows.Close()
+
+	var revisions []post.RevisionModel
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan post revision failed")
+		}
+
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get post revisions failed")
+	}
+
+	return revisions, nil
+}
+
+func (p *postRepository) GetRevision(ctx context.Context, postId int64, revision int64) (post.RevisionModel, error) {
+	sql, _, err := selectRevisions().
+		Where(goqu.Ex{"post_id": postId, "revision": revision}).
+		ToSQL()
+	if err != nil {
+		return post.RevisionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanRevision(p.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return post.RevisionModel{}, parseGetRevisionError(postId, revision, err)
+	}
+
+	return model, nil
+}
+
+func selectRevisions() *goqu.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		From("post_revisions").
+		Select("id", "post_id", "revision", "title", "content", "category_id", "user_id", "created_at")
+}
+
+func scanRevision(row pgx.Row) (post.RevisionModel, error) {
+	var model post.RevisionModel
+	var category sqlS.NullInt64
+	var userId sqlS.NullInt64
+	var createdAt time.Time
+
+	err := row.Scan(
+		&model.Id,
+		&model.PostId,
+		&model.Revision,
+		&model.Title,
+		&model.Content,
+		&category,
+		&userId,
+		&createdAt,
+	)
+	if err != nil {
+		return post.RevisionModel{}, err
+	}
+
+	model.CategoryId = category.Int64
+	model.UserId = userId.Int64
+	model.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return model, nil
+}
+
+func (r *postRepository) GetSlugs(ctx context.Context, base string) ([]post.SlugModel, error) {
+	// Slugs hold no LIKE wildcards, they are letters, digits and dashes.
+	sql, _, err := selectSlugs().
+		Where(goqu.Or(
+			goqu.Ex{"post_slugs.slug": base},
+			goqu.I("post_slugs.slug").Like(base+"-%"),
+		)).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get slugs failed")
+	}
+	defer rows.Close()
+
+	var slugs []post.SlugModel
+	for rows.Next() {
+		slug, err := scanSlug(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan slug failed")
+		}
+		slugs = append(slugs, slug)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get slugs failed")
+	}
+
+	return slugs, nil
+}
+
+func (r *postRepository) GetSlug(ctx context.Context, slug string) (post.SlugModel, error) {
+	sql, _, err := selectSlugs().
+		Where(goqu.Ex{"post_slugs.slug": slug}, notDeleted).
+		ToSQL()
+	if err != nil {
+		return post.SlugModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanSlug(r.Conn(ctx).QueryRow(ctx, sql))
+	if err == pgx.ErrNoRows {
+		return post.SlugModel{}, errors.Wrapf(err, errors.NotFoundError, "post with slug \"%s\" not found", slug)
+	}
+	if err != nil {
+		return post.SlugModel{}, errors.Wrap(err, errors.DatabaseError, "scan slug failed")
+	}
+
+	return model, nil
+}
+
+func (r *postRepository) AddSlug(ctx context.Context, postId int64, slug string) error {
+	// A slug the post had before is taken back, one of another post is not.
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("post_slugs").
+		Rows(goqu.Record{"slug": slug, "post_id": postId}).
+		OnConflict(goqu.DoUpdate("slug", goqu.Record{"created_at": goqu.L("CURRENT_TIMESTAMP")}).
+			Where(goqu.Ex{"post_slugs.post_id": postId})).
+		Returning("slug").
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var added string
+	err = r.Conn(ctx).QueryRow(ctx, sql).Scan(&added)
+	if err == pgx.ErrNoRows {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "slug \"%s\" is taken by another post", slug)
+	}
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add slug failed")
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Update("posts").
+		Set(goqu.Record{"slug": slug}).
+		Where(goqu.Ex{"id": postId}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseAddSlugError(slug, err)
+	}
+
+	return nil
+}
+
+func selectSlugs() *goqu.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		From("post_slugs").
+		Select(
+			"post_slugs.slug",
+			"post_slugs.post_id",
+			goqu.L("post_slugs.slug = posts.slug"),
+			"post_slugs.created_at",
+		).
+		InnerJoin(goqu.T("posts"), goqu.On(goqu.Ex{"posts.id": goqu.I("post_slugs.post_id")}))
+}
+
+func scanSlug(row pgx.Row) (post.SlugModel, error) {
+	var model post.SlugModel
+	var createdAt time.Time
+
+	if err := row.Scan(&model.Slug, &model.PostId, &model.Current, &createdAt); err != nil {
+		return post.SlugModel{}, err
+	}
+	model.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return model, nil
+}
+
 func (r *postRepository) Create(ctx context.Context, post post.PostModel) (int64, error) {
-	sql, _, err := databaseImpl.QueryBuilder.Insert("posts").Rows(databaseImpl.Record{
-		"user_id":      post.UserId,
-		"title":        post.Title,
-		"content":      post.Content,
-		"is_published": post.IsPublished,
-		"category_id":  post.CategoryId,
-	}).Returning("id").ToSQL()
-	fmt.Println(sql)
+	sql, _, err := databaseImpl.QueryBuilder.Insert("posts").Rows(withSearchIndex(databaseImpl.Record{
+		"user_id":         post.UserId,
+		"title":           post.Title,
+		"slug":            post.Slug,
+		"content":         post.Content,
+		"state":           post.State,
+		"category_id":     post.CategoryId,
+		"revision":        post.Revision,
+		"word_count":      post.WordCount,
+		"reading_minutes": post.ReadingMinutes,
+		"code_blocks":     post.CodeBlocks,
+	}, post)).Returning("id").ToSQL()
 	if err != nil {
 		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error post create")
 	}
@@ -99,7 +579,8 @@ func (r *postRepository) Create(ctx context.Context, post post.PostModel) (int64
 func (r *postRepository) GetById(ctx context.Context, postId int64) (post.PostModel, error) {
 	sql, _, err := databaseImpl.QueryBuilder.
 		From("posts").
-		Where(goqu.Ex{"id": postId}).
+		Select(postColumns...).
+		Where(goqu.Ex{"id": postId}, notDeleted).
 		ToSQL()
 	if err != nil {
 		return post.PostModel{}, errors.Wrap(
@@ -109,83 +590,329 @@ func (r *postRepository) GetById(ctx context.Context, postId int64) (post.PostMo
 		)
 	}
 
-	row := r.Conn(ctx).QueryRow(ctx, sql)
+	p, err := scanPost(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return post.PostModel{}, parseGetPostError(postId, err)
+	}
 
-	var p post.PostModel
-	var createdAt time.Time
-	var updatedAt time.Time
-	var deletedAt sqlS.NullTime
-	var category sqlS.NullInt64
-	if err := row.Scan(&p.Id, &p.UserId, &p.Title, &p.Content, &p.IsPublished, &p.Likes, &createdAt, &updatedAt, &deletedAt, &category); err != nil {
-		return post.PostModel{}, errors.Wrap(err, errors.DatabaseError, "scan post failed")
-	}
-	p.CategoryId = category.Int64
-	p.CreatedAt = createdAt.Format(time.RFC3339)
-	p.UpdatedAt = updatedAt.Format(time.RFC3339)
-	if deletedAt.Valid {
-		p.DeletedAt = deletedAt.Time.Format(time.RFC3339)
-	} else {
-		p.DeletedAt = ""
+	return p, nil
+}
+
+func (r *postRepository) GetDeletedById(ctx context.Context, postId int64) (post.PostModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("posts").
+		Select(postColumns...).
+		Where(goqu.Ex{"id": postId, "deleted_at": goqu.Op{"isNot": nil}}).
+		ToSQL()
+	if err != nil {
+		return post.PostModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error get deleted post by id")
+	}
+
+	p, err := scanPost(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return post.PostModel{}, parseGetPostError(postId, err)
 	}
 
 	return p, nil
 }
 
-func (r *postRepository) GetPublishedPosts(ctx context.Context) ([]post.PostModelWithUser, error) {
+func (r *postRepository) SoftDelete(ctx context.Context, postId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("posts").
+		Set(goqu.Record{"deleted_at": goqu.L("CURRENT_TIMESTAMP")}).
+		Where(goqu.Ex{"id": postId}, notDeleted).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete post failed")
+	}
+
+	return nil
+}
+
+func (r *postRepository) Restore(ctx context.Context, postId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("posts").
+		Set(goqu.Record{"deleted_at": nil}).
+		Where(goqu.Ex{"id": postId}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "restore post failed")
+	}
+
+	return nil
+}
+
+// Purge hard-deletes posts soft-deleted before the given time along with
+// their likes, comments and transitions. It returns the number of posts gone.
+func (r *postRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("posts").
+		Where(goqu.I("deleted_at").Lt(deletedBefore)).
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "purge posts failed")
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// ListPosts returns a page of posts using keyset pagination: the page starts
+// right after the cursor, ties in the sort column are broken by id.
+func (r *postRepository) ListPosts(ctx context.Context, list post.ListPostsModel) (post.PostsPageModel, error) {
+	sortColumn := goqu.I("posts." + string(list.Sort))
+	idColumn := goqu.I("posts.id")
+
+	// The sort value is read back as text, so the cursor holds it with the
+	// full precision of the column.
+	query := selectPostsWithUser().
+		SelectAppend(goqu.Cast(sortColumn, "TEXT")).
+		Limit(list.Limit + 1)
+
+	if list.Order == post.OrderAsc {
+		query = query.Order(sortColumn.Asc(), idColumn.Asc())
+	} else {
+		query = query.Order(sortColumn.Desc(), idColumn.Desc())
+	}
+
+	if list.Sort == post.SortPublishAt {
+		query = query.Where(goqu.I("posts.publish_at").IsNotNull())
+	}
+	if list.CategoryId != 0 {
+		query = query.Where(goqu.Ex{"posts.category_id": list.CategoryId})
+	}
+	if list.UserId != 0 {
+		query = query.Where(goqu.Ex{"posts.user_id": list.UserId})
+	}
+	if len(list.States) > 0 {
+		query = query.Where(goqu.Ex{"posts.state": list.States})
+	}
+	if list.Tag != "" {
+		tagged := databaseImpl.QueryBuilder.
+			From("post_tags").
+			Select("post_tags.post_id").
+			InnerJoin(goqu.T("tags"), goqu.On(goqu.Ex{"tags.id": goqu.I("post_tags.tag_id")})).
+			Where(goqu.Ex{"tags.slug": list.Tag})
+		query = query.Where(goqu.I("posts.id").In(tagged))
+	}
+	if !list.PublishedBy.IsZero() {
+		query = query.Where(goqu.Or(
+			goqu.Ex{"posts.publish_at": nil},
+			goqu.I("posts.publish_at").Lte(list.PublishedBy),
+		))
+	}
+	if list.Public {
+		query = query.Where(goqu.Ex{"posts.hidden_at": nil})
+	}
+	if list.FollowerId != 0 {
+		authors := databaseImpl.QueryBuilder.
+			From("author_follows").
+			Select("author_follows.author_id").
+			Where(goqu.Ex{"author_follows.follower_id": list.FollowerId})
+		categories := databaseImpl.QueryBuilder.
+			From("category_follows").
+			Select("category_follows.category_id").
+			Where(goqu.Ex{"category_follows.follower_id": list.FollowerId})
+		query = query.Where(
+			goqu.Or(
+				goqu.I("posts.user_id").In(authors),
+				goqu.I("posts.category_id").In(categories),
+			),
+			goqu.I("posts.user_id").Neq(list.FollowerId),
+		)
+	}
+	if !list.CreatedFrom.IsZero() {
+		query = query.Where(goqu.I("posts.created_at").Gte(list.CreatedFrom))
+	}
+	if !list.CreatedTo.IsZero() {
+		query = query.Where(goqu.I("posts.created_at").Lt(list.CreatedTo))
+	}
+	if !list.After.IsZero() {
+		operator := "<"
+		if list.Order == post.OrderAsc {
+			operator = ">"
+		}
+		query = query.Where(goqu.L(
+			"(?, ?) "+operator+" (?, ?)",
+			sortColumn,
+			idColumn,
+			list.After.Value,
+			list.After.Id,
+		))
+	}
+
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return post.PostsPageModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error list posts")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return post.PostsPageModel{}, errors.Wrap(err, errors.DatabaseError, "list posts failed")
+	}
+	defer rows.Close()
+
+	var page post.PostsPageModel
+	var sortValues []string
+	for rows.Next() {
+		var sortValue string
+
+		p, err := scanPostWithUser(rows, &sortValue)
+		if err != nil {
+			return post.PostsPageModel{}, errors.Wrap(err, errors.DatabaseError, "scan post failed")
+		}
+
+		page.Posts = append(page.Posts, p)
+		sortValues = append(sortValues, sortValue)
+	}
+	if err := rows.Err(); err != nil {
+		return post.PostsPageModel{}, errors.Wrap(err, errors.DatabaseError, "list posts failed")
+	}
+
+	// One post more than asked for means there is a next page.
+	if uint(len(page.Posts)) > list.Limit {
+		page.Posts = page.Posts[:list.Limit]
+		page.Next = post.Cursor{
+			Sort:  list.Sort,
+			Order: list.Order,
+			Value: sortValues[list.Limit-1],
+			Id:    page.Posts[list.Limit-1].Id,
+		}
+	}
+
+	return page, nil
+}
+
+// GetDuePosts locks approved posts scheduled for the given time or earlier.
+// Posts another transaction has locked are skipped, so app replicas checking
+// at the same time publish each post once.
+func (r *postRepository) GetDuePosts(ctx context.Context, dueBy time.Time, limit uint) ([]post.PostModel, error) {
 	sql, _, err := databaseImpl.QueryBuilder.
 		From("posts").
-		Select("posts.id", "posts.user_id", "posts.title", "posts.content", "posts.is_published", "posts.likes", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.category_id", "users.email", "users.firstname").
-		InnerJoin(goqu.T("users"), goqu.On(goqu.Ex{"posts.user_id": goqu.I("users.user_id")})).
-		Where(databaseImpl.Ex{"is_published": true}).
+		Select(postColumns...).
+		Where(
+			goqu.Ex{"posts.state": post.StateApproved},
+			goqu.I("posts.publish_at").Lte(dueBy),
+			notDeleted,
+		).
+		Order(goqu.I("posts.publish_at").Asc(), goqu.I("posts.id").Asc()).
+		Limit(limit).
+		ForUpdate(exp.SkipLocked, goqu.T("posts")).
 		ToSQL()
 	if err != nil {
-		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error get posts")
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error get due posts")
 	}
 
 	rows, err := r.Conn(ctx).Query(ctx, sql)
 	if err != nil {
-		return nil, errors.Wrap(err, errors.DatabaseError, "get published posts failed")
+		return nil, errors.Wrap(err, errors.DatabaseError, "get due posts failed")
 	}
 	defer rows.Close()
 
-	var posts []post.PostModelWithUser
+	var posts []post.PostModel
 	for rows.Next() {
-		var p post.PostModelWithUser
-		var createdAt time.Time
-		var updatedAt time.Time
-		var deletedAt sqlS.NullTime
-		var category sqlS.NullInt64
-		var userEmail string
-		var userName string
-		if err := rows.Scan(&p.Id, &p.UserId, &p.Title, &p.Content, &p.IsPublished, &p.Likes, &createdAt, &updatedAt, &deletedAt, &category, &userEmail, &userName); err != nil {
+		p, err := scanPost(rows)
+		if err != nil {
 			return nil, errors.Wrap(err, errors.DatabaseError, "scan post failed")
 		}
-		p.CategoryId = category.Int64
-		p.CreatedAt = createdAt.Format(time.RFC3339)
-		p.UpdatedAt = updatedAt.Format(time.RFC3339)
-		p.UserEmail = userEmail
-		p.UserName = userName
-		if deletedAt.Valid {
-			p.DeletedAt = deletedAt.Time.Format(time.RFC3339)
-		} else {
-			p.DeletedAt = ""
-		}
 		posts = append(posts, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get due posts failed")
+	}
 
 	return posts, nil
 }
 
+func (r *postRepository) GetPublishedEntries(ctx context.Context, publishedBy time.Time) ([]post.EntryModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("posts").
+		Select("posts.id", "posts.user_id", "posts.category_id", "posts.slug", "posts.updated_at").
+		Where(
+			goqu.Ex{"posts.state": post.StatePublished, "posts.hidden_at": nil},
+			goqu.Or(
+				goqu.Ex{"posts.publish_at": nil},
+				goqu.I("posts.publish_at").Lte(publishedBy),
+			),
+			notDeleted,
+		).
+		Order(goqu.I("posts.id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error get published entries")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get published entries failed")
+	}
+	defer rows.Close()
+
+	var entries []post.EntryModel
+	for rows.Next() {
+		var entry post.EntryModel
+		var category sqlS.NullInt64
+		var updatedAt time.Time
+
+		if err := rows.Scan(&entry.Id, &entry.UserId, &category, &entry.Slug, &updatedAt); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan published entry failed")
+		}
+		entry.CategoryId = category.Int64
+		entry.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get published entries failed")
+	}
+
+	return entries, nil
+}
+
+func (r *postRepository) GetReviewQueue(ctx context.Context) ([]post.PostModelWithUser, error) {
+	sql, _, err := selectPostsWithUser().
+		Where(databaseImpl.Ex{"posts.state": []post.State{post.StateSubmitted, post.StateInReview}}).
+		Order(goqu.I("posts.state_changed_at").Asc(), goqu.I("posts.id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error get review queue")
+	}
+
+	return r.queryPostsWithUser(ctx, sql, "get review queue failed")
+}
+
 func (r *postRepository) GetTotalLikesCountByUser(
 	ctx context.Context,
 	userId int64,
 ) (int64, error) {
+	// Likes authors leave on their own posts do not count towards reputation.
 	sql, _, err := databaseImpl.QueryBuilder.
-		From("posts").
-		Select(goqu.SUM("likes").As("total_likes_count")).
-		Where(goqu.Ex{"user_id": userId}).
+		From("post_likes").
+		Select(goqu.COUNT("*").As("total_likes_count")).
+		InnerJoin(goqu.T("posts"), goqu.On(goqu.Ex{"post_likes.post_id": goqu.I("posts.id")})).
+		Where(
+			goqu.Ex{"posts.user_id": userId},
+			notDeleted,
+			goqu.Or(
+				goqu.Ex{"post_likes.user_id": nil},
+				goqu.I("post_likes.user_id").Neq(goqu.I("posts.user_id")),
+			),
+		).
 		ToSQL()
-	fmt.Println(sql)
 	if err != nil {
 		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error get total likes count")
 	}
@@ -199,103 +926,212 @@ func (r *postRepository) GetTotalLikesCountByUser(
 	return totalLikesCount, nil
 }
 
-func (r *postRepository) GetMyPosts(
-	ctx context.Context,
-	userId int64,
-) ([]post.PostModelWithUser, error) {
-	sql, _, err := databaseImpl.QueryBuilder.
+// notDeleted keeps soft-deleted posts out of every query unless it asks for
+// them explicitly.
+var notDeleted = goqu.Ex{"posts.deleted_at": nil}
+
+var postColumns = []interface{}{
+	"posts.id",
+	"posts.user_id",
+	"posts.title",
+	"posts.slug",
+	"posts.content",
+	"posts.state",
+	"posts.review_notes",
+	"posts.state_changed_at",
+	"posts.publish_at",
+	"posts.revision",
+	"posts.approved_revision",
+	"posts.likes",
+	"posts.views",
+	"posts.saves",
+	"posts.word_count",
+	"posts.reading_minutes",
+	"posts.code_blocks",
+	goqu.L("ARRAY(?)", databaseImpl.QueryBuilder.
+		From("post_tags").
+		Select("tags.name").
+		InnerJoin(goqu.T("tags"), goqu.On(goqu.Ex{"tags.id": goqu.I("post_tags.tag_id")})).
+		Where(goqu.Ex{"post_tags.post_id": goqu.I("posts.id")}).
+		Order(goqu.I("tags.name").Asc()),
+	),
+	"posts.created_at",
+	"posts.updated_at",
+	"posts.deleted_at",
+	"posts.hidden_at",
+	"posts.category_id",
+}
+
+// withSearchIndex adds the search columns kept in step with the post text.
+// EditorJS content is indexed by its extracted text, and title matches weigh
+// more than content ones.
+func withSearchIndex(record goqu.Record, model post.PostModel) goqu.Record {
+	text := content.PlainText(model.Content)
+
+	record["search_text"] = text
+	record["search_vector"] = goqu.L(
+		"setweight(to_tsvector('simple', ?), 'A') || setweight(to_tsvector('simple', ?), 'B')",
+		model.Title,
+		text,
+	)
+
+	return record
+}
+
+func selectPostsWithUser() *goqu.SelectDataset {
+	columns := append([]interface{}{}, postColumns...)
+	columns = append(columns, "users.email", "users.firstname")
+
+	return databaseImpl.QueryBuilder.
 		From("posts").
-		Select("posts.id", "posts.user_id", "posts.title", "posts.content", "posts.is_published", "posts.likes", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.category_id", "users.email", "users.firstname").
+		Select(columns...).
 		InnerJoin(goqu.T("users"), goqu.On(goqu.Ex{"posts.user_id": goqu.I("users.user_id")})).
-		Where(databaseImpl.Ex{"posts.user_id": userId}).
-		ToSQL()
-	fmt.Println(sql)
-	if err != nil {
-		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error get posts")
-	}
+		Where(notDeleted)
+}
 
+func (r *postRepository) queryPostsWithUser(
+	ctx context.Context,
+	sql string,
+	failMessage string,
+) ([]post.PostModelWithUser, error) {
 	rows, err := r.Conn(ctx).Query(ctx, sql)
-	fmt.Println(err)
 	if err != nil {
-		return nil, errors.Wrap(err, errors.DatabaseError, "get my posts failed")
+		return nil, errors.Wrap(err, errors.DatabaseError, failMessage)
 	}
 	defer rows.Close()
 
 	var posts []post.PostModelWithUser
 	for rows.Next() {
-		var p post.PostModelWithUser
-		var createdAt time.Time
-		var updatedAt time.Time
-		var deletedAt sqlS.NullTime
-		var category sqlS.NullInt64
-		var userEmail string
-		var userName string
-		if err := rows.Scan(&p.Id, &p.UserId, &p.Title, &p.Content, &p.IsPublished, &p.Likes, &createdAt, &updatedAt, &deletedAt, &category, &userEmail, &userName); err != nil {
+		p, err := scanPostWithUser(rows)
+		if err != nil {
 			return nil, errors.Wrap(err, errors.DatabaseError, "scan post failed")
 		}
-		p.CategoryId = category.Int64
-		p.CreatedAt = createdAt.Format(time.RFC3339)
-		p.UpdatedAt = updatedAt.Format(time.RFC3339)
-		p.UserEmail = userEmail
-		p.UserName = userName
-		if deletedAt.Valid {
-			p.DeletedAt = deletedAt.Time.Format(time.RFC3339)
-		} else {
-			p.DeletedAt = ""
-		}
 		posts = append(posts, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, failMessage)
+	}
 
 	return posts, nil
 }
 
-func (r *postRepository) GetPosts(
-	ctx context.Context,
-) ([]post.PostModelWithUser, error) {
-	sql, _, err := databaseImpl.QueryBuilder.From("posts").
-		Select("posts.id", "posts.user_id", "posts.title", "posts.content", "posts.is_published", "posts.likes", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.category_id", "users.email", "users.firstname").
-		InnerJoin(goqu.T("users"), goqu.On(goqu.Ex{"posts.user_id": goqu.I("users.user_id")})).
-		ToSQL()
-	fmt.Println(sql)
-	if err != nil {
-		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error get posts")
+// postScan collects nullable and time columns shared by every post query
+// before they are converted to the string based models.
+type postScan struct {
+	createdAt        time.Time
+	updatedAt        time.Time
+	stateChangedAt   time.Time
+	publishAt        sqlS.NullTime
+	deletedAt        sqlS.NullTime
+	hiddenAt         sqlS.NullTime
+	category         sqlS.NullInt64
+	approvedRevision sqlS.NullInt64
+}
+
+func (s *postScan) targets(p *post.PostModel) []interface{} {
+	return []interface{}{
+		&p.Id,
+		&p.UserId,
+		&p.Title,
+		&p.Slug,
+		&p.Content,
+		&p.State,
+		&p.ReviewNotes,
+		&s.stateChangedAt,
+		&s.publishAt,
+		&p.Revision,
+		&s.approvedRevision,
+		&p.Likes,
+		&p.Views,
+		&p.Saves,
+		&p.WordCount,
+		&p.ReadingMinutes,
+		&p.CodeBlocks,
+		&p.Tags,
+		&s.createdAt,
+		&s.updatedAt,
+		&s.deletedAt,
+		&s.hiddenAt,
+		&s.category,
 	}
+}
 
-	rows, err := r.Conn(ctx).Query(ctx, sql)
-	if err != nil {
-		return nil, errors.Wrap(err, errors.DatabaseError, "get posts failed")
+func (s *postScan) apply(p *post.PostModel) {
+	p.CategoryId = s.category.Int64
+	p.ApprovedRevision = s.approvedRevision.Int64
+	p.CreatedAt = s.createdAt.Format(time.RFC3339)
+	p.UpdatedAt = s.updatedAt.Format(time.RFC3339)
+	p.StateChangedAt = s.stateChangedAt.Format(time.RFC3339)
+	if s.publishAt.Valid {
+		p.PublishAt = s.publishAt.Time.Format(time.RFC3339)
+	} else {
+		p.PublishAt = ""
+	}
+	if s.deletedAt.Valid {
+		p.DeletedAt = s.deletedAt.Time.Format(time.RFC3339)
+	} else {
+		p.DeletedAt = ""
+	}
+	if s.hiddenAt.Valid {
+		p.HiddenAt = s.hiddenAt.Time.Format(time.RFC3339)
+	} else {
+		p.HiddenAt = ""
 	}
+}
 
-	defer rows.Close()
+func scanPost(row pgx.Row) (post.PostModel, error) {
+	var p post.PostModel
+	var s postScan
 
-	var posts []post.PostModelWithUser
-	for rows.Next() {
-		var p post.PostModelWithUser
-		var createdAt time.Time
-		var updatedAt time.Time
-		var deletedAt sqlS.NullTime
-		var category sqlS.NullInt64
-		var userEmail string
-		var userName string
-		if err := rows.Scan(&p.Id, &p.UserId, &p.Title, &p.Content, &p.IsPublished, &p.Likes, &createdAt, &updatedAt, &deletedAt, &category, &userEmail, &userName); err != nil {
-			fmt.Println(err)
-			return nil, errors.Wrap(err, errors.DatabaseError, "scan post failed")
-		}
-		fmt.Println(err)
-		p.CategoryId = category.Int64
-		p.CreatedAt = createdAt.Format(time.RFC3339)
-		p.UpdatedAt = updatedAt.Format(time.RFC3339)
-		p.UserEmail = userEmail
-		p.UserName = userName
-		if deletedAt.Valid {
-			p.DeletedAt = deletedAt.Time.Format(time.RFC3339)
-		} else {
-			p.DeletedAt = ""
-		}
-		posts = append(posts, p)
+	if err := row.Scan(s.targets(&p)...); err != nil {
+		return post.PostModel{}, err
 	}
+	s.apply(&p)
 
-	return posts, nil
+	return p, nil
+}
+
+// scanPostWithUser reads a post joined with its author. Extra targets take
+// the columns selected after the author ones.
+func scanPostWithUser(row pgx.Row, extra ...interface{}) (post.PostModelWithUser, error) {
+	var p post.PostModel
+	var s postScan
+	var userEmail string
+	var userName string
+
+	targets := append(s.targets(&p), &userEmail, &userName)
+	targets = append(targets, extra...)
+	if err := row.Scan(targets...); err != nil {
+		return post.PostModelWithUser{}, err
+	}
+	s.apply(&p)
+
+	return post.PostModelWithUser{
+		Id:             p.Id,
+		UserId:         p.UserId,
+		Title:          p.Title,
+		Slug:           p.Slug,
+		Content:        p.Content,
+		CategoryId:     p.CategoryId,
+		Likes:          p.Likes,
+		Views:          p.Views,
+		Saves:          p.Saves,
+		WordCount:      p.WordCount,
+		ReadingMinutes: p.ReadingMinutes,
+		CodeBlocks:     p.CodeBlocks,
+		Tags:           p.Tags,
+		State:          p.State,
+		ReviewNotes:    p.ReviewNotes,
+		StateChangedAt: p.StateChangedAt,
+		PublishAt:      p.PublishAt,
+		Revision:       p.Revision,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+		DeletedAt:      p.DeletedAt,
+		HiddenAt:       p.HiddenAt,
+		UserEmail:      userEmail,
+		UserName:       userName,
+	}, nil
 }
 
 func parseUpdatePostError(post *post.PostModel, err error) error {
@@ -307,6 +1143,54 @@ func parseUpdatePostError(post *post.PostModel, err error) error {
 	return errors.Wrapf(err, errors.DatabaseError, "update post failed")
 }
 
+func parseAddSlugError(slug string, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "slug \"%s\" is taken by another post", slug)
+	}
+	return errors.Wrapf(err, errors.DatabaseError, "add slug failed")
+}
+
+func parseGetPostError(postId int64, err error) error {
+	if err == pgx.ErrNoRows {
+		return errors.Wrapf(err, errors.NotFoundError, "post with id \"%d\" not found", postId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "scan post failed")
+}
+
+func parseAddRevisionError(revision *post.RevisionModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(
+			err,
+			errors.AlreadyExistsError,
+			"post \"%d\" was changed meanwhile, reload it and try again",
+			revision.PostId,
+		)
+	}
+	return errors.Wrap(err, errors.DatabaseError, "add post revision failed")
+}
+
+func parseGetRevisionError(postId int64, revision int64, err error) error {
+	if err == pgx.ErrNoRows {
+		return errors.Wrapf(err, errors.NotFoundError, "revision %d of post \"%d\" not found", revision, postId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "scan post revision failed")
+}
+
+func parseAddLikeError(like *post.LikeModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "post with id \"%d\" not found", like.PostId)
+	}
+	return errors.Wrapf(err, errors.DatabaseError, "add like failed")
+}
+
 func parseAddPostError(post *post.PostModel, err error) error {
 	pgErr, isPgErr := err.(*pgconn.PgError)
 
@@ -3,39 +3,134 @@ package impl
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
 	"fibo/internal/post"
+	"fibo/internal/reputation"
+	"fibo/internal/tag"
+	"fibo/internal/user"
 )
 
 type PostUsecaseOpts struct {
-	PostRepository post.PostRepository
-	TxManager      database.TxManager
+	PostRepository       post.PostRepository
+	ReputationRepository reputation.ReputationRepository
+	TagRepository        tag.TagRepository
+	TxManager            database.TxManager
+	Events               event.Bus
+	Config               post.Config
 }
 
 func NewPostUsecase(opts PostUsecaseOpts) post.PostUseCase {
 	return &postUseCase{
-		PostRepository: opts.PostRepository,
-		TxManager:      opts.TxManager,
+		PostRepository:       opts.PostRepository,
+		ReputationRepository: opts.ReputationRepository,
+		TagRepository:        opts.TagRepository,
+		TxManager:            opts.TxManager,
+		Bus:                  opts.Events,
+		Config:               opts.Config,
+		views:                newViewBuffer(),
 	}
 }
 
 type postUseCase struct {
 	post.PostRepository
+	reputation.ReputationRepository
+	tag.TagRepository
 	database.TxManager
+	event.Bus
+	post.Config
+
+	views *viewBuffer
 }
 
+// dueBatchSize is how many scheduled posts a check publishes at most.
+const dueBatchSize uint = 100
+
 func (p *postUseCase) LikePost(
 	ctx context.Context,
-	postId int64,
-	likes post.LikePostDto,
-) (err error) {
+	in post.LikePostDto,
+) (out post.PostLikesDto, err error) {
+	like, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
 	err = p.RunTx(ctx, func(ctx context.Context) error {
-		err = p.PostRepository.LikePost(ctx, postId, likes)
+		model, err := p.PostRepository.GetById(ctx, like.PostId)
+		if err != nil {
+			return err
+		}
+		if !model.IsPublished() {
+			return errors.New(errors.ValidationError, "only published posts can be liked")
+		}
+
+		added, err := p.PostRepository.AddLike(ctx, like)
+		if err != nil {
+			return err
+		}
+		if added && like.Rewarded(&model) {
+			if err := p.addReputationEvent(ctx, model, reputation.EventPostLiked, ""); err != nil {
+				return err
+			}
+			if err := p.emit(ctx, event.PostLiked, &model, like.UserId); err != nil {
+				return err
+			}
+		}
+
+		out.Likes, err = p.PostRepository.RefreshLikes(ctx, like.PostId)
 		return err
 	})
+	if err != nil {
+		return post.PostLikesDto{}, err
+	}
 
-	return err
+	out.PostId = like.PostId
+	out.Liked = true
+
+	return out, nil
+}
+
+func (p *postUseCase) UnlikePost(
+	ctx context.Context,
+	in post.LikePostDto,
+) (out post.PostLikesDto, err error) {
+	like, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	err = p.RunTx(ctx, func(ctx context.Context) error {
+		removed, err := p.PostRepository.RemoveLike(ctx, like)
+		if err != nil {
+			return err
+		}
+		if removed {
+			model, err := p.PostRepository.GetById(ctx, like.PostId)
+			if err != nil {
+				return err
+			}
+			if like.Rewarded(&model) {
+				if err := p.addReputationEvent(ctx, model, reputation.EventPostUnliked, ""); err != nil {
+					return err
+				}
+			}
+		}
+
+		out.Likes, err = p.PostRepository.RefreshLikes(ctx, like.PostId)
+		return err
+	})
+	if err != nil {
+		return post.PostLikesDto{}, err
+	}
+
+	out.PostId = like.PostId
+	out.Liked = false
+
+	return out, nil
 }
 
 func (p *postUseCase) GetTotalLikesCountByUser(
@@ -51,31 +146,51 @@ func (p *postUseCase) GetTotalLikesCountByUser(
 
 func (p *postUseCase) GetPublishedPosts(
 	ctx context.Context,
-) (posts []post.PostModelWithUser, err error) {
-	err = p.RunTx(ctx, func(ctx context.Context) error {
-		posts, err = p.PostRepository.GetPublishedPosts(ctx)
-		return err
-	})
-	return posts, err
+	in post.ListPostsDto,
+) (post.PostsPageDto, error) {
+	in.State = post.StatePublished
+
+	list, err := in.MapToModel()
+	if err != nil {
+		return post.PostsPageDto{}, err
+	}
+	list.PublishedBy = time.Now().UTC()
+	list.Public = true
+
+	page, err := p.PostRepository.ListPosts(ctx, list)
+	if err != nil {
+		return post.PostsPageDto{}, err
+	}
+
+	return post.PostsPageDto{}.MapFromModel(page), nil
 }
 
 func (p *postUseCase) GetMyPosts(
 	ctx context.Context,
 	userId int64,
-) (posts []post.PostModelWithUser, err error) {
-	err = p.RunTx(ctx, func(ctx context.Context) error {
-		posts, err = p.PostRepository.GetMyPosts(ctx, userId)
-		return err
-	})
-	return posts, err
+	in post.ListPostsDto,
+) (post.PostsPageDto, error) {
+	in.AuthorId = userId
+
+	return p.listPosts(ctx, in)
 }
 
-func (p *postUseCase) GetPosts(ctx context.Context) (posts []post.PostModelWithUser, err error) {
-	err = p.RunTx(ctx, func(ctx context.Context) error {
-		posts, err = p.PostRepository.GetPosts(ctx)
-		return err
-	})
-	return posts, err
+func (p *postUseCase) GetPosts(ctx context.Context, in post.ListPostsDto) (post.PostsPageDto, error) {
+	return p.listPosts(ctx, in)
+}
+
+func (p *postUseCase) listPosts(ctx context.Context, in post.ListPostsDto) (out post.PostsPageDto, err error) {
+	list, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	page, err := p.PostRepository.ListPosts(ctx, list)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(page), nil
 }
 
 func (p *postUseCase) GetPostById(ctx context.Context, id int64) (post post.PostModel, err error) {
@@ -87,21 +202,233 @@ func (p *postUseCase) GetPostById(ctx context.Context, id int64) (post post.Post
 	return post, err
 }
 
+// ViewPost returns the post and counts the read towards its views. Views
+// are counted for published posts only, and readers who can't be told apart
+// are not counted at all. Hidden posts are shown to their authors and
+// moderators only, without counting views.
+func (p *postUseCase) ViewPost(ctx context.Context, in post.ViewPostDto) (model post.PostModel, err error) {
+	model, err = p.GetPostById(ctx, in.PostId)
+	if err != nil {
+		return post.PostModel{}, err
+	}
+	if !model.IsPublic() && !model.IsManagedBy(in.UserId, in.UserRole) {
+		return post.PostModel{}, errors.Errorf(errors.NotFoundError, "post with id \"%d\" not found", in.PostId)
+	}
+
+	view, err := in.MapToModel()
+	if err == nil && model.IsPublic() {
+		counted, err := p.PostRepository.RecordView(ctx, view, p.ViewWindow())
+		if err != nil {
+			return post.PostModel{}, err
+		}
+		if counted {
+			p.views.add(view)
+		}
+	}
+
+	// The counter in the database lags behind by the views not flushed yet.
+	model.Views += p.views.pending(model.Id)
+
+	return model, nil
+}
+
+func (p *postUseCase) ViewPostBySlug(
+	ctx context.Context,
+	in post.ViewPostBySlugDto,
+) (out post.PostBySlugDto, err error) {
+	slug, err := p.PostRepository.GetSlug(ctx, strings.ToLower(in.Slug))
+	if err != nil {
+		return out, err
+	}
+
+	if !slug.Current {
+		model, err := p.GetPostById(ctx, slug.PostId)
+		if err != nil {
+			return out, err
+		}
+		if !model.IsPublic() && !model.IsManagedBy(in.UserId, in.UserRole) {
+			return out, errors.Errorf(errors.NotFoundError, "post with slug \"%s\" not found", in.Slug)
+		}
+
+		out.RedirectTo = model.Slug
+		return out, nil
+	}
+
+	model, err := p.ViewPost(ctx, post.ViewPostDto{
+		PostId:    slug.PostId,
+		UserId:    in.UserId,
+		UserRole:  in.UserRole,
+		VisitorId: in.VisitorId,
+	})
+	if err != nil {
+		return out, err
+	}
+
+	out.Post = &model
+	return out, nil
+}
+
+// FlushViews writes the buffered views to the posts and rewards authors
+// whose posts reached a view milestone in views of registered users. Views that failed to be written are
+// put back to be retried on the next flush.
+func (p *postUseCase) FlushViews(ctx context.Context) error {
+	views := p.views.take()
+	if len(views) == 0 {
+		return nil
+	}
+
+	err := p.RunTx(ctx, func(ctx context.Context) error {
+		counts, err := p.PostRepository.AddViews(ctx, views)
+		if err != nil {
+			return err
+		}
+
+		for _, count := range counts {
+			model := post.PostModel{Id: count.PostId, UserId: count.UserId}
+			for _, milestone := range count.Milestones() {
+				ref := fmt.Sprintf("%s:views:%d", reputation.Ref("post", count.PostId), milestone)
+				if err := p.addReputationEvent(ctx, model, reputation.EventViewMilestone, ref); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		p.views.putBack(views)
+		return err
+	}
+
+	return nil
+}
+
 func (p *postUseCase) UpdatePost(
 	ctx context.Context,
 	post post.UpdatePostDto,
 ) (err error) {
-	model, err := p.PostRepository.GetById(ctx, post.Id)
+	var tags []tag.TagModel
+	if post.Tags != nil {
+		if tags, err = tag.NewTags(post.Tags); err != nil {
+			return err
+		}
+	}
+
+	return p.RunTx(ctx, func(ctx context.Context) error {
+		model, err := p.PostRepository.GetById(ctx, post.Id)
+		if err != nil {
+			return err
+		}
+		if err := checkEditor(&model, post.UserId, post.UserRole); err != nil {
+			return err
+		}
+
+		err = model.Update(post.Title, post.Content, post.CategoryId)
+		if err != nil {
+			return err
+		}
+		model.Measure()
+
+		if post.Tags != nil {
+			if err := p.saveTags(ctx, model.Id, tags); err != nil {
+				return err
+			}
+		}
+
+		if err := p.saveRevision(ctx, &model, post.UserId); err != nil {
+			return err
+		}
+
+		return p.changed(ctx, &model, post.UserId)
+	})
+}
+
+// GetRevisions lists the history of a post. Drafts and rejected text live in
+// it, so only the author and moderators may read it.
+func (p *postUseCase) GetRevisions(ctx context.Context, in post.GetRevisionsDto) (out []post.RevisionDto, err error) {
+	model, err := p.PostRepository.GetById(ctx, in.PostId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if !model.IsManagedBy(in.UserId, in.UserRole) {
+		return nil, errors.New(errors.ForbiddenError, "only the author or a moderator can see the revisions of a post")
 	}
 
-	err = model.Update(post.Title, post.Content, post.IsPublished, post.Likes, post.CategoryId)
+	revisions, err := p.PostRepository.GetRevisions(ctx, model.Id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	modelId, err := p.PostRepository.Update(ctx, model)
+	out = []post.RevisionDto{}
+	for _, revision := range revisions {
+		out = append(out, post.RevisionDto{}.MapFromModel(revision))
+	}
+
+	return out, nil
+}
+
+func (p *postUseCase) DiffRevisions(ctx context.Context, in post.DiffRevisionsDto) (out post.RevisionDiffDto, err error) {
+	model, err := p.PostRepository.GetById(ctx, in.PostId)
+	if err != nil {
+		return out, err
+	}
+	if !model.IsManagedBy(in.UserId, in.UserRole) {
+		return out, errors.New(errors.ForbiddenError, "only the author or a moderator can see the revisions of a post")
+	}
+
+	fromRevision, toRevision, err := model.DiffRange(in.From, in.To)
+	if err != nil {
+		return out, err
+	}
+
+	from, err := p.PostRepository.GetRevision(ctx, model.Id, fromRevision)
+	if err != nil {
+		return out, err
+	}
+
+	to, err := p.PostRepository.GetRevision(ctx, model.Id, toRevision)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(post.NewRevisionDiff(from, to)), nil
+}
+
+// RestoreRevision brings back the text of an older revision. The history is
+// kept as is: the restored text becomes the newest revision.
+func (p *postUseCase) RestoreRevision(ctx context.Context, in post.RestoreRevisionDto) error {
+	return p.RunTx(ctx, func(ctx context.Context) error {
+		model, err := p.PostRepository.GetById(ctx, in.PostId)
+		if err != nil {
+			return err
+		}
+		if err := checkEditor(&model, in.UserId, in.UserRole); err != nil {
+			return err
+		}
+
+		revision, err := p.PostRepository.GetRevision(ctx, model.Id, in.Revision)
+		if err != nil {
+			return err
+		}
+
+		if err := model.Restore(revision); err != nil {
+			return err
+		}
+		model.Measure()
+
+		if err := p.saveRevision(ctx, &model, in.UserId); err != nil {
+			return err
+		}
+
+		return p.changed(ctx, &model, in.UserId)
+	})
+}
+
+// saveRevision stores the changed post along with a new snapshot of it.
+func (p *postUseCase) saveRevision(ctx context.Context, model *post.PostModel, userId int64) error {
+	revision := model.NewRevision(userId)
+
+	modelId, err := p.PostRepository.Update(ctx, *model)
 	if err != nil {
 		return err
 	}
@@ -110,23 +437,440 @@ func (p *postUseCase) UpdatePost(
 		return fmt.Errorf("model id and returned id are different")
 	}
 
-	return nil
+	if _, err = p.PostRepository.AddRevision(ctx, revision); err != nil {
+		return err
+	}
+
+	// Slugs follow the title, the old one keeps pointing to the post.
+	if post.IsSlugOf(model.Slug, post.NewSlug(model.Title)) {
+		return nil
+	}
+	if model.Slug, err = p.slugFor(ctx, model.Id, model.Title); err != nil {
+		return err
+	}
+	return p.PostRepository.AddSlug(ctx, model.Id, model.Slug)
+}
+
+// slugFor picks a slug for the title no other post has.
+func (p *postUseCase) slugFor(ctx context.Context, postId int64, title string) (string, error) {
+	base := post.NewSlug(title)
+
+	taken, err := p.PostRepository.GetSlugs(ctx, base)
+	if err != nil {
+		return "", err
+	}
+
+	return post.UniqueSlug(base, postId, taken), nil
+}
+
+func (p *postUseCase) DeletePost(ctx context.Context, in post.DeletePostDto) error {
+	return p.RunTx(ctx, func(ctx context.Context) error {
+		model, err := p.PostRepository.GetById(ctx, in.PostId)
+		if err != nil {
+			return err
+		}
+		if err := checkOwner(&model, in); err != nil {
+			return err
+		}
+
+		if err := p.PostRepository.SoftDelete(ctx, model.Id); err != nil {
+			return err
+		}
+
+		return p.changed(ctx, &model, in.UserId)
+	})
 }
 
-func (p *postUseCase) AddPost(ctx context.Context, post post.AddPostDto) (postId int64, err error) {
-	model, err := post.MapToModel()
+func (p *postUseCase) RestorePost(ctx context.Context, in post.DeletePostDto) error {
+	return p.RunTx(ctx, func(ctx context.Context) error {
+		model, err := p.PostRepository.GetDeletedById(ctx, in.PostId)
+		if err != nil {
+			return err
+		}
+		if err := checkOwner(&model, in); err != nil {
+			return err
+		}
+
+		if err := p.PostRepository.Restore(ctx, model.Id); err != nil {
+			return err
+		}
+
+		return p.changed(ctx, &model, in.UserId)
+	})
+}
+
+// PurgePosts hard-deletes posts which stayed in the trash longer than the
+// retention period.
+func (p *postUseCase) PurgePosts(ctx context.Context) (out post.PurgePostsDto, err error) {
+	deletedBefore := time.Now().UTC().Add(-p.Config.RetentionPeriod())
+
+	out.Purged, err = p.PostRepository.Purge(ctx, deletedBefore)
+	if err != nil {
+		return post.PurgePostsDto{}, err
+	}
+
+	out.DeletedBefore = deletedBefore.Format(time.RFC3339)
+
+	return out, nil
+}
+
+func (p *postUseCase) AddPost(ctx context.Context, in post.AddPostDto) (postId int64, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+	model.Measure()
+
+	tags, err := tag.NewTags(in.Tags)
 	if err != nil {
 		return 0, err
 	}
 
 	err = p.RunTx(ctx, func(ctx context.Context) error {
+		revision := model.NewRevision(in.UserId)
+
+		if model.Slug, err = p.slugFor(ctx, 0, model.Title); err != nil {
+			return err
+		}
+
 		postId, err = p.PostRepository.Create(ctx, model)
 		if err != nil {
 			return err
 		}
 		model.Id = postId
+
+		if err := p.PostRepository.AddSlug(ctx, postId, model.Slug); err != nil {
+			return err
+		}
+
+		revision.PostId = postId
+		if _, err := p.PostRepository.AddRevision(ctx, revision); err != nil {
+			return err
+		}
+		if len(tags) > 0 {
+			if err := p.saveTags(ctx, postId, tags); err != nil {
+				return err
+			}
+		}
+
+		if in.Submit {
+			return p.moveTo(ctx, &model, post.StateSubmitted, in.UserId, "")
+		}
 		return nil
 	})
 
 	return postId, err
 }
+
+// saveTags makes the tags the only tags of the post.
+func (p *postUseCase) saveTags(ctx context.Context, postId int64, tags []tag.TagModel) error {
+	tagIds := []int64{}
+	if len(tags) > 0 {
+		saved, err := p.TagRepository.Save(ctx, tags)
+		if err != nil {
+			return err
+		}
+		for _, t := range saved {
+			tagIds = append(tagIds, t.Id)
+		}
+	}
+
+	return p.TagRepository.SetPostTags(ctx, postId, tagIds)
+}
+
+func (p *postUseCase) SubmitPost(ctx context.Context, in post.TransitionPostDto) error {
+	return p.transition(ctx, in, func(model *post.PostModel) error {
+		if !model.IsAuthor(in.ActorId) {
+			return errors.New(errors.ForbiddenError, "only the author can submit a post")
+		}
+
+		return p.moveTo(ctx, model, post.StateSubmitted, in.ActorId, in.Notes)
+	})
+}
+
+// WithdrawPost takes a post the author submitted back to draft, whether a
+// reviewer is on it or has approved it for later already.
+func (p *postUseCase) WithdrawPost(ctx context.Context, in post.TransitionPostDto) error {
+	return p.transition(ctx, in, func(model *post.PostModel) error {
+		if !model.IsAuthor(in.ActorId) {
+			return errors.New(errors.ForbiddenError, "only the author can withdraw a post")
+		}
+		if model.IsPublished() {
+			return errors.New(errors.ValidationError, "a published post is unpublished, not withdrawn")
+		}
+
+		return p.moveTo(ctx, model, post.StateDraft, in.ActorId, in.Notes)
+	})
+}
+
+// UnpublishPost takes a published post offline and back to draft, from
+// where it goes through review again.
+func (p *postUseCase) UnpublishPost(ctx context.Context, in post.TransitionPostDto) error {
+	return p.transition(ctx, in, func(model *post.PostModel) error {
+		if !model.IsAuthor(in.ActorId) && !in.ActorRole.CanEditAnyPost() {
+			return errors.New(errors.ForbiddenError, "only the author can unpublish a post")
+		}
+		if !model.IsPublished() {
+			return errors.New(errors.ValidationError, "only a published post can be unpublished")
+		}
+
+		if err := p.moveTo(ctx, model, post.StateDraft, in.ActorId, in.Notes); err != nil {
+			return err
+		}
+
+		return p.emit(ctx, event.PostChanged, model, in.ActorId)
+	})
+}
+
+func (p *postUseCase) StartReview(ctx context.Context, in post.TransitionPostDto) error {
+	return p.transition(ctx, in, func(model *post.PostModel) error {
+		if err := checkReviewer(model, in.ActorId); err != nil {
+			return err
+		}
+
+		return p.moveTo(ctx, model, post.StateInReview, in.ActorId, in.Notes)
+	})
+}
+
+func (p *postUseCase) ApprovePost(ctx context.Context, in post.TransitionPostDto) error {
+	publishAt, err := post.ParsePublishAt(in.PublishAt, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return p.transition(ctx, in, func(model *post.PostModel) error {
+		if err := checkReviewer(model, in.ActorId); err != nil {
+			return err
+		}
+
+		model.PublishAt = ""
+		if !publishAt.IsZero() {
+			model.PublishAt = publishAt.Format(time.RFC3339)
+		}
+		if err := p.moveTo(ctx, model, post.StateApproved, in.ActorId, in.Notes); err != nil {
+			return err
+		}
+
+		// The ref keeps a post that is approved again from earning twice.
+		err := p.addReputationEvent(ctx, *model, reputation.EventPostApproved, reputation.Ref("post", model.Id))
+		if err != nil {
+			return err
+		}
+		if err := p.emit(ctx, event.PostApproved, model, in.ActorId); err != nil {
+			return err
+		}
+
+		// Scheduled posts are left for PublishScheduled.
+		if model.PublishAt != "" {
+			return nil
+		}
+		return p.publish(ctx, model, in.ActorId)
+	})
+}
+
+func (p *postUseCase) PublishScheduled(ctx context.Context) (out post.PublishScheduledDto, err error) {
+	out.Published = []int64{}
+
+	err = p.RunTx(ctx, func(ctx context.Context) error {
+		due, err := p.PostRepository.GetDuePosts(ctx, time.Now().UTC(), dueBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range due {
+			if err := p.publish(ctx, &due[i], 0); err != nil {
+				return err
+			}
+			out.Published = append(out.Published, due[i].Id)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return post.PublishScheduledDto{}, err
+	}
+
+	return out, nil
+}
+
+// publish moves an approved post live and tells the rest of the app about
+// it. The zero actor is the scheduler.
+func (p *postUseCase) publish(ctx context.Context, model *post.PostModel, actorId int64) error {
+	if model.PublishAt == "" {
+		model.PublishAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	if err := p.moveTo(ctx, model, post.StatePublished, actorId, ""); err != nil {
+		return err
+	}
+
+	return p.Bus.Publish(ctx, event.Event{
+		Name:    event.PostPublished,
+		UserId:  model.UserId,
+		ActorId: actorId,
+		PostId:  model.Id,
+	})
+}
+
+// emit tells the rest of the app what happened to the post.
+func (p *postUseCase) emit(ctx context.Context, name event.Name, model *post.PostModel, actorId int64) error {
+	return p.Bus.Publish(ctx, event.Event{
+		Name:    name,
+		UserId:  model.UserId,
+		ActorId: actorId,
+		PostId:  model.Id,
+	})
+}
+
+// changed tells the rest of the app that readers see the post differently
+// now. Posts which are not published yet are nobody else's business.
+func (p *postUseCase) changed(ctx context.Context, model *post.PostModel, actorId int64) error {
+	if !model.IsPublished() {
+		return nil
+	}
+
+	return p.Bus.Publish(ctx, event.Event{
+		Name:    event.PostChanged,
+		UserId:  model.UserId,
+		ActorId: actorId,
+		PostId:  model.Id,
+	})
+}
+
+func (p *postUseCase) RejectPost(ctx context.Context, in post.TransitionPostDto) error {
+	return p.transition(ctx, in, func(model *post.PostModel) error {
+		if err := checkReviewer(model, in.ActorId); err != nil {
+			return err
+		}
+
+		if err := p.moveTo(ctx, model, post.StateRejected, in.ActorId, in.Notes); err != nil {
+			return err
+		}
+
+		return p.emit(ctx, event.PostRejected, model, in.ActorId)
+	})
+}
+
+func (p *postUseCase) GetReviewQueue(ctx context.Context) (posts []post.PostModelWithUser, err error) {
+	err = p.RunTx(ctx, func(ctx context.Context) error {
+		posts, err = p.PostRepository.GetReviewQueue(ctx)
+		return err
+	})
+	return posts, err
+}
+
+// GetPostTransitions returns the review history of the post, notes of the
+// reviewers included. Others than its author and moderators don't find it.
+func (p *postUseCase) GetPostTransitions(
+	ctx context.Context,
+	in post.GetTransitionsDto,
+) (out []post.TransitionDto, err error) {
+	model, err := p.PostRepository.GetById(ctx, in.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if !model.IsManagedBy(in.UserId, in.UserRole) {
+		return nil, errors.Errorf(errors.NotFoundError, "post with id \"%d\" not found", in.PostId)
+	}
+
+	models, err := p.PostRepository.GetTransitions(ctx, in.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []post.TransitionDto{}
+	for _, model := range models {
+		out = append(out, post.TransitionDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// transition loads the post inside a transaction and hands it over to move,
+// which validates the actor and performs the state changes.
+func (p *postUseCase) transition(
+	ctx context.Context,
+	in post.TransitionPostDto,
+	move func(model *post.PostModel) error,
+) error {
+	return p.RunTx(ctx, func(ctx context.Context) error {
+		model, err := p.PostRepository.GetById(ctx, in.PostId)
+		if err != nil {
+			return err
+		}
+
+		return move(&model)
+	})
+}
+
+func (p *postUseCase) moveTo(
+	ctx context.Context,
+	model *post.PostModel,
+	to post.State,
+	actorId int64,
+	notes string,
+) error {
+	transition, err := model.Transition(to, actorId, notes)
+	if err != nil {
+		return err
+	}
+
+	if err := p.PostRepository.UpdateState(ctx, *model); err != nil {
+		return err
+	}
+
+	_, err = p.PostRepository.AddTransition(ctx, transition)
+	return err
+}
+
+func checkReviewer(model *post.PostModel, actorId int64) error {
+	if model.IsAuthor(actorId) {
+		return errors.New(errors.ForbiddenError, "authors cannot review their own posts")
+	}
+
+	return nil
+}
+
+// checkEditor lets editors change any post and authors their own while it
+// is not in front of reviewers or readers, so that no text goes live without
+// review.
+func checkEditor(model *post.PostModel, userId int64, role user.Role) error {
+	if role.CanEditAnyPost() {
+		return nil
+	}
+	if !model.IsAuthor(userId) {
+		return errors.New(errors.ForbiddenError, "only the author can edit a post")
+	}
+	if !model.State.IsEditable() {
+		return errors.Errorf(
+			errors.ValidationError,
+			"post in state \"%s\" cannot be edited, withdraw or unpublish it first",
+			model.State,
+		)
+	}
+
+	return nil
+}
+
+func checkOwner(model *post.PostModel, in post.DeletePostDto) error {
+	if !model.IsAuthor(in.UserId) && !in.UserRole.CanDeleteAnyPost() {
+		return errors.New(errors.ForbiddenError, "only the author can delete or restore a post")
+	}
+
+	return nil
+}
+
+// addReputationEvent records the event for the author of the post.
+func (p *postUseCase) addReputationEvent(
+	ctx context.Context,
+	model post.PostModel,
+	event reputation.Event,
+	ref string,
+) error {
+	eventModel, err := reputation.NewEvent(model.UserId, event, model.Id, ref)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.ReputationRepository.AddEvent(ctx, eventModel)
+	return err
+}
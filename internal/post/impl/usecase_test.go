@@ -0,0 +1,1570 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/post"
+	"fibo/internal/reputation"
+	"fibo/internal/tag"
+	"fibo/internal/user"
+
+	dbMock "fibo/internal/base/database/mock"
+	eventMock "fibo/internal/base/event/mock"
+	postMock "fibo/internal/post/mock"
+	reputationMock "fibo/internal/reputation/mock"
+	tagMock "fibo/internal/tag/mock"
+)
+
+func TestPostUsecases_LikePost(t *testing.T) {
+	visitorId := "5d3b1f43-6f1c-4a57-9a35-0c9a9d0e7a11"
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", Content: "Content", State: post.StatePublished}
+
+	in := post.LikePostDto{PostId: getPost.Id, VisitorId: visitorId}
+	like := post.LikeModel{PostId: getPost.Id, VisitorId: visitorId}
+
+	t.Run("expect an anonymous like to count but give no reputation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().AddLike(mock.Anything, like).Return(true, nil)
+		prep.postRepo.EXPECT().RefreshLikes(mock.Anything, getPost.Id).Return(int64(7), nil)
+
+		out, err := prep.postUsecases.LikePost(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, post.PostLikesDto{PostId: getPost.Id, Likes: 7, Liked: true}, out)
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect repeated like to be idempotent", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().AddLike(mock.Anything, like).Return(false, nil)
+		prep.postRepo.EXPECT().RefreshLikes(mock.Anything, getPost.Id).Return(int64(7), nil)
+
+		out, err := prep.postUsecases.LikePost(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(7), out.Likes)
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect a registered like to give reputation, its identity winning over visitor id", func(t *testing.T) {
+		prep := newTestPrep()
+		userIn := in
+		userIn.UserId = 3
+		userLike := post.LikeModel{PostId: getPost.Id, UserId: 3}
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().AddLike(mock.Anything, userLike).Return(true, nil)
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, reputation.EventModel{
+			UserId: getPost.UserId,
+			Event:  reputation.EventPostLiked,
+			PostId: getPost.Id,
+		}).Return(true, nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{
+			Name:    event.PostLiked,
+			UserId:  getPost.UserId,
+			ActorId: 3,
+			PostId:  getPost.Id,
+		}).Return(nil)
+		prep.postRepo.EXPECT().RefreshLikes(mock.Anything, getPost.Id).Return(int64(1), nil)
+
+		_, err := prep.postUsecases.LikePost(prep.ctx, userIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect own like to give no reputation", func(t *testing.T) {
+		prep := newTestPrep()
+		ownIn := post.LikePostDto{PostId: getPost.Id, UserId: getPost.UserId}
+		ownLike := post.LikeModel{PostId: getPost.Id, UserId: getPost.UserId}
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().AddLike(mock.Anything, ownLike).Return(true, nil)
+		prep.postRepo.EXPECT().RefreshLikes(mock.Anything, getPost.Id).Return(int64(1), nil)
+
+		_, err := prep.postUsecases.LikePost(prep.ctx, ownIn)
+
+		require.NoError(t, err)
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails without any identity", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.postUsecases.LikePost(prep.ctx, post.LikePostDto{PostId: getPost.Id})
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it fails if post is not published", func(t *testing.T) {
+		prep := newTestPrep()
+		draft := getPost
+		draft.State = post.StateDraft
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(draft, nil)
+
+		_, err := prep.postUsecases.LikePost(prep.ctx, in)
+
+		require.Error(t, err)
+	})
+}
+
+func TestPostUsecases_UnlikePost(t *testing.T) {
+	in := post.LikePostDto{PostId: 1, UserId: 3}
+	like := post.LikeModel{PostId: 1, UserId: 3}
+
+	t.Run("expect it unlikes post", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().RemoveLike(mock.Anything, like).Return(true, nil)
+		prep.postRepo.EXPECT().GetById(mock.Anything, like.PostId).
+			Return(post.PostModel{Id: like.PostId, UserId: 2, State: post.StatePublished}, nil)
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, reputation.EventModel{
+			UserId: 2,
+			Event:  reputation.EventPostUnliked,
+			PostId: like.PostId,
+		}).Return(true, nil)
+		prep.postRepo.EXPECT().RefreshLikes(mock.Anything, like.PostId).Return(int64(0), nil)
+
+		out, err := prep.postUsecases.UnlikePost(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, post.PostLikesDto{PostId: like.PostId, Likes: 0, Liked: false}, out)
+	})
+
+	t.Run("expect an anonymous unlike to take no reputation", func(t *testing.T) {
+		prep := newTestPrep()
+		visitorId := "5d3b1f43-6f1c-4a57-9a35-0c9a9d0e7a11"
+		anonymous := post.LikeModel{PostId: 1, VisitorId: visitorId}
+
+		prep.postRepo.EXPECT().RemoveLike(mock.Anything, anonymous).Return(true, nil)
+		prep.postRepo.EXPECT().GetById(mock.Anything, anonymous.PostId).
+			Return(post.PostModel{Id: anonymous.PostId, UserId: 2, State: post.StatePublished}, nil)
+		prep.postRepo.EXPECT().RefreshLikes(mock.Anything, anonymous.PostId).Return(int64(0), nil)
+
+		_, err := prep.postUsecases.UnlikePost(prep.ctx, post.LikePostDto{PostId: 1, VisitorId: visitorId})
+
+		require.NoError(t, err)
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if like removing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("like removing failed")
+
+		prep.postRepo.EXPECT().RemoveLike(mock.Anything, like).Return(false, err)
+
+		_, actualErr := prep.postUsecases.UnlikePost(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestPostUsecases_AddPost(t *testing.T) {
+	content := `{"blocks":[` +
+		`{"type":"header","data":{"text":"Hello <b>world</b>","level":2}},` +
+		`{"type":"paragraph","data":{"text":"Three more words"}},` +
+		`{"type":"code","data":{"code":"x := 1"}}]}`
+
+	in := post.AddPostDto{UserId: 2, Title: "Title", Content: content, CategoryId: 1}
+
+	t.Run("expect it measures the EditorJS blocks, not the JSON", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, "title").Return(nil, nil)
+		prep.postRepo.EXPECT().Create(mock.Anything, post.PostModel{
+			UserId:         in.UserId,
+			Title:          in.Title,
+			Slug:           "title",
+			Content:        content,
+			CategoryId:     in.CategoryId,
+			State:          post.StateDraft,
+			Revision:       1,
+			WordCount:      8,
+			ReadingMinutes: 1,
+			CodeBlocks:     1,
+		}).Return(int64(5), nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, int64(5), "title").Return(nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.Anything).Return(int64(1), nil)
+
+		postId, err := prep.postUsecases.AddPost(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(5), postId)
+	})
+
+	t.Run("expect it saves the tags of the post", func(t *testing.T) {
+		prep := newTestPrep()
+		taggedIn := in
+		taggedIn.Tags = []string{"#Go", "go", "Web  Dev"}
+
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, mock.Anything).Return(nil, nil)
+		prep.postRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(int64(5), nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, int64(5), mock.Anything).Return(nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.Anything).Return(int64(1), nil)
+		prep.tagRepo.EXPECT().Save(mock.Anything, []tag.TagModel{
+			{Name: "go", Slug: "go"},
+			{Name: "web dev", Slug: "web-dev"},
+		}).Return([]tag.TagModel{{Id: 3, Name: "go", Slug: "go"}, {Id: 8, Name: "web dev", Slug: "web-dev"}}, nil)
+		prep.tagRepo.EXPECT().SetPostTags(mock.Anything, int64(5), []int64{3, 8}).Return(nil)
+
+		_, err := prep.postUsecases.AddPost(prep.ctx, taggedIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it numbers the slug when another post has it", func(t *testing.T) {
+		prep := newTestPrep()
+		mongolianIn := in
+		mongolianIn.Title = "Шинэ жилийн мэнд!"
+
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, "shine-jiliin-mend").Return([]post.SlugModel{
+			{Slug: "shine-jiliin-mend", PostId: 3},
+			{Slug: "shine-jiliin-mend-2", PostId: 4},
+		}, nil)
+		prep.postRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(model post.PostModel) bool {
+			return model.Slug == "shine-jiliin-mend-3"
+		})).Return(int64(5), nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, int64(5), "shine-jiliin-mend-3").Return(nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.Anything).Return(int64(1), nil)
+
+		_, err := prep.postUsecases.AddPost(prep.ctx, mongolianIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails on too many tags", func(t *testing.T) {
+		prep := newTestPrep()
+		taggedIn := in
+		for i := 0; i <= tag.MaxPostTags; i++ {
+			taggedIn.Tags = append(taggedIn.Tags, fmt.Sprintf("tag%d", i))
+		}
+
+		_, err := prep.postUsecases.AddPost(prep.ctx, taggedIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails on unknown blocks", func(t *testing.T) {
+		prep := newTestPrep()
+		unknownIn := in
+		unknownIn.Content = `{"blocks":[{"type":"embed","data":{"source":"https://example.com"}}]}`
+
+		_, err := prep.postUsecases.AddPost(prep.ctx, unknownIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails on content that is not EditorJS", func(t *testing.T) {
+		prep := newTestPrep()
+		htmlIn := in
+		htmlIn.Content = "<p>Hello</p>"
+
+		_, err := prep.postUsecases.AddPost(prep.ctx, htmlIn)
+
+		require.Error(t, err)
+		prep.postRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_UpdatePost(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", Slug: "title", Content: paragraph("Content"), CategoryId: 1, State: post.StateDraft, Revision: 3}
+
+	in := post.UpdatePostDto{Id: getPost.Id, Title: "New title", UserId: getPost.UserId, UserRole: user.RoleAuthor}
+
+	t.Run("expect author updates own post", func(t *testing.T) {
+		prep := newTestPrep()
+		updated := getPost
+		updated.Title = in.Title
+		updated.CategoryId = in.CategoryId
+		updated.Revision = 4
+		updated.WordCount = 1
+		updated.ReadingMinutes = 1
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, updated).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, post.RevisionModel{
+			PostId:     getPost.Id,
+			Revision:   4,
+			Title:      in.Title,
+			Content:    getPost.Content,
+			CategoryId: 0,
+			UserId:     getPost.UserId,
+		}).Return(int64(12), nil)
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, "new-title").
+			Return([]post.SlugModel{{Slug: "new-title", PostId: 8}}, nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, getPost.Id, "new-title-2").Return(nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.tagRepo.AssertNotCalled(t, "SetPostTags", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect empty tags remove the tags of the post", func(t *testing.T) {
+		prep := newTestPrep()
+		untagIn := in
+		untagIn.Title = ""
+		untagIn.Tags = []string{}
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.Anything).Return(int64(12), nil)
+		prep.tagRepo.EXPECT().SetPostTags(mock.Anything, getPost.Id, []int64{}).Return(nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, untagIn)
+
+		require.NoError(t, err)
+		prep.tagRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		prep.postRepo.AssertNotCalled(t, "AddSlug", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect editor updates someone else's post", func(t *testing.T) {
+		prep := newTestPrep()
+		editorIn := in
+		editorIn.UserId = 9
+		editorIn.UserRole = user.RoleEditor
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.MatchedBy(func(revision post.RevisionModel) bool {
+			return revision.UserId == editorIn.UserId
+		})).Return(int64(12), nil)
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, mock.Anything).Return(nil, nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, getPost.Id, mock.Anything).Return(nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, editorIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a title-only edit to keep legacy content", func(t *testing.T) {
+		prep := newTestPrep()
+		legacy := getPost
+		legacy.Content = "<p>Written before EditorJS</p>"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(legacy, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model post.PostModel) bool {
+			return model.Title == in.Title && model.Content == legacy.Content
+		})).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.Anything).Return(int64(12), nil)
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, mock.Anything).Return(nil, nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, getPost.Id, mock.Anything).Return(nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect new content to be stored sanitized", func(t *testing.T) {
+		prep := newTestPrep()
+		unsafeIn := in
+		unsafeIn.Title = ""
+		unsafeIn.Content = `{"time":1,"blocks":[{"type":"paragraph","data":{"text":"Hi<script>alert(1)</script> <b onclick=\"x()\">there"}}]}`
+		sanitized := `{"time":1,"blocks":[{"type":"paragraph","data":{"text":"Hi <b>there</b>"}}]}`
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model post.PostModel) bool {
+			return model.Content == sanitized
+		})).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.MatchedBy(func(revision post.RevisionModel) bool {
+			return revision.Content == sanitized
+		})).Return(int64(12), nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, unsafeIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if new content is not an EditorJS document", func(t *testing.T) {
+		prep := newTestPrep()
+		htmlIn := in
+		htmlIn.Content = "<p>New content</p>"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, htmlIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect author to be refused editing a post after review", func(t *testing.T) {
+		for _, state := range []post.State{post.StateSubmitted, post.StateInReview, post.StateApproved, post.StatePublished} {
+			prep := newTestPrep()
+			reviewed := getPost
+			reviewed.State = state
+
+			prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(reviewed, nil)
+
+			err := prep.postUsecases.UpdatePost(prep.ctx, in)
+
+			var baseErr *baseErrors.Error
+			require.ErrorAs(t, err, &baseErr, state)
+			require.Equal(t, baseErrors.ValidationError, baseErr.Status(), state)
+			prep.postRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("expect author to edit a rejected post", func(t *testing.T) {
+		prep := newTestPrep()
+		rejected := getPost
+		rejected.State = post.StateRejected
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(rejected, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.Anything).Return(int64(12), nil)
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, mock.Anything).Return(nil, nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, getPost.Id, mock.Anything).Return(nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if author updates someone else's post", func(t *testing.T) {
+		prep := newTestPrep()
+		otherIn := in
+		otherIn.UserId = 9
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.UpdatePost(prep.ctx, otherIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+	})
+}
+
+func TestPostUsecases_SubmitPost(t *testing.T) {
+	authorId := int64(2)
+	getPost := post.PostModel{Id: 1, UserId: authorId, Title: "Title", Content: "Content", State: post.StateDraft}
+
+	in := post.TransitionPostDto{PostId: getPost.Id, ActorId: authorId}
+
+	t.Run("expect it submits draft and records transition", func(t *testing.T) {
+		prep := newTestPrep()
+		submitted := getPost
+		submitted.State = post.StateSubmitted
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, submitted).Return(nil)
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, post.TransitionModel{
+			PostId:    getPost.Id,
+			FromState: post.StateDraft,
+			ToState:   post.StateSubmitted,
+			ActorId:   authorId,
+		}).Return(int64(1), nil)
+
+		err := prep.postUsecases.SubmitPost(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if someone else submits the post", func(t *testing.T) {
+		prep := newTestPrep()
+		otherIn := in
+		otherIn.ActorId = 9
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.SubmitPost(prep.ctx, otherIn)
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it fails if post is already published", func(t *testing.T) {
+		prep := newTestPrep()
+		published := getPost
+		published.State = post.StatePublished
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(published, nil)
+
+		err := prep.postUsecases.SubmitPost(prep.ctx, in)
+
+		require.Error(t, err)
+	})
+}
+
+func TestPostUsecases_WithdrawPost(t *testing.T) {
+	authorId := int64(2)
+	getPost := post.PostModel{Id: 1, UserId: authorId, Title: "Title", Content: "Content", State: post.StateApproved, PublishAt: "2030-01-01T00:00:00Z"}
+
+	in := post.TransitionPostDto{PostId: getPost.Id, ActorId: authorId}
+
+	t.Run("expect it takes a scheduled post back to draft", func(t *testing.T) {
+		prep := newTestPrep()
+		withdrawn := getPost
+		withdrawn.State = post.StateDraft
+		withdrawn.PublishAt = ""
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, withdrawn).Return(nil)
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, post.TransitionModel{
+			PostId:    getPost.Id,
+			FromState: post.StateApproved,
+			ToState:   post.StateDraft,
+			ActorId:   authorId,
+		}).Return(int64(1), nil)
+
+		err := prep.postUsecases.WithdrawPost(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if someone else withdraws the post", func(t *testing.T) {
+		prep := newTestPrep()
+		otherIn := in
+		otherIn.ActorId = 9
+		otherIn.ActorRole = user.RoleEditor
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.WithdrawPost(prep.ctx, otherIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+	})
+
+	t.Run("expect it fails for a published post", func(t *testing.T) {
+		prep := newTestPrep()
+		published := getPost
+		published.State = post.StatePublished
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(published, nil)
+
+		err := prep.postUsecases.WithdrawPost(prep.ctx, in)
+
+		require.Error(t, err)
+		prep.postRepo.AssertNotCalled(t, "UpdateState", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_UnpublishPost(t *testing.T) {
+	authorId := int64(2)
+	getPost := post.PostModel{Id: 1, UserId: authorId, Title: "Title", Content: "Content", State: post.StatePublished, PublishAt: "2022-01-01T00:00:00Z"}
+
+	in := post.TransitionPostDto{PostId: getPost.Id, ActorId: authorId}
+
+	t.Run("expect it takes the post offline and tells about it", func(t *testing.T) {
+		prep := newTestPrep()
+		unpublished := getPost
+		unpublished.State = post.StateDraft
+		unpublished.PublishAt = ""
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, unpublished).Return(nil)
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, post.TransitionModel{
+			PostId:    getPost.Id,
+			FromState: post.StatePublished,
+			ToState:   post.StateDraft,
+			ActorId:   authorId,
+		}).Return(int64(1), nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{
+			Name:    event.PostChanged,
+			UserId:  authorId,
+			ActorId: authorId,
+			PostId:  getPost.Id,
+		}).Return(nil)
+
+		err := prep.postUsecases.UnpublishPost(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect an editor to unpublish someone else's post", func(t *testing.T) {
+		prep := newTestPrep()
+		editorIn := in
+		editorIn.ActorId = 9
+		editorIn.ActorRole = user.RoleEditor
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, mock.Anything).Return(nil)
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, mock.Anything).Return(int64(1), nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.Anything).Return(nil)
+
+		err := prep.postUsecases.UnpublishPost(prep.ctx, editorIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if a reader unpublishes the post", func(t *testing.T) {
+		prep := newTestPrep()
+		otherIn := in
+		otherIn.ActorId = 9
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.UnpublishPost(prep.ctx, otherIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+	})
+}
+
+func TestPostUsecases_ApprovePost(t *testing.T) {
+	reviewerId := int64(5)
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", Content: "Content", State: post.StateInReview}
+
+	in := post.TransitionPostDto{PostId: getPost.Id, ActorId: reviewerId, Notes: "Looks good"}
+
+	t.Run("expect it approves and publishes post", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, mock.Anything).Return(nil).Twice()
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, mock.Anything).Return(int64(1), nil).Twice()
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, reputation.EventModel{
+			UserId: getPost.UserId,
+			Event:  reputation.EventPostApproved,
+			PostId: getPost.Id,
+			Ref:    "post:1",
+		}).Return(true, nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{
+			Name:    event.PostApproved,
+			UserId:  getPost.UserId,
+			ActorId: reviewerId,
+			PostId:  getPost.Id,
+		}).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{
+			Name:    event.PostPublished,
+			UserId:  getPost.UserId,
+			ActorId: reviewerId,
+			PostId:  getPost.Id,
+		}).Return(nil)
+
+		err := prep.postUsecases.ApprovePost(prep.ctx, in)
+
+		require.NoError(t, err)
+		last := prep.postRepo.Calls[len(prep.postRepo.Calls)-1].Arguments.Get(1).(post.TransitionModel)
+		require.Equal(t, post.StatePublished, last.ToState)
+	})
+
+	t.Run("expect it schedules post with future publish time", func(t *testing.T) {
+		prep := newTestPrep()
+		scheduledIn := in
+		publishAt := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+		scheduledIn.PublishAt = publishAt.Format(time.RFC3339)
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, mock.MatchedBy(func(model post.PostModel) bool {
+			return model.State == post.StateApproved && model.PublishAt == scheduledIn.PublishAt
+		})).Return(nil).Once()
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, mock.Anything).Return(true, nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+			return e.Name == event.PostApproved
+		})).Return(nil).Once()
+
+		err := prep.postUsecases.ApprovePost(prep.ctx, scheduledIn)
+
+		require.NoError(t, err)
+		prep.events.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("expect it fails on malformed publish time", func(t *testing.T) {
+		prep := newTestPrep()
+		badIn := in
+		badIn.PublishAt = "next monday"
+
+		err := prep.postUsecases.ApprovePost(prep.ctx, badIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect authors cannot approve own posts", func(t *testing.T) {
+		prep := newTestPrep()
+		ownIn := in
+		ownIn.ActorId = getPost.UserId
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.ApprovePost(prep.ctx, ownIn)
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect rejection to tell the author", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, mock.Anything).Return(nil).Once()
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{
+			Name:    event.PostRejected,
+			UserId:  getPost.UserId,
+			ActorId: reviewerId,
+			PostId:  getPost.Id,
+		}).Return(nil)
+
+		err := prep.postUsecases.RejectPost(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect rejection requires notes", func(t *testing.T) {
+		prep := newTestPrep()
+		rejectIn := in
+		rejectIn.Notes = ""
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.RejectPost(prep.ctx, rejectIn)
+
+		require.Error(t, err)
+	})
+}
+
+func TestPostUsecases_PublishScheduled(t *testing.T) {
+	due := []post.PostModel{
+		{Id: 3, UserId: 2, Title: "Monday", State: post.StateApproved, PublishAt: "2026-10-19T01:00:00Z"},
+		{Id: 4, UserId: 7, Title: "Also Monday", State: post.StateApproved, PublishAt: "2026-10-19T01:30:00Z"},
+	}
+
+	t.Run("expect it publishes due posts as the app", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetDuePosts(mock.Anything, mock.Anything, dueBatchSize).Return(due, nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, mock.MatchedBy(func(model post.PostModel) bool {
+			return model.State == post.StatePublished && model.PublishAt != ""
+		})).Return(nil).Twice()
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, mock.MatchedBy(func(transition post.TransitionModel) bool {
+			return transition.ToState == post.StatePublished && transition.ActorId == 0
+		})).Return(int64(1), nil).Twice()
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.PostPublished, UserId: 2, PostId: 3}).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.PostPublished, UserId: 7, PostId: 4}).Return(nil)
+
+		out, err := prep.postUsecases.PublishScheduled(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, []int64{3, 4}, out.Published)
+	})
+
+	t.Run("expect nothing happens when no post is due", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetDuePosts(mock.Anything, mock.Anything, dueBatchSize).Return(nil, nil)
+
+		out, err := prep.postUsecases.PublishScheduled(prep.ctx)
+
+		require.NoError(t, err)
+		require.Empty(t, out.Published)
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if a handler of the event fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetDuePosts(mock.Anything, mock.Anything, dueBatchSize).Return(due[:1], nil)
+		prep.postRepo.EXPECT().UpdateState(mock.Anything, mock.Anything).Return(nil)
+		prep.postRepo.EXPECT().AddTransition(mock.Anything, mock.Anything).Return(int64(1), nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.Anything).Return(errors.New("handler failed"))
+
+		_, err := prep.postUsecases.PublishScheduled(prep.ctx)
+
+		require.Error(t, err)
+	})
+}
+
+func TestPostUsecases_GetPublishedPosts(t *testing.T) {
+	posts := []post.PostModelWithUser{{Id: 9, UserId: 2, State: post.StatePublished, Likes: 12}}
+
+	t.Run("expect it lists published posts with defaults", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().ListPosts(mock.Anything, mock.MatchedBy(func(list post.ListPostsModel) bool {
+			// Posts scheduled for later and hidden by moderators stay out.
+			return !list.PublishedBy.IsZero() && list.Public &&
+				len(list.States) == 1 && list.States[0] == post.StatePublished &&
+				list.Sort == post.SortCreatedAt &&
+				list.Order == post.OrderDesc &&
+				list.Limit == post.DefaultListLimit
+		})).Return(post.PostsPageModel{Posts: posts}, nil)
+
+		out, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{State: post.StateDraft})
+
+		require.NoError(t, err)
+		require.Equal(t, post.PostsPageDto{Posts: posts, NextCursor: ""}, out)
+	})
+
+	t.Run("expect posts to be sorted by publish date", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().ListPosts(mock.Anything, mock.MatchedBy(func(list post.ListPostsModel) bool {
+			return list.Sort == post.SortPublishAt && list.Order == post.OrderDesc
+		})).Return(post.PostsPageModel{Posts: posts}, nil)
+
+		_, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{Sort: post.SortPublishAt})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect next cursor to continue the same sorting", func(t *testing.T) {
+		prep := newTestPrep()
+		next := post.Cursor{Sort: post.SortLikes, Order: post.OrderDesc, Value: "12", Id: 9}
+
+		prep.postRepo.EXPECT().ListPosts(mock.Anything, mock.MatchedBy(func(list post.ListPostsModel) bool {
+			return list.Sort == post.SortLikes && list.Limit == 1 && list.After.IsZero()
+		})).Return(post.PostsPageModel{Posts: posts, Next: next}, nil).Once()
+		prep.postRepo.EXPECT().ListPosts(mock.Anything, mock.MatchedBy(func(list post.ListPostsModel) bool {
+			return list.After == next
+		})).Return(post.PostsPageModel{}, nil).Once()
+
+		first, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{Sort: post.SortLikes, Limit: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, first.NextCursor)
+
+		second, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{
+			Sort:   post.SortLikes,
+			Limit:  1,
+			Cursor: first.NextCursor,
+		})
+		require.NoError(t, err)
+		require.Equal(t, post.PostsPageDto{Posts: []post.PostModelWithUser{}}, second)
+	})
+
+	t.Run("expect date range to include the last day", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().ListPosts(mock.Anything, mock.MatchedBy(func(list post.ListPostsModel) bool {
+			return list.CreatedFrom.Equal(time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)) &&
+				list.CreatedTo.Equal(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+		})).Return(post.PostsPageModel{}, nil)
+
+		_, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{From: "2026-09-01", To: "2026-09-30"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if cursor belongs to another sorting", func(t *testing.T) {
+		prep := newTestPrep()
+		cursor := post.Cursor{Sort: post.SortLikes, Order: post.OrderDesc, Value: "12", Id: 9}.Encode()
+
+		_, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{Sort: post.SortViews, Cursor: cursor})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+
+	t.Run("expect it fails if cursor value doesn't fit the sorting", func(t *testing.T) {
+		for _, cursor := range []post.Cursor{
+			{Sort: post.SortLikes, Order: post.OrderDesc, Value: "12'; --", Id: 9},
+			{Sort: post.SortCreatedAt, Order: post.OrderDesc, Value: "yesterday", Id: 9},
+			{Sort: "title", Order: post.OrderDesc, Value: "12", Id: 9},
+		} {
+			prep := newTestPrep()
+
+			_, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{
+				Sort:   cursor.Sort,
+				Cursor: cursor.Encode(),
+			})
+
+			var baseErr *baseErrors.Error
+			require.ErrorAs(t, err, &baseErr)
+			require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+			prep.postRepo.AssertNotCalled(t, "ListPosts", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("expect it accepts a cursor of a time sorting", func(t *testing.T) {
+		prep := newTestPrep()
+		cursor := post.Cursor{Sort: post.SortCreatedAt, Order: post.OrderDesc, Value: "2026-09-05 08:00:00.123456", Id: 9}
+
+		prep.postRepo.EXPECT().ListPosts(mock.Anything, mock.MatchedBy(func(list post.ListPostsModel) bool {
+			return list.After == cursor
+		})).Return(post.PostsPageModel{}, nil)
+
+		_, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{Cursor: cursor.Encode()})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if sort is unknown", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.postUsecases.GetPublishedPosts(prep.ctx, post.ListPostsDto{Sort: "title"})
+
+		require.Error(t, err)
+		prep.postRepo.AssertNotCalled(t, "ListPosts", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_DeletePost(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", Content: "Content", State: post.StatePublished}
+
+	in := post.DeletePostDto{PostId: getPost.Id, UserId: getPost.UserId, UserRole: user.RoleAuthor}
+
+	t.Run("expect author to delete own post", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().SoftDelete(mock.Anything, getPost.Id).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{
+			Name:    event.PostChanged,
+			UserId:  getPost.UserId,
+			ActorId: getPost.UserId,
+			PostId:  getPost.Id,
+		}).Return(nil)
+
+		err := prep.postUsecases.DeletePost(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect admin to delete any post", func(t *testing.T) {
+		prep := newTestPrep()
+		adminIn := post.DeletePostDto{PostId: getPost.Id, UserId: 9, UserRole: user.RoleAdmin}
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().SoftDelete(mock.Anything, getPost.Id).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.Anything).Return(nil)
+
+		err := prep.postUsecases.DeletePost(prep.ctx, adminIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect editors cannot delete posts of others", func(t *testing.T) {
+		prep := newTestPrep()
+		editorIn := post.DeletePostDto{PostId: getPost.Id, UserId: 9, UserRole: user.RoleEditor}
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.DeletePost(prep.ctx, editorIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "SoftDelete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_RestorePost(t *testing.T) {
+	deleted := post.PostModel{Id: 1, UserId: 2, Title: "Title", Content: "Content", DeletedAt: "2026-10-01T10:00:00Z"}
+
+	t.Run("expect author to restore own post", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetDeletedById(mock.Anything, deleted.Id).Return(deleted, nil)
+		prep.postRepo.EXPECT().Restore(mock.Anything, deleted.Id).Return(nil)
+
+		err := prep.postUsecases.RestorePost(prep.ctx, post.DeletePostDto{PostId: deleted.Id, UserId: deleted.UserId})
+
+		require.NoError(t, err)
+		// Drafts were never seen by readers.
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if post is not in the trash", func(t *testing.T) {
+		prep := newTestPrep()
+		notFound := baseErrors.New(baseErrors.NotFoundError, "post not found")
+
+		prep.postRepo.EXPECT().GetDeletedById(mock.Anything, deleted.Id).Return(post.PostModel{}, notFound)
+
+		err := prep.postUsecases.RestorePost(prep.ctx, post.DeletePostDto{PostId: deleted.Id, UserId: deleted.UserId})
+
+		require.ErrorIs(t, err, notFound)
+		prep.postRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_PurgePosts(t *testing.T) {
+	t.Run("expect it purges posts past the retention period", func(t *testing.T) {
+		prep := newTestPrep()
+		retention := 30 * 24 * time.Hour
+		before := time.Now().UTC().Add(-retention)
+
+		prep.config.EXPECT().RetentionPeriod().Return(retention)
+		prep.postRepo.EXPECT().Purge(mock.Anything, mock.MatchedBy(func(deletedBefore time.Time) bool {
+			return deletedBefore.Sub(before) >= 0 && deletedBefore.Sub(before) < time.Minute
+		})).Return(int64(3), nil)
+
+		out, err := prep.postUsecases.PurgePosts(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(3), out.Purged)
+	})
+}
+
+func TestPostUsecases_ViewPost(t *testing.T) {
+	visitorId := "5d3b1f43-6f1c-4a57-9a35-0c9a9d0e7a11"
+	window := 24 * time.Hour
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", Content: "Content", State: post.StatePublished, Views: 10}
+
+	in := post.ViewPostDto{PostId: getPost.Id, VisitorId: visitorId}
+	view := post.ViewModel{PostId: getPost.Id, VisitorId: visitorId}
+
+	t.Run("expect it counts a new view before it is flushed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.config.EXPECT().ViewWindow().Return(window)
+		prep.postRepo.EXPECT().RecordView(mock.Anything, view, window).Return(true, nil)
+
+		out, err := prep.postUsecases.ViewPost(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(11), out.Views)
+	})
+
+	t.Run("expect repeated view within the window not to count", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.config.EXPECT().ViewWindow().Return(window)
+		prep.postRepo.EXPECT().RecordView(mock.Anything, view, window).Return(false, nil)
+
+		out, err := prep.postUsecases.ViewPost(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(10), out.Views)
+	})
+
+	t.Run("expect it does not count unidentified readers", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		out, err := prep.postUsecases.ViewPost(prep.ctx, post.ViewPostDto{PostId: getPost.Id})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(10), out.Views)
+		prep.postRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it does not count views of unpublished posts", func(t *testing.T) {
+		prep := newTestPrep()
+		draft := getPost
+		draft.State = post.StateDraft
+		authorIn := in
+		authorIn.UserId = getPost.UserId
+		authorIn.UserRole = user.RoleAuthor
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(draft, nil)
+
+		_, err := prep.postUsecases.ViewPost(prep.ctx, authorIn)
+
+		require.NoError(t, err)
+		prep.postRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect scheduled post not to be found by readers", func(t *testing.T) {
+		prep := newTestPrep()
+		scheduled := getPost
+		scheduled.State = post.StateApproved
+		scheduled.PublishAt = "2030-05-01T10:00:00Z"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(scheduled, nil)
+
+		_, err := prep.postUsecases.ViewPost(prep.ctx, post.ViewPostDto{PostId: getPost.Id, UserId: 9, UserRole: user.RoleAuthor})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect hidden post not to be found by readers", func(t *testing.T) {
+		prep := newTestPrep()
+		hidden := getPost
+		hidden.HiddenAt = "2024-05-01T10:00:00Z"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(hidden, nil)
+
+		_, err := prep.postUsecases.ViewPost(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect hidden post to be shown to moderators", func(t *testing.T) {
+		prep := newTestPrep()
+		hidden := getPost
+		hidden.HiddenAt = "2024-05-01T10:00:00Z"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(hidden, nil)
+
+		out, err := prep.postUsecases.ViewPost(prep.ctx, post.ViewPostDto{PostId: getPost.Id, UserId: 7, UserRole: user.RoleReviewer})
+
+		require.NoError(t, err)
+		require.Equal(t, hidden.HiddenAt, out.HiddenAt)
+	})
+}
+
+func TestPostUsecases_ViewPostBySlug(t *testing.T) {
+	visitorId := "5d3b1f43-6f1c-4a57-9a35-0c9a9d0e7a11"
+	window := 24 * time.Hour
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "New title", Slug: "new-title", Content: "Content", State: post.StatePublished}
+
+	t.Run("expect it views the post with the current slug", func(t *testing.T) {
+		prep := newTestPrep()
+		in := post.ViewPostBySlugDto{Slug: "New-Title", VisitorId: visitorId}
+
+		prep.postRepo.EXPECT().GetSlug(mock.Anything, "new-title").
+			Return(post.SlugModel{Slug: "new-title", PostId: getPost.Id, Current: true}, nil)
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.config.EXPECT().ViewWindow().Return(window)
+		prep.postRepo.EXPECT().RecordView(mock.Anything, post.ViewModel{PostId: getPost.Id, VisitorId: visitorId}, window).
+			Return(true, nil)
+
+		out, err := prep.postUsecases.ViewPostBySlug(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, getPost.Id, out.Post.Id)
+		require.Empty(t, out.RedirectTo)
+	})
+
+	t.Run("expect an old slug to redirect without a view", func(t *testing.T) {
+		prep := newTestPrep()
+		in := post.ViewPostBySlugDto{Slug: "old-title", VisitorId: visitorId}
+
+		prep.postRepo.EXPECT().GetSlug(mock.Anything, "old-title").
+			Return(post.SlugModel{Slug: "old-title", PostId: getPost.Id}, nil)
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		out, err := prep.postUsecases.ViewPostBySlug(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Nil(t, out.Post)
+		require.Equal(t, "new-title", out.RedirectTo)
+		prep.postRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect an old slug of an unpublished post not to redirect readers", func(t *testing.T) {
+		prep := newTestPrep()
+		in := post.ViewPostBySlugDto{Slug: "old-title", VisitorId: visitorId}
+		scheduled := getPost
+		scheduled.State = post.StateApproved
+		scheduled.PublishAt = "2030-05-01T10:00:00Z"
+
+		prep.postRepo.EXPECT().GetSlug(mock.Anything, "old-title").
+			Return(post.SlugModel{Slug: "old-title", PostId: getPost.Id}, nil)
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(scheduled, nil)
+
+		out, err := prep.postUsecases.ViewPostBySlug(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		require.Empty(t, out.RedirectTo)
+	})
+
+	t.Run("expect it fails on unknown slug", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetSlug(mock.Anything, "nothing").
+			Return(post.SlugModel{}, baseErrors.New(baseErrors.NotFoundError, "post not found"))
+
+		_, err := prep.postUsecases.ViewPostBySlug(prep.ctx, post.ViewPostBySlugDto{Slug: "nothing"})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+	})
+}
+
+func TestPostUsecases_FlushViews(t *testing.T) {
+	window := 24 * time.Hour
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", Content: "Content", State: post.StatePublished, Views: 98}
+
+	viewTwice := func(prep testPrep) {
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.config.EXPECT().ViewWindow().Return(window)
+		prep.postRepo.EXPECT().RecordView(mock.Anything, mock.Anything, window).Return(true, nil)
+
+		for _, userId := range []int64{3, 4} {
+			_, err := prep.postUsecases.ViewPost(prep.ctx, post.ViewPostDto{PostId: getPost.Id, UserId: userId})
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("expect it flushes views in a batch and rewards milestones", func(t *testing.T) {
+		prep := newTestPrep()
+		viewTwice(prep)
+
+		prep.postRepo.EXPECT().AddViews(mock.Anything, map[int64]post.AddedViewsModel{
+			getPost.Id: {Views: 2, UserViews: 2},
+		}).Return([]post.ViewCountModel{
+			{PostId: getPost.Id, UserId: getPost.UserId, Views: 130, Added: 2, UserViews: 100, UserAdded: 2},
+		}, nil).Once()
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, reputation.EventModel{
+			UserId: getPost.UserId,
+			Event:  reputation.EventViewMilestone,
+			PostId: getPost.Id,
+			Ref:    "post:1:views:100",
+		}).Return(true, nil)
+
+		require.NoError(t, prep.postUsecases.FlushViews(prep.ctx))
+		require.NoError(t, prep.postUsecases.FlushViews(prep.ctx))
+	})
+
+	t.Run("expect it keeps views for the next flush if writing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		viewTwice(prep)
+
+		added := map[int64]post.AddedViewsModel{getPost.Id: {Views: 2, UserViews: 2}}
+		prep.postRepo.EXPECT().AddViews(mock.Anything, added).
+			Return(nil, errors.New("add views failed")).Once()
+		prep.postRepo.EXPECT().AddViews(mock.Anything, added).
+			Return([]post.ViewCountModel{
+				{PostId: getPost.Id, UserId: getPost.UserId, Views: 99, Added: 2, UserViews: 99, UserAdded: 2},
+			}, nil).Once()
+
+		require.Error(t, prep.postUsecases.FlushViews(prep.ctx))
+		require.NoError(t, prep.postUsecases.FlushViews(prep.ctx))
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect views of anonymous visitors to earn no milestones", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.config.EXPECT().ViewWindow().Return(window)
+		prep.postRepo.EXPECT().RecordView(mock.Anything, mock.Anything, window).Return(true, nil)
+
+		for _, visitorId := range []string{
+			"6f1c1e0a-2b8e-4c43-9a1d-9a3c8b2f4e01",
+			"6f1c1e0a-2b8e-4c43-9a1d-9a3c8b2f4e02",
+		} {
+			_, err := prep.postUsecases.ViewPost(prep.ctx, post.ViewPostDto{PostId: getPost.Id, VisitorId: visitorId})
+			require.NoError(t, err)
+		}
+
+		prep.postRepo.EXPECT().AddViews(mock.Anything, map[int64]post.AddedViewsModel{
+			getPost.Id: {Views: 2},
+		}).Return([]post.ViewCountModel{
+			{PostId: getPost.Id, UserId: getPost.UserId, Views: 100, Added: 2, UserViews: 40},
+		}, nil).Once()
+
+		require.NoError(t, prep.postUsecases.FlushViews(prep.ctx))
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_GetPostTransitions(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, State: post.StateRejected}
+	transitions := []post.TransitionModel{{Id: 5, PostId: 1, FromState: post.StateInReview, ToState: post.StateRejected, ActorId: 3, Notes: "Add sources"}}
+
+	t.Run("expect the author to read the notes of the reviewer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().GetTransitions(mock.Anything, getPost.Id).Return(transitions, nil)
+
+		out, err := prep.postUsecases.GetPostTransitions(prep.ctx, post.GetTransitionsDto{
+			PostId:   getPost.Id,
+			UserId:   getPost.UserId,
+			UserRole: user.RoleAuthor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.Equal(t, "Add sources", out[0].Notes)
+	})
+
+	t.Run("expect moderators to read the history of any post", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().GetTransitions(mock.Anything, getPost.Id).Return(transitions, nil)
+
+		_, err := prep.postUsecases.GetPostTransitions(prep.ctx, post.GetTransitionsDto{
+			PostId:   getPost.Id,
+			UserId:   3,
+			UserRole: user.RoleReviewer,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect other users not to find the history", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		_, err := prep.postUsecases.GetPostTransitions(prep.ctx, post.GetTransitionsDto{
+			PostId:   getPost.Id,
+			UserId:   4,
+			UserRole: user.RoleAuthor,
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "GetTransitions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_GetRevisions(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, State: post.StatePublished, Revision: 2}
+	revisions := []post.RevisionModel{
+		{PostId: 1, Revision: 2, Title: "Second", CategoryId: 1, UserId: 2},
+		{PostId: 1, Revision: 1, Title: "First", CategoryId: 1, UserId: 2},
+	}
+
+	t.Run("expect author to see the revisions", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().GetRevisions(mock.Anything, getPost.Id).Return(revisions, nil)
+
+		out, err := prep.postUsecases.GetRevisions(prep.ctx, post.GetRevisionsDto{
+			PostId:   getPost.Id,
+			UserId:   getPost.UserId,
+			UserRole: user.RoleAuthor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+	})
+
+	t.Run("expect reviewer to see the revisions", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().GetRevisions(mock.Anything, getPost.Id).Return(revisions, nil)
+
+		out, err := prep.postUsecases.GetRevisions(prep.ctx, post.GetRevisionsDto{
+			PostId:   getPost.Id,
+			UserId:   9,
+			UserRole: user.RoleReviewer,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+	})
+
+	t.Run("expect it fails if someone else asks for the revisions", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		_, err := prep.postUsecases.GetRevisions(prep.ctx, post.GetRevisionsDto{
+			PostId:   getPost.Id,
+			UserId:   9,
+			UserRole: user.RoleAuthor,
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "GetRevisions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_DiffRevisions(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, State: post.StatePublished, Revision: 4, ApprovedRevision: 2}
+	approved := post.RevisionModel{PostId: 1, Revision: 2, Title: "Title", Content: "one\ntwo\nthree", CategoryId: 1}
+	latest := post.RevisionModel{PostId: 1, Revision: 4, Title: "Title", Content: "one\n2\nthree\nfour", CategoryId: 1}
+
+	t.Run("expect latest revision to be compared with approved one", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().GetRevision(mock.Anything, getPost.Id, int64(2)).Return(approved, nil)
+		prep.postRepo.EXPECT().GetRevision(mock.Anything, getPost.Id, int64(4)).Return(latest, nil)
+
+		out, err := prep.postUsecases.DiffRevisions(prep.ctx, post.DiffRevisionsDto{
+			PostId:   getPost.Id,
+			UserId:   getPost.UserId,
+			UserRole: user.RoleAuthor,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, post.RevisionDiffDto{
+			PostId: getPost.Id,
+			From:   2,
+			To:     4,
+			Title:  []post.DiffLineDto{{Op: post.DiffEqual, Text: "Title"}},
+			Content: []post.DiffLineDto{
+				{Op: post.DiffEqual, Text: "one"},
+				{Op: post.DiffDelete, Text: "two"},
+				{Op: post.DiffInsert, Text: "2"},
+				{Op: post.DiffEqual, Text: "three"},
+				{Op: post.DiffInsert, Text: "four"},
+			},
+		}, out)
+	})
+
+	t.Run("expect it fails if range is reversed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		_, err := prep.postUsecases.DiffRevisions(prep.ctx, post.DiffRevisionsDto{
+			PostId:   getPost.Id,
+			From:     4,
+			To:       2,
+			UserId:   getPost.UserId,
+			UserRole: user.RoleAuthor,
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+
+	t.Run("expect it fails if someone else compares the revisions", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		_, err := prep.postUsecases.DiffRevisions(prep.ctx, post.DiffRevisionsDto{
+			PostId:   getPost.Id,
+			UserId:   9,
+			UserRole: user.RoleAuthor,
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+		prep.postRepo.AssertNotCalled(t, "GetRevision", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostUsecases_RestoreRevision(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "New", Slug: "new", Content: paragraph("New content"), CategoryId: 1, State: post.StateDraft, Revision: 4}
+	old := post.RevisionModel{PostId: 1, Revision: 2, Title: "Old", Content: paragraph("Old content"), CategoryId: 1}
+
+	in := post.RestoreRevisionDto{PostId: getPost.Id, Revision: old.Revision, UserId: getPost.UserId, UserRole: user.RoleAuthor}
+
+	t.Run("expect restored text to become a new revision", func(t *testing.T) {
+		prep := newTestPrep()
+		restored := getPost
+		restored.Title = old.Title
+		restored.Content = old.Content
+		restored.Revision = 5
+		restored.WordCount = 2
+		restored.ReadingMinutes = 1
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().GetRevision(mock.Anything, getPost.Id, old.Revision).Return(old, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, restored).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, post.RevisionModel{
+			PostId:     getPost.Id,
+			Revision:   5,
+			Title:      old.Title,
+			Content:    old.Content,
+			CategoryId: old.CategoryId,
+			UserId:     getPost.UserId,
+		}).Return(int64(20), nil)
+		// The post takes back the slug it had with the old title.
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, "old").
+			Return([]post.SlugModel{{Slug: "old", PostId: getPost.Id}}, nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, getPost.Id, "old").Return(nil)
+
+		err := prep.postUsecases.RestoreRevision(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a revision with legacy content to be restored", func(t *testing.T) {
+		prep := newTestPrep()
+		legacy := old
+		legacy.Content = "<p>Written before EditorJS</p>"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.postRepo.EXPECT().GetRevision(mock.Anything, getPost.Id, old.Revision).Return(legacy, nil)
+		prep.postRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model post.PostModel) bool {
+			return model.Content == legacy.Content && model.Revision == 5
+		})).Return(getPost.Id, nil)
+		prep.postRepo.EXPECT().AddRevision(mock.Anything, mock.Anything).Return(int64(20), nil)
+		prep.postRepo.EXPECT().GetSlugs(mock.Anything, "old").
+			Return([]post.SlugModel{{Slug: "old", PostId: getPost.Id}}, nil)
+		prep.postRepo.EXPECT().AddSlug(mock.Anything, getPost.Id, "old").Return(nil)
+
+		err := prep.postUsecases.RestoreRevision(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if someone else restores the post", func(t *testing.T) {
+		prep := newTestPrep()
+		otherIn := in
+		otherIn.UserId = 9
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		err := prep.postUsecases.RestoreRevision(prep.ctx, otherIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+	})
+}
+
+// paragraph builds EditorJS content of a single paragraph.
+func paragraph(text string) string {
+	return `{"blocks":[{"type":"paragraph","data":{"text":"` + text + `"}}]}`
+}
+
+type testPrep struct {
+	ctx            context.Context
+	postRepo       *postMock.PostRepository
+	reputationRepo *reputationMock.ReputationRepository
+	tagRepo        *tagMock.TagRepository
+	events         *eventMock.Bus
+	config         *postMock.Config
+
+	postUsecases post.PostUseCase
+}
+
+func newTestPrep() testPrep {
+	postRepo := &postMock.PostRepository{}
+	reputationRepo := &reputationMock.ReputationRepository{}
+	tagRepo := &tagMock.TagRepository{}
+	events := &eventMock.Bus{}
+	config := &postMock.Config{}
+	txManager := &dbMock.MockTxManager{}
+
+	postUsecasesOpts := PostUsecaseOpts{
+		PostRepository:       postRepo,
+		ReputationRepository: reputationRepo,
+		TagRepository:        tagRepo,
+		TxManager:            txManager,
+		Events:               events,
+		Config:               config,
+	}
+	postUsecases := NewPostUsecase(postUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		postRepo:       postRepo,
+		reputationRepo: reputationRepo,
+		tagRepo:        tagRepo,
+		events:         events,
+		config:         config,
+		postUsecases:   postUsecases,
+	}
+}
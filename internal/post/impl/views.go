@@ -0,0 +1,59 @@
+package impl
+
+import (
+	"sync"
+
+	"fibo/internal/post"
+)
+
+// viewBuffer collects counted views between flushes, so a popular post costs
+// one counter update per flush instead of one per reader.
+type viewBuffer struct {
+	mu     sync.Mutex
+	counts map[int64]post.AddedViewsModel
+}
+
+func newViewBuffer() *viewBuffer {
+	return &viewBuffer{counts: map[int64]post.AddedViewsModel{}}
+}
+
+func (b *viewBuffer) add(view post.ViewModel) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	added := b.counts[view.PostId]
+	added.Add(view)
+	b.counts[view.PostId] = added
+}
+
+// putBack returns views taken for a flush that failed.
+func (b *viewBuffer) putBack(views map[int64]post.AddedViewsModel) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for postId, views := range views {
+		added := b.counts[postId]
+		added.Views += views.Views
+		added.UserViews += views.UserViews
+		b.counts[postId] = added
+	}
+}
+
+// pending is the number of views of the post that are not flushed yet.
+func (b *viewBuffer) pending(postId int64) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.counts[postId].Views
+}
+
+// take empties the buffer and returns what it held.
+func (b *viewBuffer) take() map[int64]post.AddedViewsModel {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	counts := b.counts
+	b.counts = map[int64]post.AddedViewsModel{}
+
+	return counts
+}
@@ -0,0 +1,55 @@
+package post
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+
+	"fibo/internal/base/errors"
+)
+
+// LikeModel is a single entry of the like ledger. A like belongs either to a
+// registered user or to an anonymous visitor, never to both.
+type LikeModel struct {
+	PostId    int64
+	UserId    int64
+	VisitorId string
+}
+
+func NewLike(postId, userId int64, visitorId string) (LikeModel, error) {
+	like := LikeModel{
+		PostId: postId,
+		UserId: userId,
+	}
+	if userId == 0 {
+		like.VisitorId = visitorId
+	}
+
+	if err := like.Validate(); err != nil {
+		return LikeModel{}, err
+	}
+
+	return like, nil
+}
+
+// Rewarded tells whether the like earns the author reputation. Visitor ids
+// are made up by clients, so only likes of other registered users do.
+func (like *LikeModel) Rewarded(model *PostModel) bool {
+	return like.UserId != 0 && !model.IsAuthor(like.UserId)
+}
+
+func (like *LikeModel) Validate() error {
+	visitorRules := []validation.Rule{is.UUID}
+	if like.UserId == 0 {
+		visitorRules = append(visitorRules, validation.Required)
+	}
+
+	err := validation.ValidateStruct(like,
+		validation.Field(&like.PostId, validation.Required),
+		validation.Field(&like.VisitorId, visitorRules...),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
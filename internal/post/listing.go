@@ -0,0 +1,234 @@
+package post
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/tag"
+)
+
+type SortField string
+
+const (
+	SortCreatedAt SortField = "created_at"
+	// SortPublishAt orders by the time posts went live. Posts that were
+	// never published are left out.
+	SortPublishAt SortField = "publish_at"
+	SortLikes     SortField = "likes"
+	SortViews     SortField = "views"
+)
+
+type SortOrder string
+
+const (
+	OrderAsc  SortOrder = "asc"
+	OrderDesc SortOrder = "desc"
+)
+
+const (
+	DefaultListLimit uint = 20
+	MaxListLimit     uint = 100
+)
+
+const dateLayout = "2006-01-02"
+
+// cursorTimeLayout is how the database writes timestamps as text, which the
+// cursor holds for the time sortings.
+const cursorTimeLayout = "2006-01-02 15:04:05.999999"
+
+// EntryModel is a post readers can open, as listed for search engines.
+type EntryModel struct {
+	Id         int64
+	UserId     int64
+	CategoryId int64
+	Slug       string
+	UpdatedAt  string
+}
+
+// ListPostsModel selects a page of posts. Zero fields don't filter.
+type ListPostsModel struct {
+	CategoryId  int64
+	UserId      int64
+	States      []State
+	CreatedFrom time.Time
+	// CreatedTo is exclusive.
+	CreatedTo time.Time
+	Sort      SortField
+	Order     SortOrder
+	Limit     uint
+	After     Cursor
+	// Tag is the slug of a tag the posts must have.
+	Tag string
+	// PublishedBy leaves out posts scheduled to go live after it.
+	PublishedBy time.Time
+	// Public leaves out posts hidden by moderators.
+	Public bool
+	// FollowerId keeps the posts of the authors and categories the user
+	// follows, leaving out the user's own.
+	FollowerId int64
+}
+
+func NewListPosts(
+	categoryId int64,
+	userId int64,
+	state State,
+	tagName string,
+	from string,
+	to string,
+	sort SortField,
+	order SortOrder,
+	limit uint,
+	cursor string,
+) (ListPostsModel, error) {
+	list := ListPostsModel{
+		CategoryId: categoryId,
+		UserId:     userId,
+		Tag:        tag.Slugify(tagName),
+		Sort:       sort,
+		Order:      order,
+		Limit:      limit,
+	}
+	if state != "" {
+		list.States = []State{state}
+	}
+	if list.Sort == "" {
+		list.Sort = SortCreatedAt
+	}
+	if list.Order == "" {
+		list.Order = OrderDesc
+	}
+	if list.Limit == 0 {
+		list.Limit = DefaultListLimit
+	}
+	if list.Limit > MaxListLimit {
+		list.Limit = MaxListLimit
+	}
+
+	var err error
+	if from != "" {
+		if list.CreatedFrom, err = parseDate("from", from); err != nil {
+			return ListPostsModel{}, err
+		}
+	}
+	if to != "" {
+		if list.CreatedTo, err = parseDate("to", to); err != nil {
+			return ListPostsModel{}, err
+		}
+		// The whole last day is included.
+		list.CreatedTo = list.CreatedTo.AddDate(0, 0, 1)
+	}
+	if cursor != "" {
+		if list.After, err = ParseCursor(cursor); err != nil {
+			return ListPostsModel{}, err
+		}
+	}
+
+	if err := list.Validate(); err != nil {
+		return ListPostsModel{}, err
+	}
+
+	return list, nil
+}
+
+func (list *ListPostsModel) Validate() error {
+	err := validation.ValidateStruct(list,
+		validation.Field(&list.Sort, validation.In(SortCreatedAt, SortPublishAt, SortLikes, SortViews)),
+		validation.Field(&list.Order, validation.In(OrderAsc, OrderDesc)),
+		validation.Field(&list.States, validation.Each(validation.By(validateState))),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	if !list.CreatedFrom.IsZero() && !list.CreatedTo.IsZero() && !list.CreatedFrom.Before(list.CreatedTo) {
+		return errors.New(errors.ValidationError, "from: must be before to.")
+	}
+
+	if !list.After.IsZero() && (list.After.Sort != list.Sort || list.After.Order != list.Order) {
+		return errors.New(errors.ValidationError, "cursor: belongs to another sorting.")
+	}
+
+	return nil
+}
+
+func validateState(value interface{}) error {
+	if state, _ := value.(State); !state.IsValid() {
+		return errors.Errorf(errors.ValidationError, "unknown state \"%v\"", value)
+	}
+
+	return nil
+}
+
+func parseDate(field string, value string) (time.Time, error) {
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, errors.Errorf(errors.ValidationError, "%s: must look like \"YYYY-MM-DD\".", field)
+	}
+
+	return date, nil
+}
+
+// Cursor points at the last post of a page. The next page starts right
+// after it in the same sorting.
+type Cursor struct {
+	Sort  SortField `json:"s"`
+	Order SortOrder `json:"o"`
+	Value string    `json:"v"`
+	Id    int64     `json:"id"`
+}
+
+func (c Cursor) IsZero() bool {
+	return c.Id == 0
+}
+
+// Encode turns the cursor into an opaque token for clients.
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func ParseCursor(token string) (Cursor, error) {
+	var cursor Cursor
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(raw, &cursor)
+	}
+	if err != nil || cursor.IsZero() || !cursor.hasValidValue() {
+		return Cursor{}, errors.New(errors.ValidationError, "cursor: is malformed.")
+	}
+
+	return cursor, nil
+}
+
+// hasValidValue tells whether the value can be compared with the column of
+// the sorting, which is what the next page is looked up by.
+func (c Cursor) hasValidValue() bool {
+	var err error
+	switch c.Sort {
+	case SortLikes, SortViews:
+		_, err = strconv.ParseInt(c.Value, 10, 64)
+	case SortCreatedAt, SortPublishAt:
+		_, err = time.Parse(cursorTimeLayout, c.Value)
+	default:
+		return false
+	}
+
+	return err == nil
+}
+
+// PostsPageModel is a page of posts and the cursor of the next one, which is
+// zero on the last page.
+type PostsPageModel struct {
+	Posts []PostModelWithUser
+	Next  Cursor
+}
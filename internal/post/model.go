@@ -4,6 +4,8 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation"
 
 	"fibo/internal/base/errors"
+	"fibo/internal/post/content"
+	"fibo/internal/user"
 )
 
 type PostModelWithLikesCount struct {
@@ -12,45 +14,73 @@ type PostModelWithLikesCount struct {
 }
 
 type PostModelWithUser struct {
-	Id          int64
-	UserId      int64
-	Title       string
-	Content     string
-	CategoryId  int64
-	Likes       int64
-	IsPublished bool
-	CreatedAt   string
-	UpdatedAt   string
-	DeletedAt   string
-	UserEmail   string
-	UserName    string
+	Id             int64
+	UserId         int64
+	Title          string
+	Slug           string
+	Content        string
+	CategoryId     int64
+	Likes          int64
+	Views          int64
+	Saves          int64
+	WordCount      int64
+	ReadingMinutes int64
+	CodeBlocks     int64
+	Tags           []string
+	State          State
+	ReviewNotes    string
+	StateChangedAt string
+	PublishAt      string
+	Revision       int64
+	CreatedAt      string
+	UpdatedAt      string
+	DeletedAt      string
+	HiddenAt       string
+	UserEmail      string
+	UserName       string
 }
 type PostModel struct {
-	Id          int64
-	UserId      int64
-	Title       string
-	Content     string
-	CategoryId  int64
-	Likes       int64
-	IsPublished bool
-	CreatedAt   string
-	UpdatedAt   string
-	DeletedAt   string
+	Id             int64
+	UserId         int64
+	Title          string
+	Slug           string
+	Content        string
+	CategoryId     int64
+	Likes          int64
+	Views          int64
+	Saves          int64
+	WordCount      int64
+	ReadingMinutes int64
+	CodeBlocks     int64
+	Tags           []string
+	State          State
+	ReviewNotes    string
+	StateChangedAt string
+	PublishAt      string
+	// Revision is the number of the latest snapshot of the text and
+	// ApprovedRevision the one a reviewer approved last.
+	Revision         int64
+	ApprovedRevision int64
+	CreatedAt        string
+	UpdatedAt        string
+	DeletedAt        string
+	// HiddenAt is set while moderators keep the post from readers over
+	// reports against it.
+	HiddenAt string
 }
 
 func NewPost(
 	userId int64,
 	title string,
 	content string,
-	isPublished bool,
 	categoryId int64,
 ) (PostModel, error) {
 	post := PostModel{
-		UserId:      userId,
-		Title:       title,
-		Content:     content,
-		IsPublished: isPublished,
-		CategoryId:  categoryId,
+		UserId:     userId,
+		Title:      title,
+		Content:    content,
+		State:      StateDraft,
+		CategoryId: categoryId,
 	}
 
 	if err := post.Validate(); err != nil {
@@ -60,37 +90,118 @@ func NewPost(
 	return post, nil
 }
 
+// Update changes the text of the post. Posts written before content was
+// validated may hold content Parse rejects, so only new content is parsed,
+// and it is stored sanitized.
 func (post *PostModel) Update(
 	title string,
 	content string,
-	isPublished bool,
-	likes int64,
 	categoryId int64,
 ) error {
 	if len(title) > 0 {
 		post.Title = title
 	}
 
-	if len(content) > 0 {
+	contentChanged := len(content) > 0 && content != post.Content
+	if contentChanged {
 		post.Content = content
 	}
 
-	post.IsPublished = isPublished
-
 	post.CategoryId = categoryId
 
-	if likes > 0 {
-		post.Likes = likes
-	}
-
-	if err := post.Validate(); err != nil {
+	if err := post.validateFields(); err != nil {
 		return err
 	}
+	if contentChanged {
+		return post.parseContent()
+	}
 
 	return nil
 }
 
+// Measure updates the length stats of the post from its content.
+func (post *PostModel) Measure() {
+	stats := NewContentStats(post.Content)
+
+	post.WordCount = stats.WordCount
+	post.ReadingMinutes = stats.ReadingMinutes
+	post.CodeBlocks = stats.CodeBlocks
+}
+
+func (post *PostModel) IsAuthor(userId int64) bool {
+	return post.UserId == userId
+}
+
+// IsManagedBy tells whether the user wrote the post or moderates posts. Only
+// they see what is not public of a post, such as its review history.
+func (post *PostModel) IsManagedBy(userId int64, role user.Role) bool {
+	return post.IsAuthor(userId) || role.CanModerate()
+}
+
+func (post *PostModel) IsHidden() bool {
+	return post.HiddenAt != ""
+}
+
+func (post *PostModel) IsDeleted() bool {
+	return post.DeletedAt != ""
+}
+
+func (post *PostModel) IsPublished() bool {
+	return post.State == StatePublished
+}
+
+// IsPublic tells whether anyone may read the post. Drafts, posts waiting for
+// their publish date and hidden posts are only shown to those managing them.
+func (post *PostModel) IsPublic() bool {
+	return post.IsPublished() && !post.IsHidden()
+}
+
+// Transition moves the post to the next review state and returns the audit
+// record that has to be stored along with it.
+func (post *PostModel) Transition(to State, actorId int64, notes string) (TransitionModel, error) {
+	if !post.State.CanTransitionTo(to) {
+		return TransitionModel{}, errors.Errorf(
+			errors.ValidationError,
+			"post cannot move from \"%s\" to \"%s\"",
+			post.State,
+			to,
+		)
+	}
+
+	transition := TransitionModel{
+		PostId:    post.Id,
+		FromState: post.State,
+		ToState:   to,
+		ActorId:   actorId,
+		Notes:     notes,
+	}
+	if err := transition.Validate(); err != nil {
+		return TransitionModel{}, err
+	}
+
+	post.State = to
+	if to != StateApproved && to != StatePublished {
+		post.PublishAt = ""
+	}
+	if to == StateApproved || to == StateRejected {
+		post.ReviewNotes = notes
+	}
+	if to == StateApproved {
+		post.ApprovedRevision = post.Revision
+	}
+
+	return transition, nil
+}
+
 func (post *PostModel) Validate() error {
+	if err := post.validateFields(); err != nil {
+		return err
+	}
+
+	return post.parseContent()
+}
+
+func (post *PostModel) validateFields() error {
 	err := validation.ValidateStruct(post,
 		validation.Field(&post.Title, validation.Required),
 		validation.Field(&post.Content, validation.Required),
@@ -102,3 +213,15 @@ func (post *PostModel) Validate() error {
 
 	return nil
 }
+
+// parseContent validates the content and replaces it with the parsed
+// document, so that only sanitized HTML is stored and served.
+func (post *PostModel) parseContent() error {
+	doc, err := content.Parse(post.Content)
+	if err != nil {
+		return err
+	}
+
+	post.Content, err = doc.JSON()
+	return err
+}
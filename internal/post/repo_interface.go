@@ -1,14 +1,42 @@
+//go:generate mockery --name PostRepository --filename repository.go --output ./mock --with-expecter
+
 package post
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type PostRepository interface {
-	LikePost(ctx context.Context, postId int64, liekes LikePostDto) error
+	AddLike(ctx context.Context, like LikeModel) (bool, error)
+	RemoveLike(ctx context.Context, like LikeModel) (bool, error)
+	RefreshLikes(ctx context.Context, postId int64) (int64, error)
+	RecordView(ctx context.Context, view ViewModel, window time.Duration) (bool, error)
+	AddViews(ctx context.Context, views map[int64]AddedViewsModel) ([]ViewCountModel, error)
 	Create(ctx context.Context, post PostModel) (int64, error)
-	GetPosts(ctx context.Context) ([]PostModelWithUser, error)
-	GetMyPosts(ctx context.Context, userId int64) ([]PostModelWithUser, error)
-	GetPublishedPosts(ctx context.Context) ([]PostModelWithUser, error)
+	ListPosts(ctx context.Context, list ListPostsModel) (PostsPageModel, error)
 	GetById(ctx context.Context, postId int64) (PostModel, error)
+	GetDeletedById(ctx context.Context, postId int64) (PostModel, error)
 	GetTotalLikesCountByUser(ctx context.Context, userId int64) (int64, error)
 	Update(ctx context.Context, post PostModel) (int64, error)
+	UpdateState(ctx context.Context, post PostModel) error
+	SoftDelete(ctx context.Context, postId int64) error
+	Restore(ctx context.Context, postId int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	AddTransition(ctx context.Context, transition TransitionModel) (int64, error)
+	GetTransitions(ctx context.Context, postId int64) ([]TransitionModel, error)
+	AddRevision(ctx context.Context, revision RevisionModel) (int64, error)
+	GetRevisions(ctx context.Context, postId int64) ([]RevisionModel, error)
+	GetRevision(ctx context.Context, postId int64, revision int64) (RevisionModel, error)
+	GetReviewQueue(ctx context.Context) ([]PostModelWithUser, error)
+	// GetSlugs returns the slugs equal to base or numbered from it.
+	GetSlugs(ctx context.Context, base string) ([]SlugModel, error)
+	GetSlug(ctx context.Context, slug string) (SlugModel, error)
+	// AddSlug makes the slug the current one of the post, keeping the old
+	// ones.
+	AddSlug(ctx context.Context, postId int64, slug string) error
+	GetDuePosts(ctx context.Context, dueBy time.Time, limit uint) ([]PostModel, error)
+	// GetPublishedEntries returns every post published by the given time and
+	// not hidden, oldest first.
+	GetPublishedEntries(ctx context.Context, publishedBy time.Time) ([]EntryModel, error)
 }
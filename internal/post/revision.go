@@ -0,0 +1,87 @@
+package post
+
+import "fibo/internal/base/errors"
+
+// RevisionModel is a snapshot of a post taken every time its text changes.
+type RevisionModel struct {
+	Id         int64
+	PostId     int64
+	Revision   int64
+	Title      string
+	Content    string
+	CategoryId int64
+	UserId     int64
+	CreatedAt  string
+}
+
+// NewRevision bumps the revision of the post and snapshots it on behalf of
+// the user who made the change.
+func (post *PostModel) NewRevision(userId int64) RevisionModel {
+	post.Revision++
+
+	return RevisionModel{
+		PostId:     post.Id,
+		Revision:   post.Revision,
+		Title:      post.Title,
+		Content:    post.Content,
+		CategoryId: post.CategoryId,
+		UserId:     userId,
+	}
+}
+
+// Restore brings back the text of an older revision. The revision was
+// accepted when it was written, so its content is not parsed again: it may
+// predate content validation.
+func (post *PostModel) Restore(revision RevisionModel) error {
+	post.Title = revision.Title
+	post.Content = revision.Content
+	post.CategoryId = revision.CategoryId
+
+	return post.validateFields()
+}
+
+// DiffRange picks the revisions to compare. By default the latest revision is
+// compared with the approved one, or with the one before it if the post was
+// never approved.
+func (post *PostModel) DiffRange(from int64, to int64) (int64, int64, error) {
+	if to == 0 {
+		to = post.Revision
+	}
+	if from == 0 {
+		from = post.ApprovedRevision
+		if from == 0 || from >= to {
+			from = to - 1
+		}
+	}
+
+	if from < 1 || to > post.Revision || from >= to {
+		return 0, 0, errors.Errorf(
+			errors.ValidationError,
+			"cannot compare revision %d with %d of post \"%d\"",
+			from,
+			to,
+			post.Id,
+		)
+	}
+
+	return from, to, nil
+}
+
+// RevisionDiffModel describes what changed between two revisions.
+type RevisionDiffModel struct {
+	From            RevisionModel
+	To              RevisionModel
+	Title           []DiffLine
+	Content         []DiffLine
+	CategoryChanged bool
+}
+
+func NewRevisionDiff(from RevisionModel, to RevisionModel) RevisionDiffModel {
+	return RevisionDiffModel{
+		From:            from,
+		To:              to,
+		Title:           DiffLines(from.Title, to.Title),
+		Content:         DiffLines(from.Content, to.Content),
+		CategoryChanged: from.CategoryId != to.CategoryId,
+	}
+}
@@ -0,0 +1,95 @@
+package post
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fibo/internal/tag"
+)
+
+const maxSlugLength = 80
+
+// mongolianLatin spells the Mongolian Cyrillic alphabet in Latin letters the
+// way Mongolian URLs usually do.
+var mongolianLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "ye", 'ё': "yo",
+	'ж': "j", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'ө': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ү': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh",
+	'щ': "sh", 'ъ': "", 'ы': "y", 'ь': "i", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// SlugModel is a slug a post has or had. Old slugs are kept, so links made
+// before a title changed still find the post.
+type SlugModel struct {
+	Slug   string
+	PostId int64
+	// Current reports whether the post still has the slug.
+	Current   bool
+	CreatedAt string
+}
+
+// Transliterate spells Mongolian Cyrillic text in Latin letters. Other
+// letters are kept as they are.
+func Transliterate(text string) string {
+	var out strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if latin, ok := mongolianLatin[r]; ok {
+			out.WriteString(latin)
+		} else {
+			out.WriteRune(r)
+		}
+	}
+
+	return out.String()
+}
+
+// NewSlug makes the URL part of a post from its title. Long titles are cut
+// at a word boundary, and titles without letters or digits get "post".
+func NewSlug(title string) string {
+	slug := []rune(tag.Slugify(Transliterate(title)))
+	if len(slug) > maxSlugLength {
+		cut := string(slug[:maxSlugLength])
+		if i := strings.LastIndex(cut, "-"); i > 0 {
+			cut = cut[:i]
+		}
+		slug = []rune(strings.Trim(cut, "-"))
+	}
+
+	if len(slug) == 0 {
+		return "post"
+	}
+
+	return string(slug)
+}
+
+// UniqueSlug numbers base when other posts have it: "title", "title-2",
+// "title-3" and so on. Slugs the post had before are free for it again.
+func UniqueSlug(base string, postId int64, taken []SlugModel) string {
+	owners := map[string]int64{}
+	for _, slug := range taken {
+		owners[slug.Slug] = slug.PostId
+	}
+
+	slug := base
+	for n := 2; ; n++ {
+		if owner, ok := owners[slug]; !ok || owner == postId {
+			return slug
+		}
+		slug = fmt.Sprintf("%s-%d", base, n)
+	}
+}
+
+// IsSlugOf reports whether slug was made from base, numbered or not.
+func IsSlugOf(slug string, base string) bool {
+	if slug == base {
+		return true
+	}
+	if !strings.HasPrefix(slug, base+"-") {
+		return false
+	}
+
+	_, err := strconv.ParseUint(strings.TrimPrefix(slug, base+"-"), 10, 64)
+	return err == nil
+}
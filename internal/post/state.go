@@ -0,0 +1,107 @@
+package post
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+// State is a step of the editorial review pipeline a post goes through
+// before it shows up on the main site.
+type State string
+
+const (
+	StateDraft     State = "draft"
+	StateSubmitted State = "submitted"
+	StateInReview  State = "in_review"
+	StateApproved  State = "approved"
+	StatePublished State = "published"
+	StateRejected  State = "rejected"
+)
+
+// Moving back to draft is how an author withdraws a post from review or
+// takes it offline to edit it. Rejected posts are edited as they are and
+// submitted again.
+var transitions = map[State][]State{
+	StateDraft:     {StateSubmitted},
+	StateSubmitted: {StateDraft, StateInReview, StateApproved, StateRejected},
+	StateInReview:  {StateDraft, StateApproved, StateRejected},
+	StateApproved:  {StateDraft, StatePublished, StateRejected},
+	StatePublished: {StateDraft},
+	StateRejected:  {StateSubmitted},
+}
+
+func (s State) IsValid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+func (s State) CanTransitionTo(next State) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEditable reports whether the author may change the post. Text a
+// reviewer is looking at or has approved is taken back to draft first.
+func (s State) IsEditable() bool {
+	return s == StateDraft || s == StateRejected
+}
+
+// IsReviewPending reports whether the post waits for a reviewer decision.
+func (s State) IsReviewPending() bool {
+	return s == StateSubmitted || s == StateInReview
+}
+
+// TransitionModel records who moved a post between two states and why.
+type TransitionModel struct {
+	Id        int64
+	PostId    int64
+	FromState State
+	ToState   State
+	ActorId   int64
+	Notes     string
+	CreatedAt string
+}
+
+func (t *TransitionModel) Validate() error {
+	rules := []validation.Rule{validation.Length(0, 2000)}
+	if t.ToState == StateRejected {
+		rules = append(rules, validation.Required)
+	}
+
+	err := validation.ValidateStruct(t,
+		validation.Field(&t.PostId, validation.Required),
+		validation.Field(&t.Notes, rules...),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// ParsePublishAt reads the RFC3339 time an approved post is scheduled for.
+// The zero time means the post goes live right away, which is also what a
+// time that is already past means.
+func ParsePublishAt(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	publishAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.New(errors.ValidationError, "publishAt: must be an RFC3339 time.")
+	}
+	if !publishAt.After(now) {
+		return time.Time{}, nil
+	}
+
+	return publishAt.UTC(), nil
+}
@@ -0,0 +1,41 @@
+package post
+
+import (
+	"strings"
+
+	"fibo/internal/post/content"
+)
+
+// wordsPerMinute is the reading speed the reading time is estimated with.
+const wordsPerMinute = 200
+
+// codeBlockSeconds is added to the reading time for every code block, which
+// take longer to read than prose of the same length.
+const codeBlockSeconds = 30
+
+// ContentStats describes the length of a post.
+type ContentStats struct {
+	WordCount      int64
+	ReadingMinutes int64
+	CodeBlocks     int64
+}
+
+// NewContentStats measures the post content. EditorJS documents are measured
+// by the text of their blocks rather than their JSON.
+func NewContentStats(raw string) ContentStats {
+	stats := ContentStats{
+		WordCount:  int64(len(strings.Fields(content.PlainText(raw)))),
+		CodeBlocks: int64(content.CodeBlocks(raw)),
+	}
+
+	if stats.WordCount > 0 || stats.CodeBlocks > 0 {
+		seconds := stats.WordCount*60/wordsPerMinute + stats.CodeBlocks*codeBlockSeconds
+		stats.ReadingMinutes = (seconds + 59) / 60
+		// Even the shortest post reads in a minute.
+		if stats.ReadingMinutes == 0 {
+			stats.ReadingMinutes = 1
+		}
+	}
+
+	return stats
+}
@@ -1,14 +1,51 @@
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
 package post
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type PostUseCase interface {
-	LikePost(ctx context.Context, postId int64, likes LikePostDto) error
+	LikePost(ctx context.Context, like LikePostDto) (PostLikesDto, error)
+	UnlikePost(ctx context.Context, like LikePostDto) (PostLikesDto, error)
 	AddPost(ctx context.Context, post AddPostDto) (int64, error)
-	GetPosts(ctx context.Context) ([]PostModelWithUser, error)
-	GetMyPosts(ctx context.Context, userId int64) ([]PostModelWithUser, error)
-	GetPublishedPosts(ctx context.Context) ([]PostModelWithUser, error)
+	GetPosts(ctx context.Context, dto ListPostsDto) (PostsPageDto, error)
+	GetMyPosts(ctx context.Context, userId int64, dto ListPostsDto) (PostsPageDto, error)
+	GetPublishedPosts(ctx context.Context, dto ListPostsDto) (PostsPageDto, error)
 	GetTotalLikesCountByUser(ctx context.Context, userId int64) (int64, error)
 	UpdatePost(ctx context.Context, post UpdatePostDto) error
+	DeletePost(ctx context.Context, dto DeletePostDto) error
+	RestorePost(ctx context.Context, dto DeletePostDto) error
+	PurgePosts(ctx context.Context) (PurgePostsDto, error)
 	GetPostById(ctx context.Context, id int64) (PostModel, error)
+	ViewPost(ctx context.Context, dto ViewPostDto) (PostModel, error)
+	ViewPostBySlug(ctx context.Context, dto ViewPostBySlugDto) (PostBySlugDto, error)
+	FlushViews(ctx context.Context) error
+	SubmitPost(ctx context.Context, dto TransitionPostDto) error
+	WithdrawPost(ctx context.Context, dto TransitionPostDto) error
+	UnpublishPost(ctx context.Context, dto TransitionPostDto) error
+	StartReview(ctx context.Context, dto TransitionPostDto) error
+	ApprovePost(ctx context.Context, dto TransitionPostDto) error
+	RejectPost(ctx context.Context, dto TransitionPostDto) error
+	PublishScheduled(ctx context.Context) (PublishScheduledDto, error)
+	GetReviewQueue(ctx context.Context) ([]PostModelWithUser, error)
+	GetPostTransitions(ctx context.Context, dto GetTransitionsDto) ([]TransitionDto, error)
+	GetRevisions(ctx context.Context, dto GetRevisionsDto) ([]RevisionDto, error)
+	DiffRevisions(ctx context.Context, dto DiffRevisionsDto) (RevisionDiffDto, error)
+	RestoreRevision(ctx context.Context, dto RestoreRevisionDto) error
+}
+
+type Config interface {
+	// RetentionPeriod is how long deleted posts can still be restored.
+	RetentionPeriod() time.Duration
+	// ViewWindow is how long repeated reads of a post by the same reader
+	// count as a single view.
+	ViewWindow() time.Duration
+	// ViewFlushInterval is how often buffered views are written to posts.
+	ViewFlushInterval() time.Duration
+	// PublishInterval is how often scheduled posts that are due get
+	// published.
+	PublishInterval() time.Duration
 }
@@ -0,0 +1,104 @@
+package post
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+
+	"fibo/internal/base/errors"
+)
+
+// ViewMilestones are the counts of views by registered users that earn the
+// author reputation once a post reaches them. Views of anonymous visitors
+// don't count towards them, as visitor ids are made up by the client.
+var ViewMilestones = []int64{100, 1000, 10000, 100000}
+
+// ViewModel is a read of a post. Like a like, it belongs either to a
+// registered user or to an anonymous visitor.
+type ViewModel struct {
+	PostId    int64
+	UserId    int64
+	VisitorId string
+}
+
+func NewView(postId, userId int64, visitorId string) (ViewModel, error) {
+	view := ViewModel{
+		PostId: postId,
+		UserId: userId,
+	}
+	if userId == 0 {
+		view.VisitorId = visitorId
+	}
+
+	if err := view.Validate(); err != nil {
+		return ViewModel{}, err
+	}
+
+	return view, nil
+}
+
+// Viewer identifies the reader, so repeated reads within the view window
+// count once.
+func (view *ViewModel) Viewer() string {
+	if view.UserId != 0 {
+		return fmt.Sprintf("user:%d", view.UserId)
+	}
+
+	return "visitor:" + view.VisitorId
+}
+
+func (view *ViewModel) Validate() error {
+	visitorRules := []validation.Rule{is.UUID}
+	if view.UserId == 0 {
+		visitorRules = append(visitorRules, validation.Required)
+	}
+
+	err := validation.ValidateStruct(view,
+		validation.Field(&view.PostId, validation.Required),
+		validation.Field(&view.VisitorId, visitorRules...),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// AddedViewsModel are the views of a post counted since the last flush,
+// with those of registered users among them.
+type AddedViewsModel struct {
+	Views     int64
+	UserViews int64
+}
+
+// Add counts the view in.
+func (added *AddedViewsModel) Add(view ViewModel) {
+	added.Views++
+	if view.UserId != 0 {
+		added.UserViews++
+	}
+}
+
+// ViewCountModel is the view counter of a post after Added views were
+// flushed to it, UserAdded of them by registered users.
+type ViewCountModel struct {
+	PostId    int64
+	UserId    int64
+	Views     int64
+	Added     int64
+	UserViews int64
+	UserAdded int64
+}
+
+// Milestones returns the view milestones the flush made the post cross.
+func (count *ViewCountModel) Milestones() []int64 {
+	var milestones []int64
+	for _, milestone := range ViewMilestones {
+		if count.UserViews-count.UserAdded < milestone && milestone <= count.UserViews {
+			milestones = append(milestones, milestone)
+		}
+	}
+
+	return milestones
+}
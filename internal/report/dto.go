@@ -0,0 +1,101 @@
+package report
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+// AddReportDto is a report of a user or, when UserId is zero, of an
+// anonymous visitor.
+type AddReportDto struct {
+	TargetType TargetType `json:"-"`
+	TargetId   int64      `json:"-"`
+	UserId     int64      `json:"-"`
+	VisitorId  string     `json:"-"`
+	Reason     Reason     `json:"reason"`
+	Note       string     `json:"note"`
+}
+
+func (dto AddReportDto) MapToModel() (ReportModel, error) {
+	target, err := NewTarget(dto.TargetType, dto.TargetId)
+	if err != nil {
+		return ReportModel{}, err
+	}
+
+	return NewReport(target, dto.UserId, dto.VisitorId, dto.Reason, dto.Note)
+}
+
+// AddedReportDto tells the reporter whether the report hid the content.
+type AddedReportDto struct {
+	Id     int64 `json:"id"`
+	Hidden bool  `json:"hidden"`
+}
+
+type TargetDto struct {
+	TargetType      TargetType       `json:"targetType"`
+	TargetId        int64            `json:"targetId"`
+	PostId          int64            `json:"postId"`
+	AuthorId        int64            `json:"authorId"`
+	Excerpt         string           `json:"excerpt"`
+	Hidden          bool             `json:"hidden"`
+	Reports         int64            `json:"reports"`
+	UserReports     int64            `json:"userReports"`
+	Reasons         map[Reason]int64 `json:"reasons"`
+	FirstReportedAt string           `json:"firstReportedAt"`
+	LastReportedAt  string           `json:"lastReportedAt"`
+}
+
+func (dto TargetDto) MapFromModel(model TargetModel) TargetDto {
+	dto.TargetType = model.Target.Type
+	dto.TargetId = model.Target.Id
+	dto.PostId = model.PostId
+	dto.AuthorId = model.AuthorId
+	dto.Excerpt = model.Excerpt
+	dto.Hidden = model.IsHidden()
+	dto.Reports = model.Reports
+	dto.UserReports = model.UserReports
+	dto.Reasons = model.Reasons
+	dto.FirstReportedAt = model.FirstReportedAt
+	dto.LastReportedAt = model.LastReportedAt
+
+	if dto.Reasons == nil {
+		dto.Reasons = map[Reason]int64{}
+	}
+
+	return dto
+}
+
+type GetQueueDto struct {
+	Limit  uint `json:"limit"`
+	Offset uint `json:"offset"`
+}
+
+func (dto GetQueueDto) MapToModel() QueueModel {
+	return NewQueue(dto.Limit, dto.Offset)
+}
+
+// ModerateDto is the decision of a reviewer about the open reports of a
+// target.
+type ModerateDto struct {
+	TargetType TargetType `json:"-"`
+	TargetId   int64      `json:"-"`
+	ActorId    int64      `json:"-"`
+	Action     Action     `json:"-"`
+}
+
+func (dto *ModerateDto) Validate() error {
+	err := validation.ValidateStruct(dto,
+		validation.Field(&dto.Action, validation.Required, validation.In(actionValues()...)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// ModeratedDto counts the reports the decision closed.
+type ModeratedDto struct {
+	Closed int64 `json:"closed"`
+}
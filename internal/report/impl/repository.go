@@ -0,0 +1,334 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/report"
+)
+
+// excerptLength is how much of a reported comment the queue shows.
+const excerptLength = 200
+
+type ReportRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewReportRepository(opts ReportRepositoryOpts) report.ReportRepository {
+	return &reportRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type reportRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *reportRepository) Add(ctx context.Context, model report.ReportModel) (int64, error) {
+	record := databaseImpl.Record{
+		"target_type": model.Target.Type,
+		"target_id":   model.Target.Id,
+		"reason":      model.Reason,
+		"note":        model.Note,
+		"status":      model.Status,
+	}
+	if model.UserId != 0 {
+		record["user_id"] = model.UserId
+	} else {
+		record["visitor_id"] = model.VisitorId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("reports").
+		Rows(record).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddReportError(err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *reportRepository) Lock(ctx context.Context, target report.Target) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From(targetTable(target)).
+		Select("id").
+		Where(databaseImpl.Ex{"id": target.Id, "deleted_at": nil}).
+		ForUpdate(exp.Wait).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var id int64
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&id); err != nil {
+		return parseGetTargetError(target, err)
+	}
+
+	return nil
+}
+
+func (r *reportRepository) GetTarget(ctx context.Context, target report.Target) (report.TargetModel, error) {
+	sql, _, err := selectTargets().
+		LeftJoin(goqu.T("reports"), openReports).
+		Where(goqu.Ex{"contents.type": target.Type, "contents.id": target.Id}).
+		ToSQL()
+	if err != nil {
+		return report.TargetModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanTarget(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return report.TargetModel{}, parseGetTargetError(target, err)
+	}
+
+	return model, nil
+}
+
+// GetQueue lists reported content with open reports, the most reported
+// first and, among equally reported, the one waiting longest.
+func (r *reportRepository) GetQueue(ctx context.Context, queue report.QueueModel) ([]report.TargetModel, error) {
+	sql, _, err := selectTargets().
+		InnerJoin(goqu.T("reports"), openReports).
+		Order(
+			goqu.COUNT("reports.id").Desc(),
+			goqu.MIN("reports.created_at").Asc(),
+			goqu.I("contents.type").Asc(),
+			goqu.I("contents.id").Asc(),
+		).
+		Limit(queue.Limit).
+		Offset(queue.Offset).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get report queue failed")
+	}
+	defer rows.Close()
+
+	var models []report.TargetModel
+	for rows.Next() {
+		model, err := scanTarget(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan reported content failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get report queue failed")
+	}
+
+	return models, nil
+}
+
+func (r *reportRepository) Close(
+	ctx context.Context,
+	target report.Target,
+	status report.Status,
+	closedBy int64,
+) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("reports").
+		Set(databaseImpl.Record{
+			"status":    status,
+			"closed_by": closedBy,
+			"closed_at": goqu.L("CURRENT_TIMESTAMP"),
+		}).
+		Where(databaseImpl.Ex{
+			"target_type": target.Type,
+			"target_id":   target.Id,
+			"status":      report.StatusOpen,
+		}).
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "close reports failed")
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *reportRepository) SetHidden(ctx context.Context, target report.Target, hidden bool) error {
+	var hiddenAt interface{}
+	if hidden {
+		hiddenAt = goqu.L("CURRENT_TIMESTAMP")
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update(targetTable(target)).
+		Set(databaseImpl.Record{"hidden_at": hiddenAt}).
+		Where(databaseImpl.Ex{"id": target.Id}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "hide reported content failed")
+	}
+
+	return nil
+}
+
+// targetTable is the table holding the reported content.
+func targetTable(target report.Target) string {
+	if target.Type == report.TargetComment {
+		return "comments"
+	}
+
+	return "posts"
+}
+
+// openReports joins the reports moderators have not decided on yet.
+var openReports = goqu.On(goqu.Ex{
+	"reports.target_type": goqu.I("contents.type"),
+	"reports.target_id":   goqu.I("contents.id"),
+	"reports.status":      report.StatusOpen,
+})
+
+// selectTargets aggregates reports per post or comment. Callers join the
+// reports with openReports: an inner join keeps reported content only.
+func selectTargets() *goqu.SelectDataset {
+	posts := databaseImpl.QueryBuilder.
+		From("posts").
+		Select(
+			goqu.V(string(report.TargetPost)).As("type"),
+			goqu.I("posts.id"),
+			goqu.I("posts.id").As("post_id"),
+			goqu.I("posts.user_id"),
+			goqu.I("posts.title").As("excerpt"),
+			goqu.I("posts.hidden_at"),
+		).
+		Where(goqu.Ex{"posts.deleted_at": nil})
+
+	comments := databaseImpl.QueryBuilder.
+		From("comments").
+		Select(
+			goqu.V(string(report.TargetComment)).As("type"),
+			goqu.I("comments.id"),
+			goqu.I("comments.post_id"),
+			goqu.I("comments.user_id"),
+			goqu.L("LEFT(comments.content, ?)", excerptLength).As("excerpt"),
+			goqu.I("comments.hidden_at"),
+		).
+		Where(goqu.Ex{"comments.deleted_at": nil})
+
+	columns := []interface{}{
+		"contents.type",
+		"contents.id",
+		"contents.post_id",
+		"contents.user_id",
+		"contents.excerpt",
+		"contents.hidden_at",
+		goqu.COUNT("reports.id"),
+		goqu.COUNT("reports.user_id"),
+		goqu.MIN("reports.created_at"),
+		goqu.MAX("reports.created_at"),
+	}
+	for _, reason := range report.Reasons {
+		columns = append(columns, goqu.L("COUNT(reports.id) FILTER (WHERE reports.reason = ?)", string(reason)))
+	}
+
+	return databaseImpl.QueryBuilder.
+		From(posts.UnionAll(comments).As("contents")).
+		Select(columns...).
+		GroupBy(
+			"contents.type",
+			"contents.id",
+			"contents.post_id",
+			"contents.user_id",
+			"contents.excerpt",
+			"contents.hidden_at",
+		)
+}
+
+func scanTarget(row pgx.Row) (report.TargetModel, error) {
+	var model report.TargetModel
+	var authorId sqlS.NullInt64
+	var hiddenAt sqlS.NullTime
+	var firstReportedAt sqlS.NullTime
+	var lastReportedAt sqlS.NullTime
+
+	reasons := make([]int64, len(report.Reasons))
+	targets := []interface{}{
+		&model.Target.Type,
+		&model.Target.Id,
+		&model.PostId,
+		&authorId,
+		&model.Excerpt,
+		&hiddenAt,
+		&model.Reports,
+		&model.UserReports,
+		&firstReportedAt,
+		&lastReportedAt,
+	}
+	for i := range reasons {
+		targets = append(targets, &reasons[i])
+	}
+
+	if err := row.Scan(targets...); err != nil {
+		return report.TargetModel{}, err
+	}
+
+	model.AuthorId = authorId.Int64
+	model.Reasons = map[report.Reason]int64{}
+	for i, reason := range report.Reasons {
+		if reasons[i] > 0 {
+			model.Reasons[reason] = reasons[i]
+		}
+	}
+	if hiddenAt.Valid {
+		model.HiddenAt = hiddenAt.Time.Format(time.RFC3339)
+	}
+	if firstReportedAt.Valid {
+		model.FirstReportedAt = firstReportedAt.Time.Format(time.RFC3339)
+	}
+	if lastReportedAt.Valid {
+		model.LastReportedAt = lastReportedAt.Time.Format(time.RFC3339)
+	}
+
+	return model, nil
+}
+
+func parseAddReportError(err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.UniqueViolation {
+		return errors.Wrap(err, errors.AlreadyExistsError, "content is already reported")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add report failed")
+}
+
+func parseGetTargetError(target report.Target, err error) error {
+	if err == pgx.ErrNoRows {
+		return errors.Wrapf(err, errors.NotFoundError, "%s with id \"%d\" not found", target.Type, target.Id)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get reported content failed")
+}
@@ -0,0 +1,196 @@
+package impl
+
+import (
+	"context"
+
+	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/report"
+	"fibo/internal/reputation"
+)
+
+type ReportUsecasesOpts struct {
+	Config               report.Config
+	TxManager            database.TxManager
+	Events               event.Bus
+	ReportRepository     report.ReportRepository
+	ReputationRepository reputation.ReputationRepository
+}
+
+func NewReportUsecases(opts ReportUsecasesOpts) report.ReportUsecases {
+	return &reportUsecases{
+		Config:               opts.Config,
+		TxManager:            opts.TxManager,
+		Bus:                  opts.Events,
+		ReportRepository:     opts.ReportRepository,
+		ReputationRepository: opts.ReputationRepository,
+	}
+}
+
+type reportUsecases struct {
+	report.Config
+	database.TxManager
+	event.Bus
+	report.ReportRepository
+	reputation.ReputationRepository
+}
+
+// Add files the report and hides the content once the open reports of
+// registered users reach the threshold, so a moderator sees it before more
+// readers do. Reports of visitors only go to the queue.
+func (u *reportUsecases) Add(ctx context.Context, in report.AddReportDto) (out report.AddedReportDto, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		// Reports arriving together would otherwise count the same open
+		// reports and none of them reach the threshold.
+		if err := u.ReportRepository.Lock(ctx, model.Target); err != nil {
+			return err
+		}
+		target, err := u.ReportRepository.GetTarget(ctx, model.Target)
+		if err != nil {
+			return err
+		}
+		if model.UserId != 0 && target.AuthorId == model.UserId {
+			return errors.New(errors.ValidationError, "cannot report own content")
+		}
+
+		out.Id, err = u.ReportRepository.Add(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		target.Reports++
+		if model.UserId != 0 {
+			target.UserReports++
+		}
+		if !target.ShouldHide(u.HideThreshold()) {
+			return nil
+		}
+
+		out.Hidden = true
+		return u.setHidden(ctx, target, true, 0)
+	})
+
+	return out, err
+}
+
+func (u *reportUsecases) GetQueue(ctx context.Context, in report.GetQueueDto) (out []report.TargetDto, err error) {
+	targets, err := u.ReportRepository.GetQueue(ctx, in.MapToModel())
+	if err != nil {
+		return nil, err
+	}
+
+	out = []report.TargetDto{}
+	for _, model := range targets {
+		out = append(out, report.TargetDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *reportUsecases) Moderate(ctx context.Context, in report.ModerateDto) (out report.ModeratedDto, err error) {
+	if err := in.Validate(); err != nil {
+		return out, err
+	}
+
+	targetKey, err := report.NewTarget(in.TargetType, in.TargetId)
+	if err != nil {
+		return out, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.ReportRepository.Lock(ctx, targetKey); err != nil {
+			return err
+		}
+		target, err := u.ReportRepository.GetTarget(ctx, targetKey)
+		if err != nil {
+			return err
+		}
+
+		switch in.Action {
+		case report.ActionHide:
+			if target.IsHidden() {
+				return nil
+			}
+			return u.setHidden(ctx, target, true, in.ActorId)
+
+		case report.ActionDismiss:
+			out.Closed, err = u.close(ctx, target, report.StatusDismissed, in.ActorId)
+			if err != nil || !target.IsHidden() {
+				return err
+			}
+			return u.setHidden(ctx, target, false, in.ActorId)
+
+		default:
+			out.Closed, err = u.close(ctx, target, report.StatusUpheld, in.ActorId)
+			if err != nil {
+				return err
+			}
+			if !target.IsHidden() {
+				if err := u.setHidden(ctx, target, true, in.ActorId); err != nil {
+					return err
+				}
+			}
+			return u.penalize(ctx, target)
+		}
+	})
+
+	return out, err
+}
+
+// setHidden hides the content or shows it again. Readers see a post
+// differently then, which the rest of the app is told about.
+func (u *reportUsecases) setHidden(ctx context.Context, target report.TargetModel, hidden bool, actorId int64) error {
+	if err := u.ReportRepository.SetHidden(ctx, target.Target, hidden); err != nil {
+		return err
+	}
+	if target.Target.Type != report.TargetPost {
+		return nil
+	}
+
+	return u.Bus.Publish(ctx, event.Event{
+		Name:    event.PostChanged,
+		UserId:  target.AuthorId,
+		ActorId: actorId,
+		PostId:  target.Target.Id,
+	})
+}
+
+func (u *reportUsecases) close(
+	ctx context.Context,
+	target report.TargetModel,
+	status report.Status,
+	actorId int64,
+) (int64, error) {
+	if target.Reports == 0 {
+		return 0, errors.New(errors.ValidationError, "content has no open reports")
+	}
+
+	return u.ReportRepository.Close(ctx, target.Target, status, actorId)
+}
+
+// penalize takes reputation from the author of upheld content. Content is
+// penalized once however many times it is reported again.
+func (u *reportUsecases) penalize(ctx context.Context, target report.TargetModel) error {
+	if target.AuthorId == 0 {
+		return nil
+	}
+
+	event, err := reputation.NewEvent(
+		target.AuthorId,
+		reputation.EventReportPenalty,
+		target.PostId,
+		reputation.Ref(string(target.Target.Type), target.Target.Id),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.ReputationRepository.AddEvent(ctx, event)
+	return err
+}
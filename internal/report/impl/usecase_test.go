@@ -0,0 +1,434 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/report"
+	"fibo/internal/reputation"
+
+	dbMock "fibo/internal/base/database/mock"
+	eventMock "fibo/internal/base/event/mock"
+	reportMock "fibo/internal/report/mock"
+	reputationMock "fibo/internal/reputation/mock"
+)
+
+func TestReportUsecases_Add(t *testing.T) {
+	postTarget := report.Target{Type: report.TargetPost, Id: 10}
+	getTarget := report.TargetModel{Target: postTarget, PostId: 10, AuthorId: 2, Excerpt: "Title", Reports: 3, UserReports: 3}
+
+	in := report.AddReportDto{
+		TargetType: report.TargetPost,
+		TargetId:   10,
+		UserId:     5,
+		Reason:     report.ReasonMisleading,
+		Note:       "The numbers are made up",
+	}
+	addReport := report.ReportModel{
+		Target: postTarget,
+		UserId: 5,
+		Reason: report.ReasonMisleading,
+		Note:   "The numbers are made up",
+		Status: report.StatusOpen,
+	}
+
+	t.Run("expect it adds the report", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(getTarget, nil)
+		prep.reportRepo.EXPECT().Add(mock.Anything, addReport).Return(int64(4), nil)
+		prep.config.EXPECT().HideThreshold().Return(int64(5))
+
+		out, err := prep.reportUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, report.AddedReportDto{Id: 4}, out)
+		prep.reportRepo.AssertNotCalled(t, "SetHidden", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it hides the content reaching the threshold", func(t *testing.T) {
+		prep := newTestPrep()
+		reported := getTarget
+		reported.Reports = 4
+		reported.UserReports = 4
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(reported, nil)
+		prep.reportRepo.EXPECT().Add(mock.Anything, addReport).Return(int64(4), nil)
+		prep.config.EXPECT().HideThreshold().Return(int64(5))
+		prep.reportRepo.EXPECT().SetHidden(mock.Anything, postTarget, true).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, postChanged(0)).Return(nil)
+
+		out, err := prep.reportUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, report.AddedReportDto{Id: 4, Hidden: true}, out)
+	})
+
+	t.Run("expect it does not hide when the threshold is off", func(t *testing.T) {
+		prep := newTestPrep()
+		reported := getTarget
+		reported.Reports = 40
+		reported.UserReports = 40
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(reported, nil)
+		prep.reportRepo.EXPECT().Add(mock.Anything, addReport).Return(int64(4), nil)
+		prep.config.EXPECT().HideThreshold().Return(int64(0))
+
+		out, err := prep.reportUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.False(t, out.Hidden)
+	})
+
+	t.Run("expect anonymous visitors to report comments", func(t *testing.T) {
+		prep := newTestPrep()
+		commentTarget := report.Target{Type: report.TargetComment, Id: 8}
+		visitorIn := report.AddReportDto{
+			TargetType: report.TargetComment,
+			TargetId:   8,
+			VisitorId:  "5d3b1f43-6f1c-4a57-9a35-0c9a9d0e7a11",
+			Reason:     report.ReasonSpam,
+		}
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, commentTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, commentTarget).
+			Return(report.TargetModel{Target: commentTarget, PostId: 10}, nil)
+		prep.reportRepo.EXPECT().Add(mock.Anything, report.ReportModel{
+			Target:    commentTarget,
+			VisitorId: visitorIn.VisitorId,
+			Reason:    report.ReasonSpam,
+			Status:    report.StatusOpen,
+		}).Return(int64(6), nil)
+		prep.config.EXPECT().HideThreshold().Return(int64(5))
+
+		out, err := prep.reportUsecases.Add(prep.ctx, visitorIn)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(6), out.Id)
+	})
+
+	t.Run("expect visitor reports not to hide the content", func(t *testing.T) {
+		prep := newTestPrep()
+		visitorIn := in
+		visitorIn.UserId = 0
+		visitorIn.VisitorId = "5d3b1f43-6f1c-4a57-9a35-0c9a9d0e7a11"
+		reported := getTarget
+		reported.Reports = 40
+		reported.UserReports = 4
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(reported, nil)
+		prep.reportRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(4), nil)
+		prep.config.EXPECT().HideThreshold().Return(int64(5))
+
+		out, err := prep.reportUsecases.Add(prep.ctx, visitorIn)
+
+		require.NoError(t, err)
+		require.False(t, out.Hidden)
+		prep.reportRepo.AssertNotCalled(t, "SetHidden", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails on unknown reason", func(t *testing.T) {
+		prep := newTestPrep()
+		badIn := in
+		badIn.Reason = "boring"
+
+		_, err := prep.reportUsecases.Add(prep.ctx, badIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+
+	t.Run("expect authors not to report own content", func(t *testing.T) {
+		prep := newTestPrep()
+		ownIn := in
+		ownIn.UserId = getTarget.AuthorId
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(getTarget, nil)
+
+		_, err := prep.reportUsecases.Add(prep.ctx, ownIn)
+
+		require.Error(t, err)
+		prep.reportRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect the content to be locked before its reports are counted", func(t *testing.T) {
+		prep := newTestPrep()
+		var calls []string
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).
+			Run(func(ctx context.Context, target report.Target) { calls = append(calls, "Lock") }).
+			Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).
+			Run(func(ctx context.Context, target report.Target) { calls = append(calls, "GetTarget") }).
+			Return(getTarget, nil)
+		prep.reportRepo.EXPECT().Add(mock.Anything, addReport).Return(int64(4), nil)
+		prep.config.EXPECT().HideThreshold().Return(int64(5))
+
+		_, err := prep.reportUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"Lock", "GetTarget"}, calls)
+	})
+
+	t.Run("expect it fails on deleted content", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).
+			Return(baseErrors.New(baseErrors.NotFoundError, "post with id \"10\" not found"))
+
+		_, err := prep.reportUsecases.Add(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+		prep.reportRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReportUsecases_GetQueue(t *testing.T) {
+	t.Run("expect it lists reported content", func(t *testing.T) {
+		prep := newTestPrep()
+		target := report.TargetModel{
+			Target:   report.Target{Type: report.TargetComment, Id: 8},
+			PostId:   10,
+			Excerpt:  "Buy now",
+			HiddenAt: "2024-05-01T10:00:00Z",
+			Reports:  6,
+			Reasons:  map[report.Reason]int64{report.ReasonSpam: 6},
+		}
+
+		prep.reportRepo.EXPECT().GetQueue(mock.Anything, report.QueueModel{Limit: 100}).
+			Return([]report.TargetModel{target}, nil)
+
+		out, err := prep.reportUsecases.GetQueue(prep.ctx, report.GetQueueDto{Limit: 1000})
+
+		require.NoError(t, err)
+		require.Equal(t, []report.TargetDto{{
+			TargetType: report.TargetComment,
+			TargetId:   8,
+			PostId:     10,
+			Excerpt:    "Buy now",
+			Hidden:     true,
+			Reports:    6,
+			Reasons:    map[report.Reason]int64{report.ReasonSpam: 6},
+		}}, out)
+	})
+}
+
+func TestReportUsecases_Moderate(t *testing.T) {
+	postTarget := report.Target{Type: report.TargetPost, Id: 10}
+	getTarget := report.TargetModel{Target: postTarget, PostId: 10, AuthorId: 2, Reports: 3}
+
+	in := report.ModerateDto{TargetType: report.TargetPost, TargetId: 10, ActorId: 7}
+
+	t.Run("expect resolving to hide the post and penalize its author", func(t *testing.T) {
+		prep := newTestPrep()
+		resolveIn := in
+		resolveIn.Action = report.ActionResolve
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(getTarget, nil)
+		prep.reportRepo.EXPECT().Close(mock.Anything, postTarget, report.StatusUpheld, int64(7)).Return(int64(3), nil)
+		prep.reportRepo.EXPECT().SetHidden(mock.Anything, postTarget, true).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, postChanged(7)).Return(nil)
+		prep.reputationRepo.EXPECT().AddEvent(mock.Anything, reputation.EventModel{
+			UserId: 2,
+			Event:  reputation.EventReportPenalty,
+			PostId: 10,
+			Ref:    "post:10",
+		}).Return(true, nil)
+
+		out, err := prep.reportUsecases.Moderate(prep.ctx, resolveIn)
+
+		require.NoError(t, err)
+		require.Equal(t, report.ModeratedDto{Closed: 3}, out)
+	})
+
+	t.Run("expect dismissing to show hidden content again", func(t *testing.T) {
+		prep := newTestPrep()
+		dismissIn := in
+		dismissIn.Action = report.ActionDismiss
+		hidden := getTarget
+		hidden.HiddenAt = "2024-05-01T10:00:00Z"
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(hidden, nil)
+		prep.reportRepo.EXPECT().Close(mock.Anything, postTarget, report.StatusDismissed, int64(7)).Return(int64(3), nil)
+		prep.reportRepo.EXPECT().SetHidden(mock.Anything, postTarget, false).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, postChanged(7)).Return(nil)
+
+		out, err := prep.reportUsecases.Moderate(prep.ctx, dismissIn)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(3), out.Closed)
+		prep.reputationRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect dismissing visible content to leave it shown", func(t *testing.T) {
+		prep := newTestPrep()
+		dismissIn := in
+		dismissIn.Action = report.ActionDismiss
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(getTarget, nil)
+		prep.reportRepo.EXPECT().Close(mock.Anything, postTarget, report.StatusDismissed, int64(7)).Return(int64(3), nil)
+
+		out, err := prep.reportUsecases.Moderate(prep.ctx, dismissIn)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(3), out.Closed)
+		prep.reportRepo.AssertNotCalled(t, "SetHidden", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect reports after dismissal to count from zero", func(t *testing.T) {
+		prep := newTestPrep()
+		dismissIn := in
+		dismissIn.Action = report.ActionDismiss
+		hidden := getTarget
+		hidden.HiddenAt = "2024-05-01T10:00:00Z"
+		hidden.Reports = 5
+		hidden.UserReports = 5
+		// Dismissed reports are closed, so they no longer count.
+		dismissed := report.TargetModel{Target: postTarget, PostId: 10, AuthorId: 2}
+		addIn := report.AddReportDto{
+			TargetType: report.TargetPost,
+			TargetId:   10,
+			UserId:     5,
+			Reason:     report.ReasonSpam,
+		}
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(hidden, nil).Once()
+		prep.reportRepo.EXPECT().Close(mock.Anything, postTarget, report.StatusDismissed, int64(7)).Return(int64(5), nil)
+		prep.reportRepo.EXPECT().SetHidden(mock.Anything, postTarget, false).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, postChanged(7)).Return(nil)
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(dismissed, nil).Once()
+		prep.reportRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(9), nil)
+		prep.config.EXPECT().HideThreshold().Return(int64(5))
+
+		_, err := prep.reportUsecases.Moderate(prep.ctx, dismissIn)
+		require.NoError(t, err)
+
+		out, err := prep.reportUsecases.Add(prep.ctx, addIn)
+
+		require.NoError(t, err)
+		require.Equal(t, report.AddedReportDto{Id: 9}, out)
+		prep.reportRepo.AssertNotCalled(t, "SetHidden", mock.Anything, postTarget, true)
+	})
+
+	t.Run("expect hiding to keep the reports open", func(t *testing.T) {
+		prep := newTestPrep()
+		hideIn := in
+		hideIn.Action = report.ActionHide
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(getTarget, nil)
+		prep.reportRepo.EXPECT().SetHidden(mock.Anything, postTarget, true).Return(nil)
+		prep.events.EXPECT().Publish(mock.Anything, postChanged(7)).Return(nil)
+
+		out, err := prep.reportUsecases.Moderate(prep.ctx, hideIn)
+
+		require.NoError(t, err)
+		require.Zero(t, out.Closed)
+		prep.reportRepo.AssertNotCalled(t, "Close", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect hiding a comment to leave its post unchanged", func(t *testing.T) {
+		prep := newTestPrep()
+		commentTarget := report.Target{Type: report.TargetComment, Id: 8}
+		hideIn := report.ModerateDto{TargetType: report.TargetComment, TargetId: 8, ActorId: 7, Action: report.ActionHide}
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, commentTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, commentTarget).
+			Return(report.TargetModel{Target: commentTarget, PostId: 10, AuthorId: 3, Reports: 1}, nil)
+		prep.reportRepo.EXPECT().SetHidden(mock.Anything, commentTarget, true).Return(nil)
+
+		_, err := prep.reportUsecases.Moderate(prep.ctx, hideIn)
+
+		require.NoError(t, err)
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails without open reports", func(t *testing.T) {
+		prep := newTestPrep()
+		resolveIn := in
+		resolveIn.Action = report.ActionResolve
+		decided := getTarget
+		decided.Reports = 0
+
+		prep.reportRepo.EXPECT().Lock(mock.Anything, postTarget).Return(nil)
+		prep.reportRepo.EXPECT().GetTarget(mock.Anything, postTarget).Return(decided, nil)
+
+		_, err := prep.reportUsecases.Moderate(prep.ctx, resolveIn)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+
+	t.Run("expect it fails on unknown target type", func(t *testing.T) {
+		prep := newTestPrep()
+		badIn := in
+		badIn.TargetType = "user"
+		badIn.Action = report.ActionHide
+
+		_, err := prep.reportUsecases.Moderate(prep.ctx, badIn)
+
+		require.Error(t, err)
+		prep.reportRepo.AssertNotCalled(t, "GetTarget", mock.Anything, mock.Anything)
+	})
+}
+
+// postChanged is what hiding the reported post or showing it again tells
+// the rest of the app.
+func postChanged(actorId int64) event.Event {
+	return event.Event{Name: event.PostChanged, UserId: 2, ActorId: actorId, PostId: 10}
+}
+
+type testPrep struct {
+	ctx            context.Context
+	config         *reportMock.Config
+	events         *eventMock.Bus
+	reportRepo     *reportMock.ReportRepository
+	reputationRepo *reputationMock.ReputationRepository
+
+	reportUsecases report.ReportUsecases
+}
+
+func newTestPrep() testPrep {
+	config := &reportMock.Config{}
+	reportRepo := &reportMock.ReportRepository{}
+	reputationRepo := &reputationMock.ReputationRepository{}
+	txManager := &dbMock.MockTxManager{}
+	events := &eventMock.Bus{}
+
+	reportUsecasesOpts := ReportUsecasesOpts{
+		Config:               config,
+		TxManager:            txManager,
+		Events:               events,
+		ReportRepository:     reportRepo,
+		ReputationRepository: reputationRepo,
+	}
+	reportUsecases := NewReportUsecases(reportUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		config:         config,
+		events:         events,
+		reportRepo:     reportRepo,
+		reputationRepo: reputationRepo,
+		reportUsecases: reportUsecases,
+	}
+}
@@ -0,0 +1,222 @@
+package report
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+const (
+	defaultQueueLimit uint = 20
+	maxQueueLimit     uint = 100
+)
+
+// TargetType is the kind of content a report is about.
+type TargetType string
+
+const (
+	TargetPost    TargetType = "post"
+	TargetComment TargetType = "comment"
+)
+
+var TargetTypes = []TargetType{TargetPost, TargetComment}
+
+// Reason is the category a reporter picks for what is wrong with the
+// content.
+type Reason string
+
+const (
+	ReasonMisleading Reason = "misleading"
+	ReasonUnethical  Reason = "unethical"
+	ReasonSpam       Reason = "spam"
+	ReasonHarassment Reason = "harassment"
+	ReasonOther      Reason = "other"
+)
+
+var Reasons = []Reason{ReasonMisleading, ReasonUnethical, ReasonSpam, ReasonHarassment, ReasonOther}
+
+// Status tells whether a report still waits for a moderator. Upheld reports
+// were found right, dismissed ones were not.
+type Status string
+
+const (
+	StatusOpen      Status = "open"
+	StatusUpheld    Status = "upheld"
+	StatusDismissed Status = "dismissed"
+)
+
+// Action is what a moderator does with the reports of a target.
+type Action string
+
+const (
+	// ActionResolve upholds the reports: the content stays hidden and its
+	// author gets a reputation penalty.
+	ActionResolve Action = "resolve"
+	// ActionDismiss rejects the reports and shows the content again.
+	ActionDismiss Action = "dismiss"
+	// ActionHide hides the content while its reports wait for a decision.
+	ActionHide Action = "hide"
+)
+
+var Actions = []Action{ActionResolve, ActionDismiss, ActionHide}
+
+// Target identifies the reported post or comment.
+type Target struct {
+	Type TargetType
+	Id   int64
+}
+
+func NewTarget(targetType TargetType, id int64) (Target, error) {
+	target := Target{
+		Type: targetType,
+		Id:   id,
+	}
+	if err := target.Validate(); err != nil {
+		return Target{}, err
+	}
+
+	return target, nil
+}
+
+func (target *Target) Validate() error {
+	err := validation.ValidateStruct(target,
+		validation.Field(&target.Type, validation.Required, validation.In(targetTypeValues()...)),
+		validation.Field(&target.Id, validation.Required),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// ReportModel is a complaint of a user or, when UserId is zero, of an
+// anonymous visitor about a post or comment. A reporter reports a target
+// once.
+type ReportModel struct {
+	Id        int64
+	Target    Target
+	UserId    int64
+	VisitorId string
+	Reason    Reason
+	Note      string
+	Status    Status
+	CreatedAt string
+}
+
+func NewReport(target Target, userId int64, visitorId string, reason Reason, note string) (ReportModel, error) {
+	report := ReportModel{
+		Target: target,
+		UserId: userId,
+		Reason: reason,
+		Note:   note,
+		Status: StatusOpen,
+	}
+	if userId == 0 {
+		report.VisitorId = visitorId
+	}
+
+	if err := report.Validate(); err != nil {
+		return ReportModel{}, err
+	}
+
+	return report, nil
+}
+
+func (report *ReportModel) Validate() error {
+	if err := report.Target.Validate(); err != nil {
+		return err
+	}
+	if report.UserId == 0 && report.VisitorId == "" {
+		return errors.New(errors.ValidationError, "reporter is unknown")
+	}
+
+	err := validation.ValidateStruct(report,
+		validation.Field(&report.Reason, validation.Required, validation.In(reasonValues()...)),
+		validation.Field(&report.Note, validation.Length(0, 1000)),
+		validation.Field(&report.VisitorId, validation.Length(0, 64)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// TargetModel aggregates the open reports of a post or comment for the
+// moderation queue.
+type TargetModel struct {
+	Target Target
+	// PostId is the post itself or the one the comment was left on.
+	PostId int64
+	// AuthorId is zero for comments of anonymous visitors.
+	AuthorId int64
+	Excerpt  string
+	HiddenAt string
+	// Reports counts the open reports, Reasons splits them by reason.
+	Reports int64
+	// UserReports counts the open reports of registered users. Visitor ids
+	// are made up by clients, so only these count toward hiding.
+	UserReports     int64
+	Reasons         map[Reason]int64
+	FirstReportedAt string
+	LastReportedAt  string
+}
+
+func (target *TargetModel) IsHidden() bool {
+	return target.HiddenAt != ""
+}
+
+// ShouldHide reports whether the open reports of registered users reached
+// the threshold at which the content is hidden before a moderator looks at
+// it. A threshold of zero turns automatic hiding off.
+func (target *TargetModel) ShouldHide(threshold int64) bool {
+	return threshold > 0 && !target.IsHidden() && target.UserReports >= threshold
+}
+
+// QueueModel pages the moderation queue, most reported targets first.
+type QueueModel struct {
+	Limit  uint
+	Offset uint
+}
+
+func NewQueue(limit uint, offset uint) QueueModel {
+	if limit == 0 {
+		limit = defaultQueueLimit
+	}
+	if limit > maxQueueLimit {
+		limit = maxQueueLimit
+	}
+
+	return QueueModel{
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+func targetTypeValues() []interface{} {
+	values := make([]interface{}, len(TargetTypes))
+	for i, targetType := range TargetTypes {
+		values[i] = targetType
+	}
+
+	return values
+}
+
+func reasonValues() []interface{} {
+	values := make([]interface{}, len(Reasons))
+	for i, reason := range Reasons {
+		values[i] = reason
+	}
+
+	return values
+}
+
+func actionValues() []interface{} {
+	values := make([]interface{}, len(Actions))
+	for i, action := range Actions {
+		values[i] = action
+	}
+
+	return values
+}
@@ -0,0 +1,21 @@
+//go:generate mockery --name ReportRepository --filename repository.go --output ./mock --with-expecter
+
+package report
+
+import "context"
+
+type ReportRepository interface {
+	Add(ctx context.Context, report ReportModel) (int64, error)
+	// Lock keeps others from reporting or moderating the content until the
+	// transaction ends, so its open reports are counted one at a time. It
+	// fails with NotFoundError when the content is gone.
+	Lock(ctx context.Context, target Target) error
+	// GetTarget returns the reported content with its open reports. It
+	// fails with NotFoundError when the content is gone.
+	GetTarget(ctx context.Context, target Target) (TargetModel, error)
+	GetQueue(ctx context.Context, queue QueueModel) ([]TargetModel, error)
+	// Close gives the open reports of the target the status and returns how
+	// many it closed.
+	Close(ctx context.Context, target Target, status Status, closedBy int64) (int64, error)
+	SetHidden(ctx context.Context, target Target, hidden bool) error
+}
@@ -0,0 +1,18 @@
+//go:generate mockery --name ReportUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package report
+
+import "context"
+
+type ReportUsecases interface {
+	Add(ctx context.Context, dto AddReportDto) (AddedReportDto, error)
+	GetQueue(ctx context.Context, dto GetQueueDto) ([]TargetDto, error)
+	Moderate(ctx context.Context, dto ModerateDto) (ModeratedDto, error)
+}
+
+type Config interface {
+	// HideThreshold is the number of open reports that hides a post or
+	// comment until a moderator decides. Zero never hides automatically.
+	HideThreshold() int64
+}
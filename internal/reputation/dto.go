@@ -0,0 +1,51 @@
+package reputation
+
+type EventDto struct {
+	Id        int64  `json:"id"`
+	Event     Event  `json:"event"`
+	Points    int64  `json:"points"`
+	PostId    int64  `json:"postId"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func (dto EventDto) MapFromModel(model EventModel) EventDto {
+	dto.Id = model.Id
+	dto.Event = model.Event
+	dto.Points = model.Points
+	dto.PostId = model.PostId
+	dto.CreatedAt = model.CreatedAt
+
+	return dto
+}
+
+type GetEventsDto struct {
+	UserId int64 `json:"-"`
+	Limit  uint  `json:"limit"`
+	Offset uint  `json:"offset"`
+}
+
+type RuleDto struct {
+	Event       Event  `json:"event"`
+	Points      int64  `json:"points"`
+	Description string `json:"description"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+func (dto RuleDto) MapFromModel(model RuleModel) RuleDto {
+	dto.Event = model.Event
+	dto.Points = model.Points
+	dto.Description = model.Description
+	dto.UpdatedAt = model.UpdatedAt
+
+	return dto
+}
+
+type RecomputeDto struct {
+	Updated int64 `json:"updated"`
+}
+
+type UpdateRuleDto struct {
+	Event       Event  `json:"-"`
+	Points      int64  `json:"points"`
+	Description string `json:"description"`
+}
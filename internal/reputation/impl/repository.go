@@ -0,0 +1,292 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/reputation"
+)
+
+type ReputationRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewReputationRepository(opts ReputationRepositoryOpts) reputation.ReputationRepository {
+	return &reputationRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type reputationRepository struct {
+	databaseImpl.ConnManager
+}
+
+// AddEvent appends the event to the ledger and adds the points of its rule
+// to the user's reputation. It reports false if an event with the same ref
+// is already there.
+func (r *reputationRepository) AddEvent(ctx context.Context, event reputation.EventModel) (bool, error) {
+	record := databaseImpl.Record{
+		"user_id": event.UserId,
+		"event":   event.Event,
+	}
+	if event.PostId != 0 {
+		record["post_id"] = event.PostId
+	}
+	if event.Ref != "" {
+		record["ref"] = event.Ref
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("reputation_events").
+		Rows(record).
+		OnConflict(goqu.DoNothing()).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&event.Id); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		return false, parseAddEventError(event, err)
+	}
+
+	points := databaseImpl.QueryBuilder.
+		From("reputation_rules").
+		Select("points").
+		Where(databaseImpl.Ex{"event": event.Event})
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Update("users").
+		Set(databaseImpl.Record{"reputation": goqu.L("reputation + ?", points)}).
+		Where(databaseImpl.Ex{"user_id": event.UserId}).
+		ToSQL()
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "add user reputation failed")
+	}
+
+	return true, nil
+}
+
+func (r *reputationRepository) GetEventsByUser(
+	ctx context.Context,
+	userId int64,
+	limit uint,
+	offset uint,
+) ([]reputation.EventModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("reputation_events").
+		Select(
+			"reputation_events.id",
+			"reputation_events.user_id",
+			"reputation_events.event",
+			"reputation_rules.points",
+			"reputation_events.post_id",
+			"reputation_events.ref",
+			"reputation_events.created_at",
+		).
+		InnerJoin(
+			goqu.T("reputation_rules"),
+			goqu.On(goqu.Ex{"reputation_events.event": goqu.I("reputation_rules.event")}),
+		).
+		Where(databaseImpl.Ex{"reputation_events.user_id": userId}).
+		Order(goqu.I("reputation_events.created_at").Desc(), goqu.I("reputation_events.id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reputation events failed")
+	}
+	defer rows.Close()
+
+	var models []reputation.EventModel
+	for rows.Next() {
+		var model reputation.EventModel
+		var postId sqlS.NullInt64
+		var ref sqlS.NullString
+		var createdAt time.Time
+
+		err := rows.Scan(
+			&model.Id,
+			&model.UserId,
+			&model.Event,
+			&model.Points,
+			&postId,
+			&ref,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan reputation event failed")
+		}
+
+		model.PostId = postId.Int64
+		model.Ref = ref.String
+		model.CreatedAt = createdAt.Format(time.RFC3339)
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reputation events failed")
+	}
+
+	return models, nil
+}
+
+func (r *reputationRepository) GetRules(ctx context.Context) ([]reputation.RuleModel, error) {
+	sql, _, err := selectRules().
+		Order(goqu.I("event").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reputation rules failed")
+	}
+	defer rows.Close()
+
+	var models []reputation.RuleModel
+	for rows.Next() {
+		model, err := scanRule(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan reputation rule failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get reputation rules failed")
+	}
+
+	return models, nil
+}
+
+func (r *reputationRepository) GetRule(ctx context.Context, event reputation.Event) (reputation.RuleModel, error) {
+	sql, _, err := selectRules().
+		Where(databaseImpl.Ex{"event": event}).
+		ToSQL()
+	if err != nil {
+		return reputation.RuleModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanRule(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return reputation.RuleModel{}, parseGetRuleError(event, err)
+	}
+
+	return model, nil
+}
+
+func (r *reputationRepository) UpdateRule(ctx context.Context, rule reputation.RuleModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("reputation_rules").
+		Set(databaseImpl.Record{
+			"points":      rule.Points,
+			"description": rule.Description,
+			"updated_at":  goqu.L("CURRENT_TIMESTAMP"),
+		}).
+		Where(databaseImpl.Ex{"event": rule.Event}).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	_, err = r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update reputation rule failed")
+	}
+
+	return nil
+}
+
+// Recompute rebuilds the reputation of every user from the ledger using the
+// current rules and returns the number of updated users.
+func (r *reputationRepository) Recompute(ctx context.Context) (int64, error) {
+	total := databaseImpl.QueryBuilder.
+		From("reputation_events").
+		Select(goqu.COALESCE(goqu.SUM("reputation_rules.points"), 0)).
+		InnerJoin(
+			goqu.T("reputation_rules"),
+			goqu.On(goqu.Ex{"reputation_events.event": goqu.I("reputation_rules.event")}),
+		).
+		Where(goqu.I("reputation_events.user_id").Eq(goqu.I("users.user_id")))
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("users").
+		Set(databaseImpl.Record{"reputation": total}).
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "recompute reputation failed")
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func selectRules() *goqu.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		From("reputation_rules").
+		Select("event", "points", "description", "updated_at")
+}
+
+func scanRule(row pgx.Row) (reputation.RuleModel, error) {
+	var model reputation.RuleModel
+	var updatedAt time.Time
+
+	err := row.Scan(&model.Event, &model.Points, &model.Description, &updatedAt)
+	if err != nil {
+		return reputation.RuleModel{}, err
+	}
+
+	model.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return model, nil
+}
+
+func parseAddEventError(event reputation.EventModel, err error) error {
+	pgErr, isPgErr := err.(*pgconn.PgError)
+
+	if isPgErr && pgErr.Code == pgerrcode.ForeignKeyViolation {
+		switch pgErr.ConstraintName {
+		case "reputation_events_user_id_fkey":
+			return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", event.UserId)
+		case "reputation_events_event_fkey":
+			return errors.Wrapf(err, errors.NotFoundError, "reputation rule \"%s\" not found", event.Event)
+		}
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add reputation event failed")
+}
+
+func parseGetRuleError(event reputation.Event, err error) error {
+	if err == pgx.ErrNoRows {
+		return errors.Wrapf(err, errors.NotFoundError, "reputation rule \"%s\" not found", event)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get reputation rule failed")
+}
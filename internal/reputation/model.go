@@ -0,0 +1,115 @@
+package reputation
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+// Event is something that happened to an author's post and is worth
+// reputation points. How many points is decided by the rule of the event.
+type Event string
+
+const (
+	EventPostLiked       Event = "post_liked"
+	EventPostUnliked     Event = "post_unliked"
+	EventPostApproved    Event = "post_approved"
+	EventCommentReceived Event = "comment_received"
+	EventViewMilestone   Event = "view_milestone"
+	EventReportPenalty   Event = "report_penalty"
+)
+
+var Events = []Event{
+	EventPostLiked,
+	EventPostUnliked,
+	EventPostApproved,
+	EventCommentReceived,
+	EventViewMilestone,
+	EventReportPenalty,
+}
+
+// EventModel is an entry of the append-only reputation ledger. Ref makes an
+// event idempotent: the ledger keeps a single event per event type and ref.
+type EventModel struct {
+	Id        int64
+	UserId    int64
+	Event     Event
+	Points    int64
+	PostId    int64
+	Ref       string
+	CreatedAt string
+}
+
+func NewEvent(userId int64, event Event, postId int64, ref string) (EventModel, error) {
+	model := EventModel{
+		UserId: userId,
+		Event:  event,
+		PostId: postId,
+		Ref:    ref,
+	}
+	if err := model.Validate(); err != nil {
+		return EventModel{}, err
+	}
+
+	return model, nil
+}
+
+func (event *EventModel) Validate() error {
+	err := validation.ValidateStruct(event,
+		validation.Field(&event.UserId, validation.Required),
+		validation.Field(&event.Event, validation.Required, validation.In(eventValues()...)),
+		validation.Field(&event.Ref, validation.Length(0, 100)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// Ref builds the idempotency key of an event caused by the given entity.
+func Ref(kind string, id int64) string {
+	return fmt.Sprintf("%s:%d", kind, id)
+}
+
+// RuleModel defines how many points an event is worth. Changing a rule
+// affects new events right away and older ones on the next recompute.
+type RuleModel struct {
+	Event       Event
+	Points      int64
+	Description string
+	UpdatedAt   string
+}
+
+func (rule *RuleModel) Update(points int64, description string) error {
+	rule.Points = points
+	if len(description) > 0 {
+		rule.Description = description
+	}
+
+	return rule.Validate()
+}
+
+func (rule *RuleModel) Validate() error {
+	err := validation.ValidateStruct(rule,
+		validation.Field(&rule.Event, validation.Required, validation.In(eventValues()...)),
+		validation.Field(&rule.Points, validation.Min(-1000), validation.Max(1000)),
+		validation.Field(&rule.Description, validation.Length(0, 500)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+func eventValues() []interface{} {
+	values := make([]interface{}, 0, len(Events))
+	for _, event := range Events {
+		values = append(values, event)
+	}
+
+	return values
+}
@@ -0,0 +1,14 @@
+//go:generate mockery --name ReputationRepository --filename repository.go --output ./mock --with-expecter
+
+package reputation
+
+import "context"
+
+type ReputationRepository interface {
+	AddEvent(ctx context.Context, event EventModel) (bool, error)
+	GetEventsByUser(ctx context.Context, userId int64, limit, offset uint) ([]EventModel, error)
+	GetRules(ctx context.Context) ([]RuleModel, error)
+	GetRule(ctx context.Context, event Event) (RuleModel, error)
+	UpdateRule(ctx context.Context, rule RuleModel) error
+	Recompute(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,50 @@
+package search
+
+type SearchPostsDto struct {
+	Query      string `json:"q"`
+	CategoryId int64  `json:"category_id"`
+	Limit      uint   `json:"limit"`
+	Offset     uint   `json:"offset"`
+}
+
+func (dto SearchPostsDto) MapToModel() (QueryModel, error) {
+	return NewQuery(dto.Query, dto.CategoryId, dto.Limit, dto.Offset)
+}
+
+// PostResultDto is a found post. Title and Snippet are HTML with matched
+// words wrapped in <mark> tags.
+type PostResultDto struct {
+	PostId         int64   `json:"postId"`
+	Slug           string  `json:"slug"`
+	UserId         int64   `json:"userId"`
+	UserName       string  `json:"userName"`
+	CategoryId     int64   `json:"category_id"`
+	Title          string  `json:"title"`
+	Snippet        string  `json:"snippet"`
+	Rank           float64 `json:"rank"`
+	Likes          int64   `json:"likes"`
+	Views          int64   `json:"views"`
+	WordCount      int64   `json:"wordCount"`
+	ReadingMinutes int64   `json:"readingMinutes"`
+	CodeBlocks     int64   `json:"codeBlocks"`
+	CreatedAt      string  `json:"createdAt"`
+}
+
+func (dto PostResultDto) MapFromModel(model PostResultModel) PostResultDto {
+	dto.PostId = model.PostId
+	dto.Slug = model.Slug
+	dto.UserId = model.UserId
+	dto.UserName = model.UserName
+	dto.CategoryId = model.CategoryId
+	dto.Title = Highlight(model.Title)
+	dto.Snippet = Highlight(model.Snippet)
+	dto.Rank = model.Rank
+	dto.Likes = model.Likes
+	dto.Views = model.Views
+	dto.WordCount = model.WordCount
+	dto.ReadingMinutes = model.ReadingMinutes
+	dto.CodeBlocks = model.CodeBlocks
+	dto.CreatedAt = model.CreatedAt
+
+	return dto
+}
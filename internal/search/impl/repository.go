@@ -0,0 +1,121 @@
+package impl
+
+import (
+	"context"
+	sqlS "database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/post"
+	"fibo/internal/search"
+)
+
+// headlineOptions mark matches with control characters, which are turned
+// into tags once the text is escaped.
+const headlineOptions = "StartSel=" + search.HighlightStart +
+	", StopSel=" + search.HighlightStop +
+	", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" … \""
+
+type SearchRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewSearchRepository(opts SearchRepositoryOpts) search.SearchRepository {
+	return &searchRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type searchRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *searchRepository) SearchPosts(
+	ctx context.Context,
+	query search.QueryModel,
+) ([]search.PostResultModel, error) {
+	tsQuery := goqu.L("websearch_to_tsquery('simple', ?)", query.Text)
+	rank := goqu.L("ts_rank(posts.search_vector, ?)", tsQuery)
+
+	where := goqu.Ex{
+		"posts.state":      post.StatePublished,
+		"posts.deleted_at": nil,
+		"posts.hidden_at":  nil,
+	}
+	if query.CategoryId != 0 {
+		where["posts.category_id"] = query.CategoryId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("posts").
+		Select(
+			"posts.id",
+			"posts.slug",
+			"posts.user_id",
+			"users.firstname",
+			"posts.category_id",
+			goqu.L("ts_headline('simple', posts.title, ?, ?)", tsQuery, headlineOptions),
+			goqu.L("ts_headline('simple', posts.search_text, ?, ?)", tsQuery, headlineOptions),
+			rank,
+			"posts.likes",
+			"posts.views",
+			"posts.word_count",
+			"posts.reading_minutes",
+			"posts.code_blocks",
+			"posts.created_at",
+		).
+		InnerJoin(goqu.T("users"), goqu.On(goqu.Ex{"posts.user_id": goqu.I("users.user_id")})).
+		Where(where, goqu.L("posts.search_vector @@ ?", tsQuery)).
+		Order(rank.Desc(), goqu.I("posts.id").Desc()).
+		Limit(query.Limit).
+		Offset(query.Offset).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error search posts")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "search posts failed")
+	}
+	defer rows.Close()
+
+	results := []search.PostResultModel{}
+	for rows.Next() {
+		var result search.PostResultModel
+		var category sqlS.NullInt64
+		var createdAt time.Time
+
+		err := rows.Scan(
+			&result.PostId,
+			&result.Slug,
+			&result.UserId,
+			&result.UserName,
+			&category,
+			&result.Title,
+			&result.Snippet,
+			&result.Rank,
+			&result.Likes,
+			&result.Views,
+			&result.WordCount,
+			&result.ReadingMinutes,
+			&result.CodeBlocks,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan search result failed")
+		}
+		result.CategoryId = category.Int64
+		result.CreatedAt = createdAt.Format(time.RFC3339)
+
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "search posts failed")
+	}
+
+	return results, nil
+}
@@ -0,0 +1,40 @@
+package impl
+
+import (
+	"context"
+
+	"fibo/internal/search"
+)
+
+type SearchUsecasesOpts struct {
+	SearchRepository search.SearchRepository
+}
+
+func NewSearchUsecases(opts SearchUsecasesOpts) search.SearchUsecases {
+	return &searchUsecases{
+		SearchRepository: opts.SearchRepository,
+	}
+}
+
+type searchUsecases struct {
+	search.SearchRepository
+}
+
+func (u *searchUsecases) SearchPosts(ctx context.Context, in search.SearchPostsDto) (out []search.PostResultDto, err error) {
+	query, err := in.MapToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := u.SearchRepository.SearchPosts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []search.PostResultDto{}
+	for _, result := range results {
+		out = append(out, search.PostResultDto{}.MapFromModel(result))
+	}
+
+	return out, nil
+}
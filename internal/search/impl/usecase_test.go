@@ -0,0 +1,108 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fibo/internal/search"
+
+	searchMock "fibo/internal/search/mock"
+)
+
+func TestSearchUsecases_SearchPosts(t *testing.T) {
+	in := search.SearchPostsDto{
+		Query:      "  golang generics ",
+		CategoryId: 2,
+	}
+	query := search.QueryModel{
+		Text:       "golang generics",
+		CategoryId: 2,
+		Limit:      20,
+	}
+	result := search.PostResultModel{
+		PostId:     7,
+		UserId:     1,
+		UserName:   "Bat",
+		CategoryId: 2,
+		Title:      "\x02Golang\x03 <tips>",
+		Snippet:    "Using \x02generics\x03 & interfaces",
+		Rank:       0.6,
+	}
+
+	t.Run("expect it returns highlighted results", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.searchRepo.EXPECT().SearchPosts(mock.Anything, query).Return([]search.PostResultModel{result}, nil)
+
+		results, err := prep.searchUsecases.SearchPosts(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, int64(7), results[0].PostId)
+		require.Equal(t, "<mark>Golang</mark> &lt;tips&gt;", results[0].Title)
+		require.Equal(t, "Using <mark>generics</mark> &amp; interfaces", results[0].Snippet)
+	})
+
+	t.Run("expect it caps the limit", func(t *testing.T) {
+		prep := newTestPrep()
+		bigIn := in
+		bigIn.Limit = 1000
+		bigQuery := query
+		bigQuery.Limit = 50
+
+		prep.searchRepo.EXPECT().SearchPosts(mock.Anything, bigQuery).Return(nil, nil)
+
+		results, err := prep.searchUsecases.SearchPosts(prep.ctx, bigIn)
+
+		require.NoError(t, err)
+		require.Empty(t, results)
+	})
+
+	t.Run("expect it fails on empty query", func(t *testing.T) {
+		prep := newTestPrep()
+		emptyIn := in
+		emptyIn.Query = "   "
+
+		_, err := prep.searchUsecases.SearchPosts(prep.ctx, emptyIn)
+
+		require.Error(t, err)
+		prep.searchRepo.AssertNotCalled(t, "SearchPosts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if searching fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("search failed")
+
+		prep.searchRepo.EXPECT().SearchPosts(mock.Anything, query).Return(nil, err)
+
+		_, err = prep.searchUsecases.SearchPosts(prep.ctx, in)
+
+		require.Error(t, err)
+	})
+}
+
+type testPrep struct {
+	ctx        context.Context
+	searchRepo *searchMock.SearchRepository
+
+	searchUsecases search.SearchUsecases
+}
+
+func newTestPrep() testPrep {
+	searchRepo := &searchMock.SearchRepository{}
+
+	searchUsecasesOpts := SearchUsecasesOpts{
+		SearchRepository: searchRepo,
+	}
+	searchUsecases := NewSearchUsecases(searchUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		searchRepo:     searchRepo,
+		searchUsecases: searchUsecases,
+	}
+}
@@ -0,0 +1,90 @@
+package search
+
+import (
+	"html"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+const (
+	defaultLimit uint = 20
+	maxLimit     uint = 50
+)
+
+// Highlight delimiters used by the database. They can't occur in post text,
+// so matches are marked up only after the text is escaped.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// QueryModel is a search over published posts. Text follows the web search
+// syntax: quoted phrases, "or" and "-" for excluded words.
+type QueryModel struct {
+	Text       string
+	CategoryId int64
+	Limit      uint
+	Offset     uint
+}
+
+func NewQuery(text string, categoryId int64, limit uint, offset uint) (QueryModel, error) {
+	query := QueryModel{
+		Text:       strings.TrimSpace(text),
+		CategoryId: categoryId,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultLimit
+	}
+	if query.Limit > maxLimit {
+		query.Limit = maxLimit
+	}
+
+	if err := query.Validate(); err != nil {
+		return QueryModel{}, err
+	}
+
+	return query, nil
+}
+
+func (query *QueryModel) Validate() error {
+	err := validation.ValidateStruct(query,
+		validation.Field(&query.Text, validation.Required, validation.Length(2, 200)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// PostResultModel is a post matching the query. Title and Snippet hold the
+// matched words between HighlightStart and HighlightStop.
+type PostResultModel struct {
+	PostId         int64
+	Slug           string
+	UserId         int64
+	UserName       string
+	CategoryId     int64
+	Title          string
+	Snippet        string
+	Rank           float64
+	Likes          int64
+	Views          int64
+	WordCount      int64
+	ReadingMinutes int64
+	CodeBlocks     int64
+	CreatedAt      string
+}
+
+// Highlight escapes the text and wraps matched words in <mark> tags.
+func Highlight(text string) string {
+	text = html.EscapeString(text)
+	text = strings.ReplaceAll(text, HighlightStart, "<mark>")
+
+	return strings.ReplaceAll(text, HighlightStop, "</mark>")
+}
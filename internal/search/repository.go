@@ -0,0 +1,9 @@
+//go:generate mockery --name SearchRepository --filename repository.go --output ./mock --with-expecter
+
+package search
+
+import "context"
+
+type SearchRepository interface {
+	SearchPosts(ctx context.Context, query QueryModel) ([]PostResultModel, error)
+}
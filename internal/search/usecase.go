@@ -0,0 +1,9 @@
+//go:generate mockery --name SearchUsecases --filename usecase.go --output ./mock --with-expecter
+
+package search
+
+import "context"
+
+type SearchUsecases interface {
+	SearchPosts(ctx context.Context, dto SearchPostsDto) ([]PostResultDto, error)
+}
@@ -0,0 +1,52 @@
+package share
+
+import "fibo/internal/user"
+
+// ShareDto is what the frontend needs to render the Open Graph tags of a
+// post and its share buttons.
+type ShareDto struct {
+	PostId      int64     `json:"postId"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	Image       string    `json:"image"`
+	Author      string    `json:"author"`
+	PublishedAt string    `json:"publishedAt"`
+	Links       []LinkDto `json:"links"`
+}
+
+type LinkDto struct {
+	Channel Channel `json:"channel"`
+	URL     string  `json:"url"`
+}
+
+// AddShareDto records a click on a share button.
+type AddShareDto struct {
+	PostId    int64   `json:"-"`
+	UserId    int64   `json:"-"`
+	VisitorId string  `json:"-"`
+	Channel   Channel `json:"channel"`
+}
+
+func (dto AddShareDto) MapToModel() (ShareModel, error) {
+	return NewShare(dto.PostId, dto.Channel, dto.UserId, dto.VisitorId)
+}
+
+type GetShareStatsDto struct {
+	PostId   int64
+	UserId   int64
+	UserRole user.Role
+}
+
+// ShareStatsDto tells the author which channels the post is shared on,
+// every channel included, the most clicked first.
+type ShareStatsDto struct {
+	PostId   int64             `json:"postId"`
+	Total    int64             `json:"total"`
+	Channels []ChannelCountDto `json:"channels"`
+}
+
+type ChannelCountDto struct {
+	Channel Channel `json:"channel"`
+	Clicks  int64   `json:"clicks"`
+}
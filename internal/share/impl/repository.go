@@ -0,0 +1,88 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/share"
+)
+
+type ShareRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewShareRepository(opts ShareRepositoryOpts) share.ShareRepository {
+	return &shareRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type shareRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *shareRepository) Add(ctx context.Context, model share.ShareModel) (int64, error) {
+	record := databaseImpl.Record{
+		"post_id": model.PostId,
+		"channel": model.Channel,
+	}
+	if model.UserId != 0 {
+		record["user_id"] = model.UserId
+	}
+	if model.VisitorId != "" {
+		record["visitor_id"] = model.VisitorId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("post_shares").
+		Rows(record).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add share failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *shareRepository) GetCounts(ctx context.Context, postId int64) ([]share.ChannelCountModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		From("post_shares").
+		Select("channel", goqu.COUNT("*")).
+		Where(goqu.Ex{"post_id": postId}).
+		GroupBy("channel").
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get share counts failed")
+	}
+	defer rows.Close()
+
+	var counts []share.ChannelCountModel
+	for rows.Next() {
+		var count share.ChannelCountModel
+		if err := rows.Scan(&count.Channel, &count.Clicks); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan share count failed")
+		}
+
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get share counts failed")
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,131 @@
+package impl
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/post"
+	"fibo/internal/post/content"
+	"fibo/internal/share"
+	"fibo/internal/user"
+)
+
+// descriptionWords is about how long the description of a shared post is,
+// what fits the preview card of the social networks.
+const descriptionWords = 30
+
+type ShareUsecasesOpts struct {
+	Config          share.Config
+	ShareRepository share.ShareRepository
+	PostRepository  post.PostRepository
+	UserRepository  user.UserRepository
+}
+
+func NewShareUsecases(opts ShareUsecasesOpts) share.ShareUsecases {
+	return &shareUsecases{
+		Config:          opts.Config,
+		ShareRepository: opts.ShareRepository,
+		PostRepository:  opts.PostRepository,
+		UserRepository:  opts.UserRepository,
+	}
+}
+
+type shareUsecases struct {
+	share.Config
+	share.ShareRepository
+	post.PostRepository
+	user.UserRepository
+}
+
+func (u *shareUsecases) GetShare(ctx context.Context, postId int64) (out share.ShareDto, err error) {
+	model, err := u.sharedPost(ctx, postId)
+	if err != nil {
+		return out, err
+	}
+
+	author, err := u.UserRepository.GetById(ctx, model.UserId)
+	if err != nil {
+		return out, err
+	}
+
+	out = share.ShareDto{
+		PostId:      model.Id,
+		Title:       model.Title,
+		Description: content.Summary(model.Content, descriptionWords),
+		URL:         u.PublicURL() + "/posts/" + url.PathEscape(model.Slug),
+		Image:       content.FirstImage(model.Content),
+		Author:      strings.TrimSpace(author.FirstName + " " + author.LastName),
+		PublishedAt: model.PublishAt,
+		Links:       []share.LinkDto{},
+	}
+	for _, channel := range share.Channels {
+		out.Links = append(out.Links, share.LinkDto{
+			Channel: channel,
+			URL:     channel.ShareURL(out.URL, out.Title),
+		})
+	}
+
+	return out, nil
+}
+
+func (u *shareUsecases) AddShare(ctx context.Context, in share.AddShareDto) error {
+	model, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.sharedPost(ctx, model.PostId); err != nil {
+		return err
+	}
+
+	_, err = u.ShareRepository.Add(ctx, model)
+	return err
+}
+
+func (u *shareUsecases) GetStats(ctx context.Context, in share.GetShareStatsDto) (out share.ShareStatsDto, err error) {
+	model, err := u.PostRepository.GetById(ctx, in.PostId)
+	if err != nil {
+		return out, err
+	}
+	if !model.IsAuthor(in.UserId) && !in.UserRole.CanEditAnyPost() {
+		return out, errors.New(errors.ForbiddenError, "only the author can see the shares of a post")
+	}
+
+	counts, err := u.ShareRepository.GetCounts(ctx, model.Id)
+	if err != nil {
+		return out, err
+	}
+
+	clicks := map[share.Channel]int64{}
+	for _, count := range counts {
+		clicks[count.Channel] = count.Clicks
+	}
+
+	out = share.ShareStatsDto{PostId: model.Id, Channels: []share.ChannelCountDto{}}
+	for _, channel := range share.Channels {
+		out.Total += clicks[channel]
+		out.Channels = append(out.Channels, share.ChannelCountDto{Channel: channel, Clicks: clicks[channel]})
+	}
+	sort.SliceStable(out.Channels, func(i, j int) bool {
+		return out.Channels[i].Clicks > out.Channels[j].Clicks
+	})
+
+	return out, nil
+}
+
+// sharedPost returns the post if readers can open it, only those are
+// shared.
+func (u *shareUsecases) sharedPost(ctx context.Context, postId int64) (post.PostModel, error) {
+	model, err := u.PostRepository.GetById(ctx, postId)
+	if err != nil {
+		return post.PostModel{}, err
+	}
+	if !model.IsPublished() || model.IsHidden() {
+		return post.PostModel{}, errors.Errorf(errors.NotFoundError, "post with id \"%d\" not found", postId)
+	}
+
+	return model, nil
+}
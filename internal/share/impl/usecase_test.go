@@ -0,0 +1,190 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/post"
+	"fibo/internal/share"
+	"fibo/internal/user"
+
+	postMock "fibo/internal/post/mock"
+	shareMock "fibo/internal/share/mock"
+	userMock "fibo/internal/user/mock"
+)
+
+func TestShareUsecases_GetShare(t *testing.T) {
+	getPost := post.PostModel{
+		Id:        1,
+		UserId:    2,
+		Title:     "Сайн уу",
+		Slug:      "sain-uu",
+		Content:   `{"blocks":[{"type":"paragraph","data":{"text":"Hello <b>world</b>"}},{"type":"image","data":{"file":{"url":"https://cdn.fibo.mn/a.png"}}}]}`,
+		State:     post.StatePublished,
+		PublishAt: "2026-10-01T10:00:00Z",
+	}
+
+	t.Run("expect it returns the metadata and share links", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getPost.UserId).
+			Return(user.UserModel{Id: 2, FirstName: "Bat", LastName: "Dorj"}, nil)
+		prep.config.EXPECT().PublicURL().Return("https://fibo.mn")
+
+		out, err := prep.shareUsecases.GetShare(prep.ctx, getPost.Id)
+
+		require.NoError(t, err)
+		require.Equal(t, "Сайн уу", out.Title)
+		require.Equal(t, "Hello world", out.Description)
+		require.Equal(t, "https://fibo.mn/posts/sain-uu", out.URL)
+		require.Equal(t, "https://cdn.fibo.mn/a.png", out.Image)
+		require.Equal(t, "Bat Dorj", out.Author)
+		require.Equal(t, "2026-10-01T10:00:00Z", out.PublishedAt)
+		require.Len(t, out.Links, len(share.Channels))
+		require.Equal(t, share.LinkDto{
+			Channel: share.ChannelTwitter,
+			URL:     "https://twitter.com/intent/tweet?url=https%3A%2F%2Ffibo.mn%2Fposts%2Fsain-uu&text=%D0%A1%D0%B0%D0%B9%D0%BD+%D1%83%D1%83",
+		}, out.Links[1])
+		require.Equal(t, share.LinkDto{Channel: share.ChannelLink, URL: out.URL}, out.Links[len(out.Links)-1])
+	})
+
+	t.Run("expect hidden post not to be shared", func(t *testing.T) {
+		prep := newTestPrep()
+		hidden := getPost
+		hidden.HiddenAt = "2026-10-02T10:00:00Z"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(hidden, nil)
+
+		_, err := prep.shareUsecases.GetShare(prep.ctx, getPost.Id)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+	})
+}
+
+func TestShareUsecases_AddShare(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", State: post.StatePublished}
+
+	t.Run("expect it records the click of a visitor", func(t *testing.T) {
+		prep := newTestPrep()
+		visitorId := "5d3b1f43-6f1c-4a57-9a35-0c9a9d0e7a11"
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.shareRepo.EXPECT().Add(mock.Anything, share.ShareModel{
+			PostId:    getPost.Id,
+			Channel:   share.ChannelFacebook,
+			VisitorId: visitorId,
+		}).Return(int64(3), nil)
+
+		err := prep.shareUsecases.AddShare(prep.ctx, share.AddShareDto{
+			PostId:    getPost.Id,
+			VisitorId: visitorId,
+			Channel:   share.ChannelFacebook,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails on unknown channel", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := prep.shareUsecases.AddShare(prep.ctx, share.AddShareDto{PostId: getPost.Id, Channel: "myspace"})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.shareRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect drafts not to be shared", func(t *testing.T) {
+		prep := newTestPrep()
+		draft := getPost
+		draft.State = post.StateDraft
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(draft, nil)
+
+		err := prep.shareUsecases.AddShare(prep.ctx, share.AddShareDto{PostId: getPost.Id, UserId: 5, Channel: share.ChannelLink})
+
+		require.Error(t, err)
+		prep.shareRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestShareUsecases_GetStats(t *testing.T) {
+	getPost := post.PostModel{Id: 1, UserId: 2, Title: "Title", State: post.StatePublished}
+
+	t.Run("expect author sees clicks of every channel", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+		prep.shareRepo.EXPECT().GetCounts(mock.Anything, getPost.Id).Return([]share.ChannelCountModel{
+			{Channel: share.ChannelFacebook, Clicks: 4},
+			{Channel: share.ChannelTelegram, Clicks: 9},
+		}, nil)
+
+		out, err := prep.shareUsecases.GetStats(prep.ctx, share.GetShareStatsDto{PostId: getPost.Id, UserId: getPost.UserId})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(13), out.Total)
+		require.Len(t, out.Channels, len(share.Channels))
+		require.Equal(t, share.ChannelCountDto{Channel: share.ChannelTelegram, Clicks: 9}, out.Channels[0])
+		require.Equal(t, share.ChannelCountDto{Channel: share.ChannelFacebook, Clicks: 4}, out.Channels[1])
+		require.Equal(t, share.ChannelCountDto{Channel: share.ChannelTwitter}, out.Channels[2])
+	})
+
+	t.Run("expect other authors not to see the clicks", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.postRepo.EXPECT().GetById(mock.Anything, getPost.Id).Return(getPost, nil)
+
+		_, err := prep.shareUsecases.GetStats(prep.ctx, share.GetShareStatsDto{
+			PostId:   getPost.Id,
+			UserId:   9,
+			UserRole: user.RoleAuthor,
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ForbiddenError, baseErr.Status())
+	})
+}
+
+type testPrep struct {
+	ctx       context.Context
+	config    *shareMock.Config
+	shareRepo *shareMock.ShareRepository
+	postRepo  *postMock.PostRepository
+	userRepo  *userMock.UserRepository
+
+	shareUsecases share.ShareUsecases
+}
+
+func newTestPrep() testPrep {
+	config := &shareMock.Config{}
+	shareRepo := &shareMock.ShareRepository{}
+	postRepo := &postMock.PostRepository{}
+	userRepo := &userMock.UserRepository{}
+
+	shareUsecasesOpts := ShareUsecasesOpts{
+		Config:          config,
+		ShareRepository: shareRepo,
+		PostRepository:  postRepo,
+		UserRepository:  userRepo,
+	}
+	shareUsecases := NewShareUsecases(shareUsecasesOpts)
+
+	return testPrep{
+		ctx:           context.Background(),
+		config:        config,
+		shareRepo:     shareRepo,
+		postRepo:      postRepo,
+		userRepo:      userRepo,
+		shareUsecases: shareUsecases,
+	}
+}
@@ -0,0 +1,110 @@
+package share
+
+import (
+	"net/url"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+// Channel is where a reader shares a post.
+type Channel string
+
+const (
+	ChannelFacebook Channel = "facebook"
+	ChannelTwitter  Channel = "twitter"
+	ChannelLinkedIn Channel = "linkedin"
+	ChannelTelegram Channel = "telegram"
+	ChannelEmail    Channel = "email"
+	// ChannelLink is a copied link, it has no share URL of its own.
+	ChannelLink Channel = "link"
+)
+
+var Channels = []Channel{
+	ChannelFacebook,
+	ChannelTwitter,
+	ChannelLinkedIn,
+	ChannelTelegram,
+	ChannelEmail,
+	ChannelLink,
+}
+
+// ShareURL is the address that opens the channel ready to share the page.
+func (channel Channel) ShareURL(pageURL string, title string) string {
+	page := url.QueryEscape(pageURL)
+	text := url.QueryEscape(title)
+
+	switch channel {
+	case ChannelFacebook:
+		return "https://www.facebook.com/sharer/sharer.php?u=" + page
+	case ChannelTwitter:
+		return "https://twitter.com/intent/tweet?url=" + page + "&text=" + text
+	case ChannelLinkedIn:
+		return "https://www.linkedin.com/sharing/share-offsite/?url=" + page
+	case ChannelTelegram:
+		return "https://t.me/share/url?url=" + page + "&text=" + text
+	case ChannelEmail:
+		// Mail clients read + literally, spaces have to be %20.
+		return "mailto:?subject=" + url.PathEscape(title) + "&body=" + url.PathEscape(pageURL)
+	default:
+		return pageURL
+	}
+}
+
+// ShareModel is a click on a share button of a user or, when UserId is
+// zero, of a visitor. Clicks are counted as they come, a reader sharing
+// twice counts twice.
+type ShareModel struct {
+	Id        int64
+	PostId    int64
+	Channel   Channel
+	UserId    int64
+	VisitorId string
+	CreatedAt string
+}
+
+func NewShare(postId int64, channel Channel, userId int64, visitorId string) (ShareModel, error) {
+	share := ShareModel{
+		PostId:  postId,
+		Channel: channel,
+		UserId:  userId,
+	}
+	if userId == 0 {
+		share.VisitorId = visitorId
+	}
+
+	if err := share.Validate(); err != nil {
+		return ShareModel{}, err
+	}
+
+	return share, nil
+}
+
+func (share *ShareModel) Validate() error {
+	err := validation.ValidateStruct(share,
+		validation.Field(&share.PostId, validation.Required),
+		validation.Field(&share.Channel, validation.Required, validation.In(channelValues()...)),
+		validation.Field(&share.VisitorId, validation.Length(0, 64)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// ChannelCountModel counts the share clicks of a post on a channel.
+type ChannelCountModel struct {
+	Channel Channel
+	Clicks  int64
+}
+
+func channelValues() []interface{} {
+	values := make([]interface{}, len(Channels))
+	for i, channel := range Channels {
+		values[i] = channel
+	}
+
+	return values
+}
@@ -0,0 +1,10 @@
+//go:generate mockery --name ShareRepository --filename repository.go --output ./mock --with-expecter
+
+package share
+
+import "context"
+
+type ShareRepository interface {
+	Add(ctx context.Context, share ShareModel) (int64, error)
+	GetCounts(ctx context.Context, postId int64) ([]ChannelCountModel, error)
+}
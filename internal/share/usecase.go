@@ -0,0 +1,18 @@
+//go:generate mockery --name ShareUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package share
+
+import "context"
+
+type ShareUsecases interface {
+	GetShare(ctx context.Context, postId int64) (ShareDto, error)
+	AddShare(ctx context.Context, dto AddShareDto) error
+	GetStats(ctx context.Context, dto GetShareStatsDto) (ShareStatsDto, error)
+}
+
+type Config interface {
+	// PublicURL is the address of the site readers open, without a trailing
+	// slash. Shared links point to posts under it.
+	PublicURL() string
+}
@@ -0,0 +1,40 @@
+package sitemap
+
+// GetSitemapDto asks for one file of the sitemap. Page zero is the sitemap
+// itself: all URLs, or the index of the files once they don't fit one.
+type GetSitemapDto struct {
+	Page int
+}
+
+// SitemapDto holds either the URLs of a sitemap file or, for an index, the
+// files to read them from.
+type SitemapDto struct {
+	URLs  []URLDto
+	Files []FileDto
+}
+
+func (dto SitemapDto) IsIndex() bool {
+	return dto.Files != nil
+}
+
+type URLDto struct {
+	Kind    Kind
+	Id      int64
+	Slug    string
+	LastMod string
+}
+
+func (dto URLDto) MapFromModel(model EntryModel) URLDto {
+	return URLDto{
+		Kind:    model.Kind,
+		Id:      model.Id,
+		Slug:    model.Slug,
+		LastMod: model.LastMod,
+	}
+}
+
+// FileDto is a sitemap file of the index, changed at LastMod the latest.
+type FileDto struct {
+	Page    int
+	LastMod string
+}
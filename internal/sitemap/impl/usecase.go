@@ -0,0 +1,139 @@
+package impl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/category"
+	"fibo/internal/post"
+	"fibo/internal/sitemap"
+)
+
+type SitemapUsecasesOpts struct {
+	Config             sitemap.Config
+	Events             event.Bus
+	PostRepository     post.PostRepository
+	CategoryRepository category.CatRepository
+}
+
+// NewSitemapUsecases keeps the generated sitemap until a post goes live or
+// changes. Every replica has a cache of its own and sees the events of its
+// own requests only, so the TTL bounds how stale the others are.
+func NewSitemapUsecases(opts SitemapUsecasesOpts) sitemap.SitemapUsecases {
+	u := &sitemapUsecases{
+		Config:         opts.Config,
+		PostRepository: opts.PostRepository,
+		CatRepository:  opts.CategoryRepository,
+	}
+
+	opts.Events.Subscribe(event.PostPublished, u.invalidate)
+	opts.Events.Subscribe(event.PostChanged, u.invalidate)
+
+	return u
+}
+
+type sitemapUsecases struct {
+	sitemap.Config
+	post.PostRepository
+	category.CatRepository
+
+	mu      sync.Mutex
+	entries []sitemap.EntryModel
+	builtAt time.Time
+}
+
+func (u *sitemapUsecases) GetSitemap(ctx context.Context, in sitemap.GetSitemapDto) (out sitemap.SitemapDto, err error) {
+	entries, err := u.load(ctx)
+	if err != nil {
+		return out, err
+	}
+
+	// A site without content still has its sitemap file, an empty one.
+	pages := sitemap.Pages(entries)
+	if pages == 0 {
+		pages = 1
+	}
+
+	if in.Page == 0 && pages > 1 {
+		for page := 1; page <= pages; page++ {
+			file := sitemap.FileDto{Page: page}
+			for _, entry := range pageOf(entries, page) {
+				file.LastMod = sitemap.Latest(file.LastMod, entry.LastMod)
+			}
+			out.Files = append(out.Files, file)
+		}
+		return out, nil
+	}
+
+	if in.Page < 0 || in.Page > pages {
+		return out, errors.Errorf(errors.NotFoundError, "sitemap page \"%d\" not found", in.Page)
+	}
+
+	page := in.Page
+	if page == 0 {
+		page = 1
+	}
+
+	out.URLs = []sitemap.URLDto{}
+	for _, entry := range pageOf(entries, page) {
+		out.URLs = append(out.URLs, sitemap.URLDto{}.MapFromModel(entry))
+	}
+
+	return out, nil
+}
+
+// load returns the cached entries, generating them again once they expire.
+// Requests wait for a single generation instead of running their own.
+func (u *sitemapUsecases) load(ctx context.Context) ([]sitemap.EntryModel, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	now := time.Now().UTC()
+	if !u.builtAt.IsZero() && now.Sub(u.builtAt) < u.CacheTTL() {
+		return u.entries, nil
+	}
+
+	categories, err := u.CatRepository.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := u.PostRepository.GetPublishedEntries(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	u.entries = sitemap.NewEntries(categories, posts)
+	u.builtAt = now
+
+	return u.entries, nil
+}
+
+// invalidate drops the cache. It runs inside the transaction of the change,
+// so a sitemap generated before the commit can still miss it until the TTL.
+func (u *sitemapUsecases) invalidate(ctx context.Context, e event.Event) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.builtAt = time.Time{}
+
+	return nil
+}
+
+// pageOf returns the entries of the sitemap file, numbered from one.
+func pageOf(entries []sitemap.EntryModel, page int) []sitemap.EntryModel {
+	from := (page - 1) * sitemap.MaxURLs
+	if from >= len(entries) {
+		return nil
+	}
+
+	to := from + sitemap.MaxURLs
+	if to > len(entries) {
+		to = len(entries)
+	}
+
+	return entries[from:to]
+}
@@ -0,0 +1,153 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/category"
+	"fibo/internal/post"
+	"fibo/internal/sitemap"
+
+	eventMock "fibo/internal/base/event/mock"
+	categoryMock "fibo/internal/category/mock"
+	postMock "fibo/internal/post/mock"
+	sitemapMock "fibo/internal/sitemap/mock"
+)
+
+func TestSitemapUsecases_GetSitemap(t *testing.T) {
+	categories := []category.CategoryModel{{Id: 1, Name: "Tech"}, {Id: 2, Name: "Sport"}}
+	posts := []post.EntryModel{
+		{Id: 3, UserId: 7, CategoryId: 1, Slug: "first", UpdatedAt: "2026-10-01T10:00:00Z"},
+		{Id: 4, UserId: 7, CategoryId: 1, Slug: "second", UpdatedAt: "2026-10-03T10:00:00Z"},
+		{Id: 5, UserId: 8, Slug: "third", UpdatedAt: "2026-10-02T10:00:00Z"},
+	}
+
+	t.Run("expect it lists categories, authors and posts", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().CacheTTL().Return(time.Hour)
+		prep.catRepo.EXPECT().GetCategories(mock.Anything).Return(categories, nil)
+		prep.postRepo.EXPECT().GetPublishedEntries(mock.Anything, mock.Anything).Return(posts, nil)
+
+		out, err := prep.sitemapUsecases.GetSitemap(prep.ctx, sitemap.GetSitemapDto{})
+
+		require.NoError(t, err)
+		require.False(t, out.IsIndex())
+		require.Equal(t, []sitemap.URLDto{
+			{Kind: sitemap.KindCategory, Id: 1, LastMod: "2026-10-03T10:00:00Z"},
+			{Kind: sitemap.KindCategory, Id: 2},
+			{Kind: sitemap.KindAuthor, Id: 7, LastMod: "2026-10-03T10:00:00Z"},
+			{Kind: sitemap.KindAuthor, Id: 8, LastMod: "2026-10-02T10:00:00Z"},
+			{Kind: sitemap.KindPost, Id: 3, Slug: "first", LastMod: "2026-10-01T10:00:00Z"},
+			{Kind: sitemap.KindPost, Id: 4, Slug: "second", LastMod: "2026-10-03T10:00:00Z"},
+			{Kind: sitemap.KindPost, Id: 5, Slug: "third", LastMod: "2026-10-02T10:00:00Z"},
+		}, out.URLs)
+	})
+
+	t.Run("expect it serves the cache until a post changes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().CacheTTL().Return(time.Hour)
+		prep.catRepo.EXPECT().GetCategories(mock.Anything).Return(categories, nil).Times(2)
+		prep.postRepo.EXPECT().GetPublishedEntries(mock.Anything, mock.Anything).Return(posts, nil).Times(2)
+
+		_, err := prep.sitemapUsecases.GetSitemap(prep.ctx, sitemap.GetSitemapDto{})
+		require.NoError(t, err)
+		_, err = prep.sitemapUsecases.GetSitemap(prep.ctx, sitemap.GetSitemapDto{})
+		require.NoError(t, err)
+		prep.postRepo.AssertNumberOfCalls(t, "GetPublishedEntries", 1)
+
+		err = prep.handlers[event.PostChanged](prep.ctx, event.Event{Name: event.PostChanged, PostId: 3})
+		require.NoError(t, err)
+
+		_, err = prep.sitemapUsecases.GetSitemap(prep.ctx, sitemap.GetSitemapDto{})
+		require.NoError(t, err)
+		prep.postRepo.AssertNumberOfCalls(t, "GetPublishedEntries", 2)
+	})
+
+	t.Run("expect an index once the urls do not fit one file", func(t *testing.T) {
+		prep := newTestPrep()
+		many := make([]post.EntryModel, sitemap.MaxURLs)
+		for i := range many {
+			many[i] = post.EntryModel{Id: int64(i + 1), UserId: 7, Slug: "post", UpdatedAt: "2026-10-01T10:00:00Z"}
+		}
+		many[len(many)-1].UpdatedAt = "2026-10-05T10:00:00Z"
+
+		prep.config.EXPECT().CacheTTL().Return(time.Hour)
+		prep.catRepo.EXPECT().GetCategories(mock.Anything).Return(categories, nil)
+		prep.postRepo.EXPECT().GetPublishedEntries(mock.Anything, mock.Anything).Return(many, nil)
+
+		index, err := prep.sitemapUsecases.GetSitemap(prep.ctx, sitemap.GetSitemapDto{})
+		require.NoError(t, err)
+		// The author of the last post is in the first file.
+		require.Equal(t, []sitemap.FileDto{
+			{Page: 1, LastMod: "2026-10-05T10:00:00Z"},
+			{Page: 2, LastMod: "2026-10-05T10:00:00Z"},
+		}, index.Files)
+
+		// Two categories and an author come first, so the last three posts
+		// move to the second file.
+		second, err := prep.sitemapUsecases.GetSitemap(prep.ctx, sitemap.GetSitemapDto{Page: 2})
+		require.NoError(t, err)
+		require.Len(t, second.URLs, 3)
+		require.Equal(t, int64(sitemap.MaxURLs), second.URLs[2].Id)
+	})
+
+	t.Run("expect it fails on a page past the end", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().CacheTTL().Return(time.Hour)
+		prep.catRepo.EXPECT().GetCategories(mock.Anything).Return(categories, nil)
+		prep.postRepo.EXPECT().GetPublishedEntries(mock.Anything, mock.Anything).Return(posts, nil)
+
+		_, err := prep.sitemapUsecases.GetSitemap(prep.ctx, sitemap.GetSitemapDto{Page: 2})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+	})
+}
+
+type testPrep struct {
+	ctx      context.Context
+	config   *sitemapMock.Config
+	postRepo *postMock.PostRepository
+	catRepo  *categoryMock.CatRepository
+	handlers map[event.Name]event.Handler
+
+	sitemapUsecases sitemap.SitemapUsecases
+}
+
+func newTestPrep() testPrep {
+	config := &sitemapMock.Config{}
+	postRepo := &postMock.PostRepository{}
+	catRepo := &categoryMock.CatRepository{}
+	events := &eventMock.Bus{}
+	handlers := map[event.Name]event.Handler{}
+
+	events.EXPECT().Subscribe(mock.Anything, mock.Anything).
+		Run(func(name event.Name, handler event.Handler) { handlers[name] = handler })
+
+	sitemapUsecasesOpts := SitemapUsecasesOpts{
+		Config:             config,
+		Events:             events,
+		PostRepository:     postRepo,
+		CategoryRepository: catRepo,
+	}
+	sitemapUsecases := NewSitemapUsecases(sitemapUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		config:          config,
+		postRepo:        postRepo,
+		catRepo:         catRepo,
+		handlers:        handlers,
+		sitemapUsecases: sitemapUsecases,
+	}
+}
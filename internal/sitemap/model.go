@@ -0,0 +1,86 @@
+package sitemap
+
+import (
+	"time"
+
+	"fibo/internal/category"
+	"fibo/internal/post"
+)
+
+// MaxURLs is how many URLs search engines read from one sitemap file.
+// Longer sitemaps are split into files listed by a sitemap index.
+const MaxURLs = 50000
+
+// Kind is the page of the site a sitemap entry points to.
+type Kind string
+
+const (
+	KindPost     Kind = "post"
+	KindCategory Kind = "category"
+	KindAuthor   Kind = "author"
+)
+
+// EntryModel is a page of the site search engines should crawl. LastMod is
+// empty when nothing tells when the page changed.
+type EntryModel struct {
+	Kind    Kind
+	Id      int64
+	Slug    string
+	LastMod string
+}
+
+// NewEntries lists the categories, then the authors of the posts and then
+// the posts themselves. A category or an author changes whenever one of its
+// posts does.
+func NewEntries(categories []category.CategoryModel, posts []post.EntryModel) []EntryModel {
+	categoryLastMod := map[int64]string{}
+	authorLastMod := map[int64]string{}
+	var authors []int64
+
+	entries := make([]EntryModel, 0, len(categories)+len(posts))
+	for _, p := range posts {
+		if p.CategoryId != 0 {
+			categoryLastMod[p.CategoryId] = Latest(categoryLastMod[p.CategoryId], p.UpdatedAt)
+		}
+		if _, ok := authorLastMod[p.UserId]; !ok {
+			authors = append(authors, p.UserId)
+		}
+		authorLastMod[p.UserId] = Latest(authorLastMod[p.UserId], p.UpdatedAt)
+	}
+
+	for _, c := range categories {
+		entries = append(entries, EntryModel{Kind: KindCategory, Id: c.Id, LastMod: categoryLastMod[c.Id]})
+	}
+	for _, authorId := range authors {
+		entries = append(entries, EntryModel{Kind: KindAuthor, Id: authorId, LastMod: authorLastMod[authorId]})
+	}
+	for _, p := range posts {
+		entries = append(entries, EntryModel{Kind: KindPost, Id: p.Id, Slug: p.Slug, LastMod: p.UpdatedAt})
+	}
+
+	return entries
+}
+
+// Pages is how many sitemap files the entries take.
+func Pages(entries []EntryModel) int {
+	return (len(entries) + MaxURLs - 1) / MaxURLs
+}
+
+// Latest returns the later of two RFC3339 times. Empty or malformed values
+// lose.
+func Latest(a string, b string) string {
+	aTime, aErr := time.Parse(time.RFC3339, a)
+	bTime, bErr := time.Parse(time.RFC3339, b)
+
+	switch {
+	case bErr != nil:
+		if aErr != nil {
+			return ""
+		}
+		return a
+	case aErr != nil || bTime.After(aTime):
+		return b
+	default:
+		return a
+	}
+}
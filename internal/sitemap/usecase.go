@@ -0,0 +1,19 @@
+//go:generate mockery --name SitemapUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package sitemap
+
+import (
+	"context"
+	"time"
+)
+
+type SitemapUsecases interface {
+	GetSitemap(ctx context.Context, dto GetSitemapDto) (SitemapDto, error)
+}
+
+type Config interface {
+	// CacheTTL is how long a generated sitemap is served before it is
+	// generated again, even when no change of content was seen.
+	CacheTTL() time.Duration
+}
@@ -0,0 +1,28 @@
+package tag
+
+type TagDto struct {
+	Id    int64  `json:"id"`
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Posts int64  `json:"posts"`
+}
+
+func (dto TagDto) MapFromModel(model TagModel) TagDto {
+	dto.Id = model.Id
+	dto.Name = model.Name
+	dto.Slug = model.Slug
+	dto.Posts = model.Posts
+
+	return dto
+}
+
+// GetTagsDto lists tags. A prefix turns it into autocompletion of what the
+// author is typing.
+type GetTagsDto struct {
+	Prefix string `json:"prefix"`
+	Limit  uint   `json:"limit"`
+}
+
+func (dto GetTagsDto) MapToModel() ListTagsModel {
+	return NewListTags(dto.Prefix, dto.Limit)
+}
@@ -0,0 +1,195 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v4"
+
+	databaseImpl "fibo/internal/base/database/impl"
+	"fibo/internal/base/errors"
+	"fibo/internal/post"
+	"fibo/internal/tag"
+)
+
+type TagRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewTagRepository(opts TagRepositoryOpts) tag.TagRepository {
+	return &tagRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type tagRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *tagRepository) Save(ctx context.Context, tags []tag.TagModel) ([]tag.TagModel, error) {
+	if len(tags) == 0 {
+		return []tag.TagModel{}, nil
+	}
+
+	rows := make([]interface{}, 0, len(tags))
+	for _, model := range tags {
+		rows = append(rows, goqu.Record{"name": model.Name, "slug": model.Slug})
+	}
+
+	// Updating the slug of an existing tag to itself makes it returned
+	// along with the new ones.
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("tags").
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("slug", goqu.Record{"slug": goqu.L("EXCLUDED.slug")})).
+		Returning("id", "name", "slug").
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	result, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "save tags failed")
+	}
+	defer result.Close()
+
+	saved := []tag.TagModel{}
+	for result.Next() {
+		var model tag.TagModel
+		if err := result.Scan(&model.Id, &model.Name, &model.Slug); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan tag failed")
+		}
+
+		saved = append(saved, model)
+	}
+	if err := result.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "save tags failed")
+	}
+
+	return saved, nil
+}
+
+func (r *tagRepository) SetPostTags(ctx context.Context, postId int64, tagIds []int64) error {
+	where := goqu.Ex{"post_id": postId}
+	if len(tagIds) > 0 {
+		where["tag_id"] = goqu.Op{"notIn": tagIds}
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("post_tags").
+		Where(where).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "remove post tags failed")
+	}
+
+	if len(tagIds) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(tagIds))
+	for _, tagId := range tagIds {
+		rows = append(rows, goqu.Record{"post_id": postId, "tag_id": tagId})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("post_tags").
+		Rows(rows...).
+		OnConflict(goqu.DoNothing()).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add post tags failed")
+	}
+
+	return nil
+}
+
+func (r *tagRepository) GetBySlug(ctx context.Context, slug string) (tag.TagModel, error) {
+	sql, _, err := selectTags().
+		Where(goqu.Ex{"tags.slug": slug}).
+		ToSQL()
+	if err != nil {
+		return tag.TagModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanTag(r.Conn(ctx).QueryRow(ctx, sql))
+	if err == pgx.ErrNoRows {
+		return tag.TagModel{}, errors.Wrapf(err, errors.NotFoundError, "tag \"%s\" not found", slug)
+	}
+	if err != nil {
+		return tag.TagModel{}, errors.Wrap(err, errors.DatabaseError, "scan tag failed")
+	}
+
+	return model, nil
+}
+
+func (r *tagRepository) GetTags(ctx context.Context, list tag.ListTagsModel) ([]tag.TagModel, error) {
+	query := selectTags().
+		Order(goqu.L("COUNT(posts.id)").Desc(), goqu.I("tags.slug").Asc()).
+		Limit(list.Limit)
+	if list.Prefix != "" {
+		query = query.Where(goqu.I("tags.slug").Like(list.Prefix + "%"))
+	}
+
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get tags failed")
+	}
+	defer rows.Close()
+
+	tags := []tag.TagModel{}
+	for rows.Next() {
+		model, err := scanTag(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "scan tag failed")
+		}
+
+		tags = append(tags, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get tags failed")
+	}
+
+	return tags, nil
+}
+
+// selectTags selects tags with the number of published posts using them.
+func selectTags() *goqu.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		From("tags").
+		Select("tags.id", "tags.name", "tags.slug", goqu.COUNT("posts.id"), "tags.created_at").
+		LeftJoin(goqu.T("post_tags"), goqu.On(goqu.Ex{"post_tags.tag_id": goqu.I("tags.id")})).
+		LeftJoin(goqu.T("posts"), goqu.On(goqu.Ex{
+			"posts.id":         goqu.I("post_tags.post_id"),
+			"posts.state":      post.StatePublished,
+			"posts.deleted_at": nil,
+		})).
+		GroupBy("tags.id")
+}
+
+func scanTag(row pgx.Row) (tag.TagModel, error) {
+	var model tag.TagModel
+	var createdAt time.Time
+
+	if err := row.Scan(&model.Id, &model.Name, &model.Slug, &model.Posts, &createdAt); err != nil {
+		return tag.TagModel{}, err
+	}
+	model.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return model, nil
+}
@@ -0,0 +1,44 @@
+package impl
+
+import (
+	"context"
+
+	"fibo/internal/tag"
+)
+
+type TagUsecasesOpts struct {
+	TagRepository tag.TagRepository
+}
+
+func NewTagUsecases(opts TagUsecasesOpts) tag.TagUsecases {
+	return &tagUsecases{
+		TagRepository: opts.TagRepository,
+	}
+}
+
+type tagUsecases struct {
+	tag.TagRepository
+}
+
+func (u *tagUsecases) GetTags(ctx context.Context, in tag.GetTagsDto) (out []tag.TagDto, err error) {
+	tags, err := u.TagRepository.GetTags(ctx, in.MapToModel())
+	if err != nil {
+		return nil, err
+	}
+
+	out = []tag.TagDto{}
+	for _, model := range tags {
+		out = append(out, tag.TagDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *tagUsecases) GetTag(ctx context.Context, slug string) (tag.TagDto, error) {
+	model, err := u.TagRepository.GetBySlug(ctx, tag.Slugify(slug))
+	if err != nil {
+		return tag.TagDto{}, err
+	}
+
+	return tag.TagDto{}.MapFromModel(model), nil
+}
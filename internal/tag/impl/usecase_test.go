@@ -0,0 +1,94 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/tag"
+
+	tagMock "fibo/internal/tag/mock"
+)
+
+func TestTagUsecases_GetTags(t *testing.T) {
+	goTag := tag.TagModel{Id: 3, Name: "go", Slug: "go", Posts: 12}
+	webTag := tag.TagModel{Id: 8, Name: "go web", Slug: "go-web", Posts: 4}
+
+	t.Run("expect it completes the prefix", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().GetTags(mock.Anything, tag.ListTagsModel{Prefix: "go", Limit: 20}).
+			Return([]tag.TagModel{goTag, webTag}, nil)
+
+		tags, err := prep.tagUsecases.GetTags(prep.ctx, tag.GetTagsDto{Prefix: "#Go"})
+
+		require.NoError(t, err)
+		require.Equal(t, []tag.TagDto{
+			{Id: 3, Name: "go", Slug: "go", Posts: 12},
+			{Id: 8, Name: "go web", Slug: "go-web", Posts: 4},
+		}, tags)
+	})
+
+	t.Run("expect it caps the limit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().GetTags(mock.Anything, tag.ListTagsModel{Limit: 100}).Return(nil, nil)
+
+		tags, err := prep.tagUsecases.GetTags(prep.ctx, tag.GetTagsDto{Limit: 1000})
+
+		require.NoError(t, err)
+		require.Empty(t, tags)
+	})
+}
+
+func TestTagUsecases_GetTag(t *testing.T) {
+	t.Run("expect it finds the tag by its slug", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, "web-dev").
+			Return(tag.TagModel{Id: 8, Name: "web dev", Slug: "web-dev", Posts: 4}, nil)
+
+		tagDto, err := prep.tagUsecases.GetTag(prep.ctx, "Web Dev")
+
+		require.NoError(t, err)
+		require.Equal(t, tag.TagDto{Id: 8, Name: "web dev", Slug: "web-dev", Posts: 4}, tagDto)
+	})
+
+	t.Run("expect it fails on unknown tag", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, "rust").
+			Return(tag.TagModel{}, baseErrors.New(baseErrors.NotFoundError, "tag not found"))
+
+		_, err := prep.tagUsecases.GetTag(prep.ctx, "rust")
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.NotFoundError, baseErr.Status())
+	})
+}
+
+type testPrep struct {
+	ctx     context.Context
+	tagRepo *tagMock.TagRepository
+
+	tagUsecases tag.TagUsecases
+}
+
+func newTestPrep() testPrep {
+	tagRepo := &tagMock.TagRepository{}
+
+	tagUsecasesOpts := TagUsecasesOpts{
+		TagRepository: tagRepo,
+	}
+	tagUsecases := NewTagUsecases(tagUsecasesOpts)
+
+	return testPrep{
+		ctx:         context.Background(),
+		tagRepo:     tagRepo,
+		tagUsecases: tagUsecases,
+	}
+}
@@ -0,0 +1,126 @@
+package tag
+
+import (
+	"strings"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"fibo/internal/base/errors"
+)
+
+const (
+	// MaxPostTags is how many tags a post can have.
+	MaxPostTags = 10
+
+	maxNameLength = 40
+
+	defaultListLimit uint = 20
+	maxListLimit     uint = 100
+)
+
+// TagModel is a topic posts are marked with. Tags are told apart by their
+// slug, so "Go lang" and "go-lang" are the same tag.
+type TagModel struct {
+	Id   int64
+	Name string
+	Slug string
+	// Posts is the number of published posts with the tag.
+	Posts     int64
+	CreatedAt string
+}
+
+// NewTag normalizes the name: a leading "#" is dropped, the name is lower
+// cased and its whitespace collapsed.
+func NewTag(name string) (TagModel, error) {
+	name = strings.ToLower(strings.Join(strings.Fields(strings.TrimPrefix(strings.TrimSpace(name), "#")), " "))
+
+	tag := TagModel{
+		Name: name,
+		Slug: Slugify(name),
+	}
+	if err := tag.Validate(); err != nil {
+		return TagModel{}, err
+	}
+
+	return tag, nil
+}
+
+// NewTags builds the tags of a post, dropping duplicates.
+func NewTags(names []string) ([]TagModel, error) {
+	tags := []TagModel{}
+	seen := map[string]bool{}
+
+	for _, name := range names {
+		tag, err := NewTag(name)
+		if err != nil {
+			return nil, err
+		}
+		if seen[tag.Slug] {
+			continue
+		}
+		seen[tag.Slug] = true
+
+		tags = append(tags, tag)
+	}
+
+	if len(tags) > MaxPostTags {
+		return nil, errors.Errorf(errors.ValidationError, "tags: a post can have at most %d tags.", MaxPostTags)
+	}
+
+	return tags, nil
+}
+
+func (tag *TagModel) Validate() error {
+	err := validation.ValidateStruct(tag,
+		validation.Field(&tag.Name, validation.Required, validation.RuneLength(1, maxNameLength)),
+		validation.Field(&tag.Slug, validation.Required.Error("must contain letters or digits")),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// Slugify makes the URL part of a tag: letters and digits of any script are
+// kept, everything else becomes a single dash.
+func Slugify(name string) string {
+	var slug strings.Builder
+	dash := false
+
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && slug.Len() > 0 {
+				slug.WriteRune('-')
+			}
+			slug.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	return slug.String()
+}
+
+// ListTagsModel selects tags by the prefix of their slug, most used first.
+type ListTagsModel struct {
+	Prefix string
+	Limit  uint
+}
+
+func NewListTags(prefix string, limit uint) ListTagsModel {
+	list := ListTagsModel{
+		Prefix: Slugify(prefix),
+		Limit:  limit,
+	}
+	if list.Limit == 0 {
+		list.Limit = defaultListLimit
+	}
+	if list.Limit > maxListLimit {
+		list.Limit = maxListLimit
+	}
+
+	return list
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name TagRepository --filename repository.go --output ./mock --with-expecter
+
+package tag
+
+import "context"
+
+type TagRepository interface {
+	// Save stores new tags and returns all of them with their ids.
+	Save(ctx context.Context, tags []TagModel) ([]TagModel, error)
+	SetPostTags(ctx context.Context, postId int64, tagIds []int64) error
+	GetBySlug(ctx context.Context, slug string) (TagModel, error)
+	GetTags(ctx context.Context, list ListTagsModel) ([]TagModel, error)
+}
@@ -0,0 +1,10 @@
+//go:generate mockery --name TagUsecases --filename usecase.go --output ./mock --with-expecter
+
+package tag
+
+import "context"
+
+type TagUsecases interface {
+	GetTags(ctx context.Context, dto GetTagsDto) ([]TagDto, error)
+	GetTag(ctx context.Context, slug string) (TagDto, error)
+}
@@ -1,11 +1,15 @@
 package user
 
 type UserDto struct {
-	Id         int64  `json:"id"`
-	FirstName  string `json:"firstName"`
-	LastName   string `json:"lastName"`
-	Email      string `json:"email"`
-	Reputation int64  `json:"reputation"`
+	Id            int64  `json:"id"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	Email         string `json:"email"`
+	Reputation    int64  `json:"reputation"`
+	Role          Role   `json:"role"`
+	Followers     int64  `json:"followers"`
+	Following     int64  `json:"following"`
+	EmailVerified bool   `json:"emailVerified"`
 }
 
 func (dto UserDto) MapFromModel(user UserModel) UserDto {
@@ -14,16 +18,19 @@ func (dto UserDto) MapFromModel(user UserModel) UserDto {
 	dto.LastName = user.LastName
 	dto.Email = user.Email
 	dto.Reputation = user.Reputation
+	dto.Role = user.Role
+	dto.Followers = user.Followers
+	dto.Following = user.Following
+	dto.EmailVerified = user.IsEmailVerified()
 
 	return dto
 }
 
 type AddUserDto struct {
-	FirstName  string `json:"firstName"`
-	LastName   string `json:"lastName"`
-	Email      string `json:"email"`
-	Password   string `json:"password"`
-	Reputation int64  `json:"reputation"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
 }
 
 func (dto AddUserDto) MapToModel() (UserModel, error) {
@@ -32,7 +39,6 @@ func (dto AddUserDto) MapToModel() (UserModel, error) {
 		dto.LastName,
 		dto.Email,
 		dto.Password,
-		dto.Reputation,
 	)
 }
 
@@ -47,3 +53,23 @@ type ChangeUserPasswordDto struct {
 	Id       int64  `json:"id"`
 	Password string `json:"password"`
 }
+
+type VerifyEmailDto struct {
+	Token string `json:"token"`
+}
+
+// RequestPasswordResetDto asks for a link to choose a new password sent to
+// the address.
+type RequestPasswordResetDto struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordDto struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type ChangeUserRoleDto struct {
+	Id   int64 `json:"-"`
+	Role Role  `json:"role"`
+}
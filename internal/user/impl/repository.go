@@ -2,7 +2,8 @@ package impl
 
 import (
 	"context"
-	"fmt"
+	sqlS "database/sql"
+	"time"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
@@ -35,6 +36,10 @@ func (r *userRepository) GetAllUsers(ctx context.Context) ([]user.UserModel, err
 			"email",
 			"password",
 			"reputation",
+			"role",
+			"followers",
+			"following",
+			"email_verified_at",
 		).
 		From("users").
 		ToSQL()
@@ -52,6 +57,7 @@ func (r *userRepository) GetAllUsers(ctx context.Context) ([]user.UserModel, err
 
 	for rows.Next() {
 		var model user.UserModel
+		var emailVerifiedAt sqlS.NullTime
 		err = rows.Scan(
 			&model.Id,
 			&model.FirstName,
@@ -59,27 +65,34 @@ func (r *userRepository) GetAllUsers(ctx context.Context) ([]user.UserModel, err
 			&model.Email,
 			&model.Password,
 			&model.Reputation,
+			&model.Role,
+			&model.Followers,
+			&model.Following,
+			&emailVerifiedAt,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, errors.DatabaseError, "scan user failed")
 		}
+		model.EmailVerifiedAt = formatVerifiedAt(emailVerifiedAt)
 
 		models = append(models, model)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "get all users failed")
+	}
 
 	return models, nil
 }
 
 func (r *userRepository) Add(ctx context.Context, model user.UserModel) (int64, error) {
-	fmt.Printf("Add user: %+v\n", model)
 	sql, _, err := databaseImpl.QueryBuilder.
 		Insert("users").
 		Rows(databaseImpl.Record{
-			"firstname":  model.FirstName,
-			"lastname":   model.LastName,
-			"email":      model.Email,
-			"password":   model.Password,
-			"reputation": model.Reputation,
+			"firstname": model.FirstName,
+			"lastname":  model.LastName,
+			"email":     model.Email,
+			"password":  model.Password,
+			"role":      model.Role,
 		}).
 		Returning("user_id").
 		ToSQL()
@@ -100,11 +113,12 @@ func (r *userRepository) Update(ctx context.Context, model user.UserModel) (int6
 	sql, _, err := databaseImpl.QueryBuilder.
 		Update("users").
 		Set(databaseImpl.Record{
-			"firstname":  model.FirstName,
-			"lastname":   model.LastName,
-			"email":      model.Email,
-			"password":   model.Password,
-			"reputation": model.Reputation,
+			"firstname":         model.FirstName,
+			"lastname":          model.LastName,
+			"email":             model.Email,
+			"password":          model.Password,
+			"role":              model.Role,
+			"email_verified_at": nullTime(model.EmailVerifiedAt),
 		}).
 		Where(databaseImpl.Ex{"user_id": model.Id}).
 		Returning("user_id").
@@ -130,6 +144,10 @@ func (r *userRepository) GetById(ctx context.Context, userId int64) (user.UserMo
 			"email",
 			"password",
 			"reputation",
+			"role",
+			"followers",
+			"following",
+			"email_verified_at",
 		).
 		From("users").
 		Where(databaseImpl.Ex{"user_id": userId}).
@@ -141,6 +159,7 @@ func (r *userRepository) GetById(ctx context.Context, userId int64) (user.UserMo
 	row := r.Conn(ctx).QueryRow(ctx, sql)
 
 	model := user.UserModel{Id: userId}
+	var emailVerifiedAt sqlS.NullTime
 
 	err = row.Scan(
 		&model.FirstName,
@@ -148,10 +167,15 @@ func (r *userRepository) GetById(ctx context.Context, userId int64) (user.UserMo
 		&model.Email,
 		&model.Password,
 		&model.Reputation,
+		&model.Role,
+		&model.Followers,
+		&model.Following,
+		&emailVerifiedAt,
 	)
 	if err != nil {
 		return user.UserModel{}, parseGetUserByIdError(userId, err)
 	}
+	model.EmailVerifiedAt = formatVerifiedAt(emailVerifiedAt)
 
 	return model, nil
 }
@@ -164,6 +188,10 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (user.Use
 			"lastname",
 			"password",
 			"reputation",
+			"role",
+			"followers",
+			"following",
+			"email_verified_at",
 		).
 		From("users").
 		Where(databaseImpl.Ex{"email": email}).
@@ -175,6 +203,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (user.Use
 	row := r.Conn(ctx).QueryRow(ctx, sql)
 
 	model := user.UserModel{Email: email}
+	var emailVerifiedAt sqlS.NullTime
 
 	err = row.Scan(
 		&model.Id,
@@ -182,14 +211,36 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (user.Use
 		&model.LastName,
 		&model.Password,
 		&model.Reputation,
+		&model.Role,
+		&model.Followers,
+		&model.Following,
+		&emailVerifiedAt,
 	)
 	if err != nil {
 		return user.UserModel{}, parseGetUserByEmailError(email, err)
 	}
+	model.EmailVerifiedAt = formatVerifiedAt(emailVerifiedAt)
 
 	return model, nil
 }
 
+func formatVerifiedAt(verifiedAt sqlS.NullTime) string {
+	if !verifiedAt.Valid {
+		return ""
+	}
+
+	return verifiedAt.Time.UTC().Format(time.RFC3339)
+}
+
+// nullTime stores an empty RFC3339 time as NULL.
+func nullTime(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
 func parseAddUserError(user *user.UserModel, err error) error {
 	pgError, isPgError := err.(*pgconn.PgError)
 
@@ -2,30 +2,48 @@ package impl
 
 import (
 	"context"
+	"time"
 
 	"fibo/internal/base/crypto"
 	"fibo/internal/base/database"
+	"fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/reputation"
 	"fibo/internal/user"
 )
 
+const (
+	defaultHistoryLimit uint = 20
+	maxHistoryLimit     uint = 100
+)
+
 type UserUsecasesOpts struct {
-	TxManager      database.TxManager
-	UserRepository user.UserRepository
-	Crypto         crypto.Crypto
+	TxManager            database.TxManager
+	Events               event.Bus
+	UserRepository       user.UserRepository
+	ReputationRepository reputation.ReputationRepository
+	Crypto               crypto.Crypto
+	Config               user.Config
 }
 
 func NewUserUsecases(opts UserUsecasesOpts) user.UserUsecases {
 	return &userUsecases{
-		TxManager:      opts.TxManager,
-		UserRepository: opts.UserRepository,
-		Crypto:         opts.Crypto,
+		TxManager:            opts.TxManager,
+		Bus:                  opts.Events,
+		UserRepository:       opts.UserRepository,
+		ReputationRepository: opts.ReputationRepository,
+		Crypto:               opts.Crypto,
+		Config:               opts.Config,
 	}
 }
 
 type userUsecases struct {
 	database.TxManager
+	event.Bus
 	user.UserRepository
+	reputation.ReputationRepository
 	crypto.Crypto
+	user.Config
 }
 
 func (u *userUsecases) GetAllUsers(ctx context.Context) (out []user.UserDto, err error) {
@@ -62,24 +80,98 @@ func (u *userUsecases) Add(ctx context.Context, in user.AddUserDto) (userId int6
 		if err != nil {
 			return err
 		}
-		return nil
+
+		return u.Bus.Publish(ctx, event.Event{Name: event.UserCreated, UserId: userId})
 	})
 
 	return userId, err
 }
 
+// Update asks the user to confirm a new email address in the same
+// transaction that saves it.
 func (u *userUsecases) Update(ctx context.Context, in user.UpdateUserDto) (err error) {
-	model, err := u.UserRepository.GetById(ctx, in.Id)
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.UserRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+		email := model.Email
+
+		if err := model.Update(in.FirstName, in.LastName, in.Email); err != nil {
+			return err
+		}
+		if _, err := u.UserRepository.Update(ctx, model); err != nil {
+			return err
+		}
+
+		if model.Email == email {
+			return nil
+		}
+		return u.Bus.Publish(ctx, event.Event{Name: event.EmailChanged, UserId: model.Id})
+	})
+}
+
+func (u *userUsecases) VerifyEmail(ctx context.Context, in user.VerifyEmailDto) error {
+	claims, err := user.ParseToken(u.Crypto, u.TokenSecret(), user.TokenVerifyEmail, in.Token)
 	if err != nil {
 		return err
 	}
-	err = model.Update(in.FirstName, in.LastName, in.Email)
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.UserRepository.GetById(ctx, claims.UserId)
+		if err != nil {
+			return err
+		}
+		if err := model.CheckToken(claims); err != nil {
+			return err
+		}
+
+		model.VerifyEmail(time.Now())
+		_, err = u.UserRepository.Update(ctx, model)
+		return err
+	})
+}
+
+func (u *userUsecases) RequestPasswordReset(ctx context.Context, in user.RequestPasswordResetDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.UserRepository.GetByEmail(ctx, in.Email)
+		if err != nil {
+			if baseErr, ok := err.(*errors.Error); ok && baseErr.Status() == errors.NotFoundError {
+				return nil
+			}
+			return err
+		}
+
+		return u.Bus.Publish(ctx, event.Event{Name: event.PasswordResetRequested, UserId: model.Id})
+	})
+}
+
+// ResetPassword sets the password the reset link was sent for. The link is
+// bound to the old password, so it works once.
+func (u *userUsecases) ResetPassword(ctx context.Context, in user.ResetPasswordDto) error {
+	claims, err := user.ParseToken(u.Crypto, u.TokenSecret(), user.TokenResetPassword, in.Token)
 	if err != nil {
 		return err
 	}
-	_, err = u.UserRepository.Update(ctx, model)
 
-	return err
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.UserRepository.GetById(ctx, claims.UserId)
+		if err != nil {
+			return err
+		}
+		if err := model.CheckToken(claims); err != nil {
+			return err
+		}
+
+		if err := model.ChangePassword(in.Password, u.Crypto); err != nil {
+			return err
+		}
+		// Whoever got the link controls the address.
+		model.VerifyEmail(time.Now())
+
+		_, err = u.UserRepository.Update(ctx, model)
+		return err
+	})
 }
 
 func (u *userUsecases) ChangePassword(
@@ -98,6 +190,19 @@ func (u *userUsecases) ChangePassword(
 	return err
 }
 
+func (u *userUsecases) ChangeRole(ctx context.Context, in user.ChangeUserRoleDto) (err error) {
+	model, err := u.UserRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if err = model.ChangeRole(in.Role); err != nil {
+		return err
+	}
+	_, err = u.UserRepository.Update(ctx, model)
+
+	return err
+}
+
 func (u *userUsecases) GetById(ctx context.Context, userId int64) (out user.UserDto, err error) {
 	model, err := u.UserRepository.GetById(ctx, userId)
 	if err != nil {
@@ -106,3 +211,67 @@ func (u *userUsecases) GetById(ctx context.Context, userId int64) (out user.User
 
 	return out.MapFromModel(model), nil
 }
+
+func (u *userUsecases) GetReputationHistory(
+	ctx context.Context,
+	in reputation.GetEventsDto,
+) (out []reputation.EventDto, err error) {
+	limit := in.Limit
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	events, err := u.ReputationRepository.GetEventsByUser(ctx, in.UserId, limit, in.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []reputation.EventDto{}
+	for _, event := range events {
+		out = append(out, reputation.EventDto{}.MapFromModel(event))
+	}
+
+	return out, nil
+}
+
+// RecomputeReputation rebuilds the reputation of all users from the ledger
+// and returns how many users were updated.
+func (u *userUsecases) RecomputeReputation(ctx context.Context) (out reputation.RecomputeDto, err error) {
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		out.Updated, err = u.ReputationRepository.Recompute(ctx)
+		return err
+	})
+
+	return out, err
+}
+
+func (u *userUsecases) GetReputationRules(ctx context.Context) (out []reputation.RuleDto, err error) {
+	rules, err := u.ReputationRepository.GetRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out = []reputation.RuleDto{}
+	for _, rule := range rules {
+		out = append(out, reputation.RuleDto{}.MapFromModel(rule))
+	}
+
+	return out, nil
+}
+
+func (u *userUsecases) UpdateReputationRule(ctx context.Context, in reputation.UpdateRuleDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		rule, err := u.ReputationRepository.GetRule(ctx, in.Event)
+		if err != nil {
+			return err
+		}
+		if err := rule.Update(in.Points, in.Description); err != nil {
+			return err
+		}
+
+		return u.ReputationRepository.UpdateRule(ctx, rule)
+	})
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	baseErrors "fibo/internal/base/errors"
+	"fibo/internal/base/event"
+	"fibo/internal/reputation"
 	"fibo/internal/user"
 
 	cryptoMock "fibo/internal/base/crypto/mock"
 	dbMock "fibo/internal/base/database/mock"
+	eventMock "fibo/internal/base/event/mock"
+	reputationMock "fibo/internal/reputation/mock"
 	userMock "fibo/internal/user/mock"
 )
 
@@ -31,6 +37,7 @@ func TestUserUsecases_Add(t *testing.T) {
 		LastName:  in.LastName,
 		Email:     in.Email,
 		Password:  passwordHash,
+		Role:      user.RoleAuthor,
 	}
 	updateUser := user.UserModel{
 		Id:        userId,
@@ -38,6 +45,7 @@ func TestUserUsecases_Add(t *testing.T) {
 		LastName:  createUser.LastName,
 		Email:     createUser.Email,
 		Password:  createUser.Password,
+		Role:      createUser.Role,
 	}
 
 	t.Run("expect it adds new user", func(t *testing.T) {
@@ -46,6 +54,7 @@ func TestUserUsecases_Add(t *testing.T) {
 		prep.crypto.EXPECT().HashPassword(password).Return(passwordHash, nil)
 		prep.userRepo.EXPECT().Add(mock.Anything, createUser).Return(userId, nil)
 		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(userId, nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.UserCreated, UserId: userId}).Return(nil)
 
 		actualUserId, err := prep.userUsecases.Add(prep.ctx, in)
 
@@ -53,6 +62,20 @@ func TestUserUsecases_Add(t *testing.T) {
 		require.Equal(t, userId, actualUserId)
 	})
 
+	t.Run("expect it fails if the verify email can't be queued", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("outbox failed")
+
+		prep.crypto.EXPECT().HashPassword(password).Return(passwordHash, nil)
+		prep.userRepo.EXPECT().Add(mock.Anything, createUser).Return(userId, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(userId, nil)
+		prep.events.EXPECT().Publish(mock.Anything, mock.Anything).Return(err)
+
+		_, actualErr := prep.userUsecases.Add(prep.ctx, in)
+
+		require.EqualError(t, actualErr, err.Error())
+	})
+
 	t.Run("expect it fails if password hashing fails", func(t *testing.T) {
 		prep := newTestPrep()
 		err := errors.New("password hashing failed")
@@ -106,6 +129,7 @@ func TestUserUsecases_UpdateInfo(t *testing.T) {
 		LastName:  "LastName",
 		Email:     "user@email.com",
 		Password:  "password-hash",
+		Role:      user.RoleAuthor,
 	}
 	updateUser := user.UserModel{
 		Id:        in.Id,
@@ -113,6 +137,7 @@ func TestUserUsecases_UpdateInfo(t *testing.T) {
 		LastName:  in.LastName,
 		Email:     in.Email,
 		Password:  getUser.Password,
+		Role:      getUser.Role,
 	}
 
 	t.Run("expect it updates user", func(t *testing.T) {
@@ -120,12 +145,43 @@ func TestUserUsecases_UpdateInfo(t *testing.T) {
 
 		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
 		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(in.Id, nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.EmailChanged, UserId: in.Id}).Return(nil)
 
 		err := prep.userUsecases.Update(prep.ctx, in)
 
 		require.NoError(t, err)
 	})
 
+	t.Run("expect a new address to be confirmed again", func(t *testing.T) {
+		prep := newTestPrep()
+		verifiedUser := getUser
+		verifiedUser.EmailVerifiedAt = "2026-01-02T03:04:05Z"
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(verifiedUser, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(in.Id, nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.EmailChanged, UserId: in.Id}).Return(nil)
+
+		err := prep.userUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect no confirmation if the address stays the same", func(t *testing.T) {
+		prep := newTestPrep()
+		sameEmail := in
+		sameEmail.Email = getUser.Email
+		sameUser := updateUser
+		sameUser.Email = getUser.Email
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, sameUser).Return(in.Id, nil)
+
+		err := prep.userUsecases.Update(prep.ctx, sameEmail)
+
+		require.NoError(t, err)
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
 	t.Run("expect it fails if user getting fails", func(t *testing.T) {
 		prep := newTestPrep()
 		err := errors.New("user getting failed")
@@ -163,6 +219,7 @@ func TestUserUsecases_ChangePassword(t *testing.T) {
 		LastName:  "LastName",
 		Email:     "user@email.com",
 		Password:  "old-password-hash",
+		Role:      user.RoleAuthor,
 	}
 	updateUser := user.UserModel{
 		Id:        getUser.Id,
@@ -170,6 +227,7 @@ func TestUserUsecases_ChangePassword(t *testing.T) {
 		LastName:  getUser.LastName,
 		Email:     getUser.Email,
 		Password:  "new-password-hash",
+		Role:      user.RoleAuthor,
 	}
 
 	t.Run("expect it changes user password", func(t *testing.T) {
@@ -224,6 +282,221 @@ func TestUserUsecases_ChangePassword(t *testing.T) {
 	})
 }
 
+func TestUserUsecases_VerifyEmail(t *testing.T) {
+	getUser := user.UserModel{
+		Id:        int64(4),
+		FirstName: "FirstName",
+		LastName:  "LastName",
+		Email:     "user@email.com",
+		Password:  "password-hash",
+		Role:      user.RoleAuthor,
+	}
+	in := user.VerifyEmailDto{Token: "token"}
+
+	t.Run("expect it confirms the address", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().TokenSecret().Return("secret")
+		prep.crypto.EXPECT().ParseAndValidateJWT(in.Token, "secret").
+			Return(issuedPayload(t, getUser, user.TokenVerifyEmail), nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model user.UserModel) bool {
+			return model.Id == getUser.Id && model.IsEmailVerified()
+		})).Return(getUser.Id, nil)
+
+		err := prep.userUsecases.VerifyEmail(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a link for a former address to be refused", func(t *testing.T) {
+		prep := newTestPrep()
+		changedUser := getUser
+		changedUser.Email = "user+new@email.com"
+
+		prep.config.EXPECT().TokenSecret().Return("secret")
+		prep.crypto.EXPECT().ParseAndValidateJWT(in.Token, "secret").
+			Return(issuedPayload(t, getUser, user.TokenVerifyEmail), nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(changedUser, nil)
+
+		err := prep.userUsecases.VerifyEmail(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect a reset link to be refused", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().TokenSecret().Return("secret")
+		prep.crypto.EXPECT().ParseAndValidateJWT(in.Token, "secret").
+			Return(issuedPayload(t, getUser, user.TokenResetPassword), nil)
+
+		err := prep.userUsecases.VerifyEmail(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.userRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect an expired link to be refused", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().TokenSecret().Return("secret")
+		prep.crypto.EXPECT().ParseAndValidateJWT(in.Token, "secret").Return(nil, errors.New("token is expired"))
+
+		err := prep.userUsecases.VerifyEmail(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+	})
+}
+
+func TestUserUsecases_RequestPasswordReset(t *testing.T) {
+	in := user.RequestPasswordResetDto{Email: "user@email.com"}
+
+	t.Run("expect a reset link to be sent", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(user.UserModel{Id: 5, Email: in.Email}, nil)
+		prep.events.EXPECT().Publish(mock.Anything, event.Event{Name: event.PasswordResetRequested, UserId: 5}).Return(nil)
+
+		err := prep.userUsecases.RequestPasswordReset(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect an unknown address to look the same", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).
+			Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "user not found"))
+
+		err := prep.userUsecases.RequestPasswordReset(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if user getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.DatabaseError, "connection lost")
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(user.UserModel{}, err)
+
+		actualErr := prep.userUsecases.RequestPasswordReset(prep.ctx, in)
+
+		require.Equal(t, err, actualErr)
+	})
+}
+
+func TestUserUsecases_ResetPassword(t *testing.T) {
+	getUser := user.UserModel{
+		Id:        int64(6),
+		FirstName: "FirstName",
+		LastName:  "LastName",
+		Email:     "user@email.com",
+		Password:  "old-password-hash",
+		Role:      user.RoleAuthor,
+	}
+	in := user.ResetPasswordDto{Token: "token", Password: "new-password"}
+
+	t.Run("expect it sets the new password", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().TokenSecret().Return("secret")
+		prep.crypto.EXPECT().ParseAndValidateJWT(in.Token, "secret").
+			Return(issuedPayload(t, getUser, user.TokenResetPassword), nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.crypto.EXPECT().HashPassword(in.Password).Return("new-password-hash", nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model user.UserModel) bool {
+			return model.Password == "new-password-hash" && model.IsEmailVerified()
+		})).Return(getUser.Id, nil)
+
+		err := prep.userUsecases.ResetPassword(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect a used link to be refused", func(t *testing.T) {
+		prep := newTestPrep()
+		resetUser := getUser
+		resetUser.Password = "new-password-hash"
+
+		prep.config.EXPECT().TokenSecret().Return("secret")
+		prep.crypto.EXPECT().ParseAndValidateJWT(in.Token, "secret").
+			Return(issuedPayload(t, getUser, user.TokenResetPassword), nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(resetUser, nil)
+
+		err := prep.userUsecases.ResetPassword(prep.ctx, in)
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+// issuedPayload is what the token emailed to the user for the purpose
+// carries.
+func issuedPayload(t *testing.T, model user.UserModel, purpose user.TokenPurpose) map[string]interface{} {
+	c := &cryptoMock.Crypto{}
+	var payload map[string]interface{}
+
+	c.EXPECT().GenerateJWT(mock.Anything, "secret", mock.Anything).
+		Run(func(p map[string]interface{}, secret string, exp time.Time) { payload = p }).
+		Return("token", nil)
+
+	_, err := model.IssueToken(c, "secret", purpose, time.Now())
+	require.NoError(t, err)
+
+	return payload
+}
+
+func TestUserUsecases_ChangeRole(t *testing.T) {
+	in := user.ChangeUserRoleDto{
+		Id:   int64(3),
+		Role: user.RoleReviewer,
+	}
+	getUser := user.UserModel{
+		Id:        in.Id,
+		FirstName: "FirstName",
+		LastName:  "LastName",
+		Email:     "user@email.com",
+		Password:  "password-hash",
+		Role:      user.RoleAuthor,
+	}
+	updateUser := getUser
+	updateUser.Role = in.Role
+
+	t.Run("expect it changes user role", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(in.Id, nil)
+
+		err := prep.userUsecases.ChangeRole(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if role is unknown", func(t *testing.T) {
+		prep := newTestPrep()
+		unknownIn := in
+		unknownIn.Role = "root"
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+
+		err := prep.userUsecases.ChangeRole(prep.ctx, unknownIn)
+
+		require.Error(t, err)
+	})
+}
+
 func TestUserUsecases_GetById(t *testing.T) {
 	userId := int64(4)
 
@@ -233,12 +506,14 @@ func TestUserUsecases_GetById(t *testing.T) {
 		LastName:  "LastName",
 		Email:     "user@email.com",
 		Password:  "password-hash",
+		Role:      user.RoleAuthor,
 	}
 	out := user.UserDto{
 		Id:        getUser.Id,
 		FirstName: getUser.FirstName,
 		LastName:  getUser.LastName,
 		Email:     getUser.Email,
+		Role:      getUser.Role,
 	}
 
 	t.Run("expect it gets user", func(t *testing.T) {
@@ -265,10 +540,88 @@ func TestUserUsecases_GetById(t *testing.T) {
 	})
 }
 
+func TestUserUsecases_GetReputationHistory(t *testing.T) {
+	userId := int64(4)
+	events := []reputation.EventModel{
+		{Id: 2, UserId: userId, Event: reputation.EventCommentReceived, Points: 1, PostId: 7, CreatedAt: "2026-10-02T10:00:00Z"},
+		{Id: 1, UserId: userId, Event: reputation.EventPostApproved, Points: 10, PostId: 7, CreatedAt: "2026-10-01T10:00:00Z"},
+	}
+
+	t.Run("expect it gets latest events with default limit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.reputationRepo.EXPECT().GetEventsByUser(mock.Anything, userId, defaultHistoryLimit, uint(0)).Return(events, nil)
+
+		out, err := prep.userUsecases.GetReputationHistory(prep.ctx, reputation.GetEventsDto{UserId: userId})
+
+		require.NoError(t, err)
+		require.Equal(t, []reputation.EventDto{
+			{Id: 2, Event: reputation.EventCommentReceived, Points: 1, PostId: 7, CreatedAt: "2026-10-02T10:00:00Z"},
+			{Id: 1, Event: reputation.EventPostApproved, Points: 10, PostId: 7, CreatedAt: "2026-10-01T10:00:00Z"},
+		}, out)
+	})
+
+	t.Run("expect limit to be capped", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.reputationRepo.EXPECT().GetEventsByUser(mock.Anything, userId, maxHistoryLimit, uint(40)).Return(nil, nil)
+
+		out, err := prep.userUsecases.GetReputationHistory(prep.ctx, reputation.GetEventsDto{
+			UserId: userId,
+			Limit:  1000,
+			Offset: 40,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, out)
+	})
+}
+
+func TestUserUsecases_UpdateReputationRule(t *testing.T) {
+	getRule := reputation.RuleModel{Event: reputation.EventPostLiked, Points: 1, Description: "Post liked"}
+
+	t.Run("expect it updates rule", func(t *testing.T) {
+		prep := newTestPrep()
+		updated := getRule
+		updated.Points = 2
+
+		prep.reputationRepo.EXPECT().GetRule(mock.Anything, getRule.Event).Return(getRule, nil)
+		prep.reputationRepo.EXPECT().UpdateRule(mock.Anything, updated).Return(nil)
+
+		err := prep.userUsecases.UpdateReputationRule(prep.ctx, reputation.UpdateRuleDto{
+			Event:       getRule.Event,
+			Points:      2,
+			Description: getRule.Description,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if points are out of range", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.reputationRepo.EXPECT().GetRule(mock.Anything, getRule.Event).Return(getRule, nil)
+
+		err := prep.userUsecases.UpdateReputationRule(prep.ctx, reputation.UpdateRuleDto{
+			Event:       getRule.Event,
+			Points:      5000,
+			Description: getRule.Description,
+		})
+
+		var baseErr *baseErrors.Error
+		require.ErrorAs(t, err, &baseErr)
+		require.Equal(t, baseErrors.ValidationError, baseErr.Status())
+		prep.reputationRepo.AssertNotCalled(t, "UpdateRule", mock.Anything, mock.Anything)
+	})
+}
+
 type testPrep struct {
-	ctx      context.Context
-	crypto   *cryptoMock.Crypto
-	userRepo *userMock.UserRepository
+	ctx            context.Context
+	crypto         *cryptoMock.Crypto
+	config         *userMock.Config
+	events         *eventMock.Bus
+	userRepo       *userMock.UserRepository
+	reputationRepo *reputationMock.ReputationRepository
 
 	userUsecases user.UserUsecases
 }
@@ -276,19 +629,28 @@ type testPrep struct {
 func newTestPrep() testPrep {
 	crypto := &cryptoMock.Crypto{}
 	userRepo := &userMock.UserRepository{}
+	reputationRepo := &reputationMock.ReputationRepository{}
 	txManager := &dbMock.MockTxManager{}
+	events := &eventMock.Bus{}
+	config := &userMock.Config{}
 
 	userUsecasesOpts := UserUsecasesOpts{
-		TxManager:      txManager,
-		UserRepository: userRepo,
-		Crypto:         crypto,
+		TxManager:            txManager,
+		Events:               events,
+		UserRepository:       userRepo,
+		ReputationRepository: reputationRepo,
+		Crypto:               crypto,
+		Config:               config,
 	}
 	userUsecases := NewUserUsecases(userUsecasesOpts)
 
 	return testPrep{
-		ctx:          context.Background(),
-		crypto:       crypto,
-		userRepo:     userRepo,
-		userUsecases: userUsecases,
+		ctx:            context.Background(),
+		crypto:         crypto,
+		config:         config,
+		events:         events,
+		userRepo:       userRepo,
+		reputationRepo: reputationRepo,
+		userUsecases:   userUsecases,
 	}
 }
@@ -67,6 +67,7 @@ func (_c *UserRepository_Add_Call) Return(_a0 int64, _a1 error) *UserRepository_
 	return _c
 }
 
+// GetAllUsers provides a mock function with given fields: ctx
 func (_m *UserRepository) GetAllUsers(ctx context.Context) ([]user.UserModel, error) {
 	ret := _m.Called(ctx)
 
@@ -74,7 +75,9 @@ func (_m *UserRepository) GetAllUsers(ctx context.Context) ([]user.UserModel, er
 	if rf, ok := ret.Get(0).(func(context.Context) []user.UserModel); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).([]user.UserModel)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.UserModel)
+		}
 	}
 
 	var r1 error
@@ -87,6 +90,29 @@ func (_m *UserRepository) GetAllUsers(ctx context.Context) ([]user.UserModel, er
 	return r0, r1
 }
 
+// UserRepository_GetAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllUsers'
+type UserRepository_GetAllUsers_Call struct {
+	*mock.Call
+}
+
+// GetAllUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserRepository_Expecter) GetAllUsers(ctx interface{}) *UserRepository_GetAllUsers_Call {
+	return &UserRepository_GetAllUsers_Call{Call: _e.mock.On("GetAllUsers", ctx)}
+}
+
+func (_c *UserRepository_GetAllUsers_Call) Run(run func(ctx context.Context)) *UserRepository_GetAllUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetAllUsers_Call) Return(_a0 []user.UserModel, _a1 error) *UserRepository_GetAllUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetByEmail provides a mock function with given fields: ctx, email
 func (_m *UserRepository) GetByEmail(ctx context.Context, email string) (user.UserModel, error) {
 	ret := _m.Called(ctx, email)
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	reputation "fibo/internal/reputation"
 	user "fibo/internal/user"
 
 	mock "github.com/stretchr/testify/mock"
@@ -49,8 +50,8 @@ type UserUsecases_Add_Call struct {
 }
 
 // Add is a helper method to define mock.On call
-//  - ctx context.Context
-//  - dto user.AddUserDto
+//   - ctx context.Context
+//   - dto user.AddUserDto
 func (_e *UserUsecases_Expecter) Add(ctx interface{}, dto interface{}) *UserUsecases_Add_Call {
 	return &UserUsecases_Add_Call{Call: _e.mock.On("Add", ctx, dto)}
 }
@@ -87,8 +88,8 @@ type UserUsecases_ChangePassword_Call struct {
 }
 
 // ChangePassword is a helper method to define mock.On call
-//  - ctx context.Context
-//  - dto user.ChangeUserPasswordDto
+//   - ctx context.Context
+//   - dto user.ChangeUserPasswordDto
 func (_e *UserUsecases_Expecter) ChangePassword(ctx interface{}, dto interface{}) *UserUsecases_ChangePassword_Call {
 	return &UserUsecases_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, dto)}
 }
@@ -105,6 +106,90 @@ func (_c *UserUsecases_ChangePassword_Call) Return(_a0 error) *UserUsecases_Chan
 	return _c
 }
 
+// ChangeRole provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) ChangeRole(ctx context.Context, dto user.ChangeUserRoleDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.ChangeUserRoleDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_ChangeRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeRole'
+type UserUsecases_ChangeRole_Call struct {
+	*mock.Call
+}
+
+// ChangeRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto user.ChangeUserRoleDto
+func (_e *UserUsecases_Expecter) ChangeRole(ctx interface{}, dto interface{}) *UserUsecases_ChangeRole_Call {
+	return &UserUsecases_ChangeRole_Call{Call: _e.mock.On("ChangeRole", ctx, dto)}
+}
+
+func (_c *UserUsecases_ChangeRole_Call) Run(run func(ctx context.Context, dto user.ChangeUserRoleDto)) *UserUsecases_ChangeRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.ChangeUserRoleDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_ChangeRole_Call) Return(_a0 error) *UserUsecases_ChangeRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GetAllUsers provides a mock function with given fields: ctx
+func (_m *UserUsecases) GetAllUsers(ctx context.Context) ([]user.UserDto, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []user.UserDto
+	if rf, ok := ret.Get(0).(func(context.Context) []user.UserDto); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.UserDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserUsecases_GetAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllUsers'
+type UserUsecases_GetAllUsers_Call struct {
+	*mock.Call
+}
+
+// GetAllUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserUsecases_Expecter) GetAllUsers(ctx interface{}) *UserUsecases_GetAllUsers_Call {
+	return &UserUsecases_GetAllUsers_Call{Call: _e.mock.On("GetAllUsers", ctx)}
+}
+
+func (_c *UserUsecases_GetAllUsers_Call) Run(run func(ctx context.Context)) *UserUsecases_GetAllUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_GetAllUsers_Call) Return(_a0 []user.UserDto, _a1 error) *UserUsecases_GetAllUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetById provides a mock function with given fields: ctx, userId
 func (_m *UserUsecases) GetById(ctx context.Context, userId int64) (user.UserDto, error) {
 	ret := _m.Called(ctx, userId)
@@ -132,8 +217,8 @@ type UserUsecases_GetById_Call struct {
 }
 
 // GetById is a helper method to define mock.On call
-//  - ctx context.Context
-//  - userId int64
+//   - ctx context.Context
+//   - userId int64
 func (_e *UserUsecases_Expecter) GetById(ctx interface{}, userId interface{}) *UserUsecases_GetById_Call {
 	return &UserUsecases_GetById_Call{Call: _e.mock.On("GetById", ctx, userId)}
 }
@@ -150,6 +235,219 @@ func (_c *UserUsecases_GetById_Call) Return(_a0 user.UserDto, _a1 error) *UserUs
 	return _c
 }
 
+// GetReputationHistory provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) GetReputationHistory(ctx context.Context, dto reputation.GetEventsDto) ([]reputation.EventDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 []reputation.EventDto
+	if rf, ok := ret.Get(0).(func(context.Context, reputation.GetEventsDto) []reputation.EventDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reputation.EventDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, reputation.GetEventsDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserUsecases_GetReputationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReputationHistory'
+type UserUsecases_GetReputationHistory_Call struct {
+	*mock.Call
+}
+
+// GetReputationHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto reputation.GetEventsDto
+func (_e *UserUsecases_Expecter) GetReputationHistory(ctx interface{}, dto interface{}) *UserUsecases_GetReputationHistory_Call {
+	return &UserUsecases_GetReputationHistory_Call{Call: _e.mock.On("GetReputationHistory", ctx, dto)}
+}
+
+func (_c *UserUsecases_GetReputationHistory_Call) Run(run func(ctx context.Context, dto reputation.GetEventsDto)) *UserUsecases_GetReputationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reputation.GetEventsDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_GetReputationHistory_Call) Return(_a0 []reputation.EventDto, _a1 error) *UserUsecases_GetReputationHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetReputationRules provides a mock function with given fields: ctx
+func (_m *UserUsecases) GetReputationRules(ctx context.Context) ([]reputation.RuleDto, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []reputation.RuleDto
+	if rf, ok := ret.Get(0).(func(context.Context) []reputation.RuleDto); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reputation.RuleDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserUsecases_GetReputationRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReputationRules'
+type UserUsecases_GetReputationRules_Call struct {
+	*mock.Call
+}
+
+// GetReputationRules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserUsecases_Expecter) GetReputationRules(ctx interface{}) *UserUsecases_GetReputationRules_Call {
+	return &UserUsecases_GetReputationRules_Call{Call: _e.mock.On("GetReputationRules", ctx)}
+}
+
+func (_c *UserUsecases_GetReputationRules_Call) Run(run func(ctx context.Context)) *UserUsecases_GetReputationRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_GetReputationRules_Call) Return(_a0 []reputation.RuleDto, _a1 error) *UserUsecases_GetReputationRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RecomputeReputation provides a mock function with given fields: ctx
+func (_m *UserUsecases) RecomputeReputation(ctx context.Context) (reputation.RecomputeDto, error) {
+	ret := _m.Called(ctx)
+
+	var r0 reputation.RecomputeDto
+	if rf, ok := ret.Get(0).(func(context.Context) reputation.RecomputeDto); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(reputation.RecomputeDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserUsecases_RecomputeReputation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecomputeReputation'
+type UserUsecases_RecomputeReputation_Call struct {
+	*mock.Call
+}
+
+// RecomputeReputation is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserUsecases_Expecter) RecomputeReputation(ctx interface{}) *UserUsecases_RecomputeReputation_Call {
+	return &UserUsecases_RecomputeReputation_Call{Call: _e.mock.On("RecomputeReputation", ctx)}
+}
+
+func (_c *UserUsecases_RecomputeReputation_Call) Run(run func(ctx context.Context)) *UserUsecases_RecomputeReputation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_RecomputeReputation_Call) Return(_a0 reputation.RecomputeDto, _a1 error) *UserUsecases_RecomputeReputation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) RequestPasswordReset(ctx context.Context, dto user.RequestPasswordResetDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.RequestPasswordResetDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type UserUsecases_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto user.RequestPasswordResetDto
+func (_e *UserUsecases_Expecter) RequestPasswordReset(ctx interface{}, dto interface{}) *UserUsecases_RequestPasswordReset_Call {
+	return &UserUsecases_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", ctx, dto)}
+}
+
+func (_c *UserUsecases_RequestPasswordReset_Call) Run(run func(ctx context.Context, dto user.RequestPasswordResetDto)) *UserUsecases_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.RequestPasswordResetDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_RequestPasswordReset_Call) Return(_a0 error) *UserUsecases_RequestPasswordReset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ResetPassword provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) ResetPassword(ctx context.Context, dto user.ResetPasswordDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.ResetPasswordDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type UserUsecases_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto user.ResetPasswordDto
+func (_e *UserUsecases_Expecter) ResetPassword(ctx interface{}, dto interface{}) *UserUsecases_ResetPassword_Call {
+	return &UserUsecases_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, dto)}
+}
+
+func (_c *UserUsecases_ResetPassword_Call) Run(run func(ctx context.Context, dto user.ResetPasswordDto)) *UserUsecases_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.ResetPasswordDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_ResetPassword_Call) Return(_a0 error) *UserUsecases_ResetPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, dto
 func (_m *UserUsecases) Update(ctx context.Context, dto user.UpdateUserDto) error {
 	ret := _m.Called(ctx, dto)
@@ -170,8 +468,8 @@ type UserUsecases_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
-//  - ctx context.Context
-//  - dto user.UpdateUserDto
+//   - ctx context.Context
+//   - dto user.UpdateUserDto
 func (_e *UserUsecases_Expecter) Update(ctx interface{}, dto interface{}) *UserUsecases_Update_Call {
 	return &UserUsecases_Update_Call{Call: _e.mock.On("Update", ctx, dto)}
 }
@@ -187,3 +485,79 @@ func (_c *UserUsecases_Update_Call) Return(_a0 error) *UserUsecases_Update_Call
 	_c.Call.Return(_a0)
 	return _c
 }
+
+// UpdateReputationRule provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) UpdateReputationRule(ctx context.Context, dto reputation.UpdateRuleDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reputation.UpdateRuleDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_UpdateReputationRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReputationRule'
+type UserUsecases_UpdateReputationRule_Call struct {
+	*mock.Call
+}
+
+// UpdateReputationRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto reputation.UpdateRuleDto
+func (_e *UserUsecases_Expecter) UpdateReputationRule(ctx interface{}, dto interface{}) *UserUsecases_UpdateReputationRule_Call {
+	return &UserUsecases_UpdateReputationRule_Call{Call: _e.mock.On("UpdateReputationRule", ctx, dto)}
+}
+
+func (_c *UserUsecases_UpdateReputationRule_Call) Run(run func(ctx context.Context, dto reputation.UpdateRuleDto)) *UserUsecases_UpdateReputationRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reputation.UpdateRuleDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_UpdateReputationRule_Call) Return(_a0 error) *UserUsecases_UpdateReputationRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// VerifyEmail provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) VerifyEmail(ctx context.Context, dto user.VerifyEmailDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.VerifyEmailDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type UserUsecases_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto user.VerifyEmailDto
+func (_e *UserUsecases_Expecter) VerifyEmail(ctx interface{}, dto interface{}) *UserUsecases_VerifyEmail_Call {
+	return &UserUsecases_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, dto)}
+}
+
+func (_c *UserUsecases_VerifyEmail_Call) Run(run func(ctx context.Context, dto user.VerifyEmailDto)) *UserUsecases_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.VerifyEmailDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_VerifyEmail_Call) Return(_a0 error) *UserUsecases_VerifyEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
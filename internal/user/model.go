@@ -1,6 +1,8 @@
 package user
 
 import (
+	"time"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 
@@ -15,15 +17,23 @@ type UserModel struct {
 	Email      string
 	Password   string
 	Reputation int64
+	Role       Role
+	// Followers counts the readers following the user, Following the
+	// authors and categories the user follows.
+	Followers int64
+	Following int64
+	// EmailVerifiedAt is when the user confirmed owning the email address,
+	// empty until then.
+	EmailVerifiedAt string
 }
 
-func NewUser(firstName, lastName, email, password string, reputation int64) (UserModel, error) {
+func NewUser(firstName, lastName, email, password string) (UserModel, error) {
 	user := UserModel{
-		FirstName:  firstName,
-		LastName:   lastName,
-		Email:      email,
-		Password:   password,
-		Reputation: reputation,
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     email,
+		Password:  password,
+		Role:      RoleAuthor,
 	}
 	if err := user.Validate(); err != nil {
 		return UserModel{}, err
@@ -39,9 +49,28 @@ func (user *UserModel) Update(firstName, lastName, email string) error {
 	if len(lastName) > 0 {
 		user.LastName = lastName
 	}
-	if len(email) > 0 {
+	if len(email) > 0 && email != user.Email {
 		user.Email = email
+		user.EmailVerifiedAt = ""
+	}
+
+	return user.Validate()
+}
+
+func (user *UserModel) IsEmailVerified() bool {
+	return user.EmailVerifiedAt != ""
+}
+
+// VerifyEmail records that the user confirmed the address. Confirming it
+// again keeps the first time.
+func (user *UserModel) VerifyEmail(now time.Time) {
+	if !user.IsEmailVerified() {
+		user.EmailVerifiedAt = now.UTC().Format(time.RFC3339)
 	}
+}
+
+func (user *UserModel) ChangeRole(role Role) error {
+	user.Role = role
 
 	return user.Validate()
 }
@@ -62,6 +91,7 @@ func (user *UserModel) Validate() error {
 		validation.Field(&user.LastName, validation.Required, validation.Length(2, 100)),
 		validation.Field(&user.Email, validation.Required, is.Email),
 		validation.Field(&user.Password, validation.Required, validation.Length(5, 100)),
+		validation.Field(&user.Role, validation.Required, validation.In(RoleAuthor, RoleReviewer, RoleEditor, RoleAdmin)),
 	)
 	if err != nil {
 		return errors.New(errors.ValidationError, err.Error())
@@ -0,0 +1,51 @@
+package user
+
+// Role grants access to the parts of the blog beyond writing own posts.
+type Role string
+
+const (
+	RoleAuthor   Role = "author"
+	RoleReviewer Role = "reviewer"
+	RoleEditor   Role = "editor"
+	RoleAdmin    Role = "admin"
+)
+
+var Roles = []Role{RoleAuthor, RoleReviewer, RoleEditor, RoleAdmin}
+
+func (r Role) IsValid() bool {
+	for _, role := range Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// In reports whether the role is one of the given ones.
+func (r Role) In(roles ...Role) bool {
+	for _, role := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanEditAnyPost reports whether the role may change posts of other authors.
+func (r Role) CanEditAnyPost() bool {
+	return r.In(RoleEditor, RoleAdmin)
+}
+
+// CanModerate reports whether the role may see hidden content and decide on
+// reports against it.
+func (r Role) CanModerate() bool {
+	return r.In(RoleReviewer, RoleEditor, RoleAdmin)
+}
+
+// CanDeleteAnyPost reports whether the role may delete and restore posts of
+// other authors.
+func (r Role) CanDeleteAnyPost() bool {
+	return r == RoleAdmin
+}
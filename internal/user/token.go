@@ -0,0 +1,109 @@
+package user
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"fibo/internal/base/crypto"
+	"fibo/internal/base/errors"
+)
+
+// TokenPurpose tells what the token in an emailed link lets its holder do.
+type TokenPurpose string
+
+const (
+	TokenVerifyEmail   TokenPurpose = "verify_email"
+	TokenResetPassword TokenPurpose = "reset_password"
+)
+
+const (
+	VerifyEmailTokenTTL   = 7 * 24 * time.Hour
+	ResetPasswordTokenTTL = time.Hour
+)
+
+// TTL is how long a link with a token of the purpose works.
+func (purpose TokenPurpose) TTL() time.Duration {
+	if purpose == TokenResetPassword {
+		return ResetPasswordTokenTTL
+	}
+
+	return VerifyEmailTokenTTL
+}
+
+// IssueToken signs a token for the emailed link of the purpose. The token is
+// bound to what it acts on, the address to confirm or the password to
+// replace, so it stops working once that changed.
+func (user *UserModel) IssueToken(
+	c crypto.Crypto,
+	secret string,
+	purpose TokenPurpose,
+	now time.Time,
+) (string, error) {
+	if secret == "" {
+		return "", errors.New(errors.InternalError, "user token secret is not set")
+	}
+
+	payload := map[string]interface{}{
+		"sub":     strconv.FormatInt(user.Id, 10),
+		"purpose": string(purpose),
+		"stamp":   user.tokenStamp(purpose),
+	}
+
+	return c.GenerateJWT(payload, secret, now.Add(purpose.TTL()))
+}
+
+// TokenClaims is what a valid token tells.
+type TokenClaims struct {
+	UserId  int64
+	Purpose TokenPurpose
+	stamp   string
+}
+
+// ParseToken reads a token of the purpose. CheckToken tells whether it is
+// still good for the user it was issued to.
+func ParseToken(c crypto.Crypto, secret string, purpose TokenPurpose, token string) (TokenClaims, error) {
+	if secret == "" {
+		return TokenClaims{}, errors.New(errors.InternalError, "user token secret is not set")
+	}
+
+	payload, err := c.ParseAndValidateJWT(token, secret)
+	if err != nil || payload["purpose"] != string(purpose) {
+		return TokenClaims{}, errInvalidToken()
+	}
+
+	sub, _ := payload["sub"].(string)
+	userId, err := strconv.ParseInt(sub, 10, 64)
+	if err != nil {
+		return TokenClaims{}, errInvalidToken()
+	}
+	stamp, _ := payload["stamp"].(string)
+
+	return TokenClaims{UserId: userId, Purpose: purpose, stamp: stamp}, nil
+}
+
+// CheckToken tells whether the token still matches the user.
+func (user *UserModel) CheckToken(claims TokenClaims) error {
+	if claims.UserId != user.Id || claims.stamp != user.tokenStamp(claims.Purpose) {
+		return errInvalidToken()
+	}
+
+	return nil
+}
+
+// tokenStamp is what a token of the purpose is bound to. The password hash
+// is hashed again so that the token doesn't carry it.
+func (user *UserModel) tokenStamp(purpose TokenPurpose) string {
+	bound := user.Email
+	if purpose == TokenResetPassword {
+		bound = user.Password
+	}
+
+	sum := sha256.Sum256([]byte(string(purpose) + ":" + bound))
+	return hex.EncodeToString(sum[:8])
+}
+
+func errInvalidToken() error {
+	return errors.New(errors.ValidationError, "token: the link is invalid or has expired.")
+}
@@ -1,15 +1,34 @@
 //go:generate mockery --name UserUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
 
 package user
 
 import (
 	"context"
+
+	"fibo/internal/reputation"
 )
 
 type UserUsecases interface {
 	Add(ctx context.Context, dto AddUserDto) (int64, error)
 	Update(ctx context.Context, dto UpdateUserDto) error
 	ChangePassword(ctx context.Context, dto ChangeUserPasswordDto) error
+	VerifyEmail(ctx context.Context, dto VerifyEmailDto) error
+	// RequestPasswordReset emails a reset link if a user has the address.
+	// It tells nothing about whether one has.
+	RequestPasswordReset(ctx context.Context, dto RequestPasswordResetDto) error
+	ResetPassword(ctx context.Context, dto ResetPasswordDto) error
+	ChangeRole(ctx context.Context, dto ChangeUserRoleDto) error
 	GetById(ctx context.Context, userId int64) (UserDto, error)
 	GetAllUsers(ctx context.Context) ([]UserDto, error)
+	GetReputationHistory(ctx context.Context, dto reputation.GetEventsDto) ([]reputation.EventDto, error)
+	RecomputeReputation(ctx context.Context) (reputation.RecomputeDto, error)
+	GetReputationRules(ctx context.Context) ([]reputation.RuleDto, error)
+	UpdateReputationRule(ctx context.Context, dto reputation.UpdateRuleDto) error
+}
+
+type Config interface {
+	// TokenSecret signs the tokens of the links emailed to users to confirm
+	// their address or reset their password.
+	TokenSecret() string
 }
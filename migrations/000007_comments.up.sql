@@ -0,0 +1,13 @@
+CREATE TABLE comments (
+  id BIGSERIAL PRIMARY KEY,
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  parent_id BIGINT REFERENCES comments (id) ON DELETE CASCADE,
+  user_id BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  author_name VARCHAR(100),
+  content TEXT NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  deleted_at TIMESTAMP
+);
+
+CREATE INDEX comments_post_id_parent_id_idx ON comments (post_id, parent_id, created_at);
@@ -0,0 +1,4 @@
+ALTER TABLE posts
+ALTER COLUMN likes DROP NOT NULL;
+
+DROP TABLE IF EXISTS post_likes;
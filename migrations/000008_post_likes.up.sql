@@ -0,0 +1,20 @@
+CREATE TABLE post_likes (
+  id BIGSERIAL PRIMARY KEY,
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  user_id BIGINT REFERENCES users (user_id) ON DELETE CASCADE,
+  visitor_id VARCHAR(64),
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT post_likes_voter_check CHECK ((user_id IS NULL) <> (visitor_id IS NULL))
+);
+
+CREATE UNIQUE INDEX post_likes_post_id_user_id_key ON post_likes (post_id, user_id)
+  WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX post_likes_post_id_visitor_id_key ON post_likes (post_id, visitor_id)
+  WHERE visitor_id IS NOT NULL;
+
+-- Likes used to be written by clients, so they cannot be trusted. From now on
+-- the counter only reflects the ledger.
+UPDATE posts SET likes = 0;
+
+ALTER TABLE posts
+ALTER COLUMN likes SET NOT NULL;
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS post_transitions;
+
+ALTER TABLE posts
+ADD COLUMN is_published BOOLEAN DEFAULT FALSE;
+
+UPDATE posts SET is_published = (state = 'published');
+
+DROP INDEX IF EXISTS posts_state_idx;
+
+ALTER TABLE posts
+DROP COLUMN state,
+DROP COLUMN review_notes,
+DROP COLUMN state_changed_at;
@@ -0,0 +1,23 @@
+ALTER TABLE posts
+ADD COLUMN state VARCHAR(20) NOT NULL DEFAULT 'draft',
+ADD COLUMN review_notes TEXT NOT NULL DEFAULT '',
+ADD COLUMN state_changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE posts SET state = 'published' WHERE is_published;
+
+ALTER TABLE posts
+DROP COLUMN is_published;
+
+CREATE INDEX posts_state_idx ON posts (state, state_changed_at);
+
+CREATE TABLE post_transitions (
+  id BIGSERIAL PRIMARY KEY,
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  from_state VARCHAR(20) NOT NULL,
+  to_state VARCHAR(20) NOT NULL,
+  actor_id BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  notes TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX post_transitions_post_id_idx ON post_transitions (post_id, created_at);
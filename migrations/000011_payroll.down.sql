@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS payroll_items;
+DROP TABLE IF EXISTS payroll_authors;
+DROP TABLE IF EXISTS payroll_runs;
+DROP FUNCTION IF EXISTS payroll_lines_immutable;
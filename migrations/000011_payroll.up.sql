@@ -0,0 +1,52 @@
+CREATE TABLE payroll_runs (
+  id BIGSERIAL PRIMARY KEY,
+  month DATE NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'draft',
+  tiers TEXT NOT NULL,
+  total_amount BIGINT NOT NULL DEFAULT 0,
+  created_by BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  approved_by BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  approved_at TIMESTAMP,
+  CHECK (month = date_trunc('month', month)::DATE)
+);
+
+CREATE INDEX payroll_runs_month_idx ON payroll_runs (month);
+CREATE UNIQUE INDEX payroll_runs_approved_month_key ON payroll_runs (month) WHERE status = 'approved';
+
+-- Line items keep a copy of what was paid, so they don't reference users or
+-- posts that may change or go away later.
+CREATE TABLE payroll_authors (
+  run_id BIGINT NOT NULL REFERENCES payroll_runs (id),
+  user_id BIGINT NOT NULL,
+  points BIGINT NOT NULL,
+  rate BIGINT NOT NULL,
+  amount BIGINT NOT NULL,
+  PRIMARY KEY (run_id, user_id)
+);
+
+CREATE TABLE payroll_items (
+  run_id BIGINT NOT NULL REFERENCES payroll_runs (id),
+  user_id BIGINT NOT NULL,
+  post_id BIGINT NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  likes BIGINT NOT NULL,
+  comments BIGINT NOT NULL,
+  points BIGINT NOT NULL,
+  amount BIGINT NOT NULL,
+  PRIMARY KEY (run_id, post_id)
+);
+
+CREATE FUNCTION payroll_lines_immutable() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'payroll line items are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER payroll_authors_immutable
+BEFORE UPDATE OR DELETE ON payroll_authors
+FOR EACH STATEMENT EXECUTE FUNCTION payroll_lines_immutable();
+
+CREATE TRIGGER payroll_items_immutable
+BEFORE UPDATE OR DELETE ON payroll_items
+FOR EACH STATEMENT EXECUTE FUNCTION payroll_lines_immutable();
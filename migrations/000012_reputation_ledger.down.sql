@@ -0,0 +1,6 @@
+ALTER TABLE users
+ALTER COLUMN reputation DROP NOT NULL;
+
+DROP TABLE IF EXISTS reputation_events;
+DROP TABLE IF EXISTS reputation_rules;
+DROP FUNCTION IF EXISTS reputation_events_append_only;
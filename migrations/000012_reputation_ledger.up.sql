@@ -0,0 +1,72 @@
+CREATE TABLE reputation_rules (
+  event VARCHAR(40) PRIMARY KEY,
+  points INTEGER NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO reputation_rules (event, points, description) VALUES
+  ('post_liked', 1, 'Somebody liked your post'),
+  ('post_unliked', -1, 'Somebody took back a like of your post'),
+  ('post_approved', 10, 'Your post was approved and published'),
+  ('comment_received', 1, 'Somebody commented on your post'),
+  ('view_milestone', 5, 'Your post reached a view milestone'),
+  ('report_penalty', -20, 'A report against your content was upheld');
+
+-- Events don't store points: the rules are applied when the reputation is
+-- computed, so changing a rule can be replayed over the whole ledger.
+CREATE TABLE reputation_events (
+  id BIGSERIAL PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES users (user_id),
+  event VARCHAR(40) NOT NULL REFERENCES reputation_rules (event),
+  post_id BIGINT,
+  ref VARCHAR(100),
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX reputation_events_user_id_idx ON reputation_events (user_id, created_at);
+CREATE UNIQUE INDEX reputation_events_event_ref_key ON reputation_events (event, ref)
+  WHERE ref IS NOT NULL;
+
+CREATE FUNCTION reputation_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'reputation events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reputation_events_append_only
+BEFORE UPDATE OR DELETE ON reputation_events
+FOR EACH STATEMENT EXECUTE FUNCTION reputation_events_append_only();
+
+-- Seed the ledger with what can still be derived from existing data. The
+-- signup reputation had no history and is dropped. Only likes and comments
+-- of registered readers other than the author earn reputation.
+INSERT INTO reputation_events (user_id, event, post_id, created_at)
+SELECT posts.user_id, 'post_liked', posts.id, post_likes.created_at
+FROM post_likes
+INNER JOIN posts ON posts.id = post_likes.post_id
+INNER JOIN users ON users.user_id = posts.user_id
+WHERE post_likes.user_id IS NOT NULL AND post_likes.user_id <> posts.user_id;
+
+INSERT INTO reputation_events (user_id, event, post_id, ref, created_at)
+SELECT posts.user_id, 'comment_received', posts.id, 'comment:' || comments.id, comments.created_at
+FROM comments
+INNER JOIN posts ON posts.id = comments.post_id
+INNER JOIN users ON users.user_id = posts.user_id
+WHERE comments.user_id IS NOT NULL AND comments.user_id <> posts.user_id AND comments.deleted_at IS NULL;
+
+INSERT INTO reputation_events (user_id, event, post_id, ref, created_at)
+SELECT posts.user_id, 'post_approved', posts.id, 'post:' || posts.id, posts.state_changed_at
+FROM posts
+INNER JOIN users ON users.user_id = posts.user_id
+WHERE posts.state = 'published';
+
+UPDATE users SET reputation = (
+  SELECT COALESCE(SUM(reputation_rules.points), 0)
+  FROM reputation_events
+  INNER JOIN reputation_rules ON reputation_rules.event = reputation_events.event
+  WHERE reputation_events.user_id = users.user_id
+);
+
+ALTER TABLE users
+ALTER COLUMN reputation SET NOT NULL;
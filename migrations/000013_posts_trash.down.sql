@@ -0,0 +1 @@
+DROP INDEX IF EXISTS posts_deleted_at_idx;
@@ -0,0 +1,2 @@
+-- Only deleted posts are indexed, the purge job is the one looking them up.
+CREATE INDEX posts_deleted_at_idx ON posts (deleted_at) WHERE deleted_at IS NOT NULL;
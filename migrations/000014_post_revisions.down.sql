@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS post_revisions;
+
+ALTER TABLE posts
+DROP COLUMN revision,
+DROP COLUMN approved_revision;
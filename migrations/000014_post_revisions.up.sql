@@ -0,0 +1,23 @@
+ALTER TABLE posts
+ADD COLUMN revision INTEGER NOT NULL DEFAULT 1,
+ADD COLUMN approved_revision INTEGER;
+
+UPDATE posts SET approved_revision = 1 WHERE state IN ('approved', 'published');
+
+CREATE TABLE post_revisions (
+  id BIGSERIAL PRIMARY KEY,
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  revision INTEGER NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  content TEXT NOT NULL,
+  category_id INTEGER,
+  user_id BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (post_id, revision)
+);
+
+-- The current text of every post becomes its first revision.
+INSERT INTO post_revisions (post_id, revision, title, content, category_id, user_id, created_at)
+SELECT posts.id, 1, posts.title, posts.content, posts.category_id, users.user_id, COALESCE(posts.updated_at, CURRENT_TIMESTAMP)
+FROM posts
+LEFT JOIN users ON users.user_id = posts.user_id;
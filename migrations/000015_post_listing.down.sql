@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS posts_views_id_idx;
+DROP INDEX IF EXISTS posts_likes_id_idx;
+DROP INDEX IF EXISTS posts_created_at_id_idx;
+
+ALTER TABLE posts
+DROP COLUMN views;
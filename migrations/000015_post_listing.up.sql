@@ -0,0 +1,7 @@
+ALTER TABLE posts
+ADD COLUMN views BIGINT NOT NULL DEFAULT 0;
+
+-- Keyset pagination walks these in both directions.
+CREATE INDEX posts_created_at_id_idx ON posts (created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX posts_likes_id_idx ON posts (likes, id) WHERE deleted_at IS NULL;
+CREATE INDEX posts_views_id_idx ON posts (views, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,47 @@
+ALTER TABLE posts
+ADD COLUMN search_text TEXT NOT NULL DEFAULT '',
+ADD COLUMN search_vector TSVECTOR NOT NULL DEFAULT ''::TSVECTOR;
+
+-- Existing posts are indexed here, the application keeps the columns up to
+-- date afterwards. EditorJS documents give the text of their blocks,
+-- anything else is indexed with its tags stripped.
+CREATE FUNCTION pg_temp.post_search_text(content TEXT) RETURNS TEXT AS $$
+DECLARE
+  doc JSONB;
+BEGIN
+  BEGIN
+    doc := content::JSONB;
+  EXCEPTION WHEN OTHERS THEN
+    doc := NULL;
+  END;
+
+  IF doc IS NULL OR jsonb_typeof(doc -> 'blocks') IS DISTINCT FROM 'array' THEN
+    RETURN btrim(regexp_replace(content, '<[^>]*>', ' ', 'g'));
+  END IF;
+
+  RETURN COALESCE((
+    SELECT string_agg(btrim(regexp_replace(value #>> '{}', '<[^>]*>', ' ', 'g')), E'\n')
+    FROM (
+      SELECT jsonb_path_query(doc, 'lax $.blocks[*].data.text') AS value
+      UNION ALL
+      SELECT jsonb_path_query(doc, 'lax $.blocks[*].data.code')
+      UNION ALL
+      SELECT jsonb_path_query(doc, 'lax $.blocks[*].data.caption')
+      UNION ALL
+      SELECT jsonb_path_query(doc, 'lax $.blocks[*].data.items.** ? (@.type() == "object").content')
+      UNION ALL
+      SELECT jsonb_path_query(doc, 'lax $.blocks[*].data.items[*] ? (@.type() == "string")')
+    ) AS texts
+    WHERE jsonb_typeof(value) = 'string'
+  ), '');
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE posts
+SET search_text = pg_temp.post_search_text(content);
+
+UPDATE posts
+SET search_vector = setweight(to_tsvector('simple', title), 'A') ||
+  setweight(to_tsvector('simple', search_text), 'B');
+
+CREATE INDEX posts_search_vector_idx ON posts USING GIN (search_vector);
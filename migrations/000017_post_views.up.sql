@@ -0,0 +1,8 @@
+-- The last counted read of a post by each reader. Reads within the view
+-- window of it don't count again.
+CREATE TABLE post_views (
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  viewer VARCHAR(80) NOT NULL,
+  viewed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (post_id, viewer)
+);
@@ -0,0 +1,4 @@
+ALTER TABLE posts
+DROP COLUMN code_blocks,
+DROP COLUMN reading_minutes,
+DROP COLUMN word_count;
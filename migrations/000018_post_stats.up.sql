@@ -0,0 +1,39 @@
+ALTER TABLE posts
+ADD COLUMN word_count INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN reading_minutes INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN code_blocks INTEGER NOT NULL DEFAULT 0;
+
+-- Existing posts are measured by their indexed text, the application keeps
+-- the stats up to date afterwards.
+UPDATE posts
+SET word_count = COALESCE(array_length(regexp_split_to_array(btrim(search_text), '\s+'), 1), 0)
+WHERE btrim(search_text) <> '';
+
+CREATE FUNCTION pg_temp.post_code_blocks(content TEXT) RETURNS INTEGER AS $$
+DECLARE
+  doc JSONB;
+BEGIN
+  BEGIN
+    doc := content::JSONB;
+  EXCEPTION WHEN OTHERS THEN
+    RETURN (SELECT COUNT(*) FROM regexp_matches(content, '<pre[\s>]', 'gi'));
+  END;
+
+  IF jsonb_typeof(doc -> 'blocks') IS DISTINCT FROM 'array' THEN
+    RETURN (SELECT COUNT(*) FROM regexp_matches(content, '<pre[\s>]', 'gi'));
+  END IF;
+
+  RETURN (
+    SELECT COUNT(*)
+    FROM jsonb_array_elements(doc -> 'blocks') AS block
+    WHERE block ->> 'type' = 'code'
+  );
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE posts
+SET code_blocks = pg_temp.post_code_blocks(content);
+
+UPDATE posts
+SET reading_minutes = GREATEST(1, CEIL((word_count * 60 / 200 + code_blocks * 30) / 60.0))
+WHERE word_count > 0 OR code_blocks > 0;
@@ -0,0 +1,17 @@
+CREATE TABLE tags (
+  id BIGSERIAL PRIMARY KEY,
+  name VARCHAR(40) NOT NULL,
+  slug VARCHAR(60) NOT NULL UNIQUE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Autocompletion matches slugs by prefix.
+CREATE INDEX tags_slug_prefix_idx ON tags (slug text_pattern_ops);
+
+CREATE TABLE post_tags (
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  tag_id BIGINT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+  PRIMARY KEY (post_id, tag_id)
+);
+
+CREATE INDEX post_tags_tag_id_idx ON post_tags (tag_id, post_id);
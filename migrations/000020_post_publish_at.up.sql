@@ -0,0 +1,8 @@
+ALTER TABLE posts
+ADD COLUMN publish_at TIMESTAMP;
+
+-- Published posts went live when they were last published.
+UPDATE posts SET publish_at = state_changed_at WHERE state = 'published';
+
+-- The scheduler looks for approved posts that are due.
+CREATE INDEX posts_publish_at_idx ON posts (publish_at) WHERE state = 'approved';
@@ -0,0 +1,4 @@
+ALTER TABLE posts
+DROP COLUMN slug;
+
+DROP TABLE IF EXISTS post_slugs;
@@ -0,0 +1,58 @@
+-- Every slug a post has had. Old ones keep pointing to the post after its
+-- title changes.
+CREATE TABLE post_slugs (
+  slug VARCHAR(100) PRIMARY KEY,
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX post_slugs_post_id_idx ON post_slugs (post_id);
+
+ALTER TABLE posts
+ADD COLUMN slug VARCHAR(100);
+
+-- Mirrors post.NewSlug: Mongolian Cyrillic is spelled in Latin letters and
+-- everything but letters and digits becomes a dash.
+CREATE FUNCTION pg_temp.post_slug(title TEXT) RETURNS TEXT AS $$
+DECLARE
+  slug TEXT := lower(title);
+BEGIN
+  slug := replace(slug, 'х', 'kh');
+  slug := replace(slug, 'ц', 'ts');
+  slug := replace(slug, 'ч', 'ch');
+  slug := replace(slug, 'ш', 'sh');
+  slug := replace(slug, 'щ', 'sh');
+  slug := replace(slug, 'е', 'ye');
+  slug := replace(slug, 'ё', 'yo');
+  slug := replace(slug, 'ю', 'yu');
+  slug := replace(slug, 'я', 'ya');
+  slug := replace(slug, 'ъ', '');
+  slug := translate(slug, 'абвгджзийклмноөпрстуүфыьэ', 'abvgdjziiklmnooprstuufyie');
+  slug := btrim(regexp_replace(slug, '[^[:alnum:]]+', '-', 'g'), '-');
+  slug := btrim(left(slug, 80), '-');
+
+  IF slug = '' THEN
+    RETURN 'post';
+  END IF;
+  RETURN slug;
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE posts
+SET slug = pg_temp.post_slug(title);
+
+-- The oldest post keeps a shared slug, the others are told apart by id.
+UPDATE posts
+SET slug = posts.slug || '-' || posts.id
+FROM (
+  SELECT id, row_number() OVER (PARTITION BY slug ORDER BY id) AS n
+  FROM posts
+) AS numbered
+WHERE numbered.id = posts.id AND numbered.n > 1;
+
+INSERT INTO post_slugs (slug, post_id)
+SELECT slug, id FROM posts;
+
+ALTER TABLE posts
+ALTER COLUMN slug SET NOT NULL,
+ADD CONSTRAINT posts_slug_key UNIQUE (slug);
@@ -0,0 +1,7 @@
+ALTER TABLE comments
+DROP COLUMN hidden_at;
+
+ALTER TABLE posts
+DROP COLUMN hidden_at;
+
+DROP TABLE IF EXISTS reports;
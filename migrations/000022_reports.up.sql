@@ -0,0 +1,32 @@
+-- Reports point at a post or a comment, so the target is checked by the app
+-- instead of a foreign key.
+CREATE TABLE reports (
+  id BIGSERIAL PRIMARY KEY,
+  target_type VARCHAR(20) NOT NULL,
+  target_id BIGINT NOT NULL,
+  user_id BIGINT REFERENCES users (user_id) ON DELETE CASCADE,
+  visitor_id VARCHAR(64),
+  reason VARCHAR(20) NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  status VARCHAR(20) NOT NULL DEFAULT 'open',
+  closed_by BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  closed_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT reports_target_type_check CHECK (target_type IN ('post', 'comment')),
+  CONSTRAINT reports_status_check CHECK (status IN ('open', 'upheld', 'dismissed')),
+  CONSTRAINT reports_reporter_check CHECK ((user_id IS NULL) <> (visitor_id IS NULL))
+);
+
+CREATE UNIQUE INDEX reports_target_user_id_key ON reports (target_type, target_id, user_id)
+  WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX reports_target_visitor_id_key ON reports (target_type, target_id, visitor_id)
+  WHERE visitor_id IS NOT NULL;
+CREATE INDEX reports_open_idx ON reports (target_type, target_id) WHERE status = 'open';
+
+-- Hidden content stays with its author but is kept from readers until a
+-- moderator decides on its reports.
+ALTER TABLE posts
+ADD COLUMN hidden_at TIMESTAMP;
+
+ALTER TABLE comments
+ADD COLUMN hidden_at TIMESTAMP;
@@ -0,0 +1,12 @@
+-- Every click on a share button, so authors see which channels bring
+-- readers to their posts.
+CREATE TABLE post_shares (
+  id BIGSERIAL PRIMARY KEY,
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  channel VARCHAR(20) NOT NULL,
+  user_id BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  visitor_id VARCHAR(64),
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX post_shares_post_id_channel_idx ON post_shares (post_id, channel);
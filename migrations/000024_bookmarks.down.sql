@@ -0,0 +1,5 @@
+ALTER TABLE posts
+DROP COLUMN saves;
+
+DROP TABLE IF EXISTS bookmarks;
+DROP TABLE IF EXISTS reading_lists;
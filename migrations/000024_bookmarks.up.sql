@@ -0,0 +1,31 @@
+-- Every reader has reading lists of saved posts. The default one is made
+-- with the first post saved without picking a list.
+CREATE TABLE reading_lists (
+  id BIGSERIAL PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  is_default BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX reading_lists_user_id_name_key ON reading_lists (user_id, LOWER(name));
+CREATE UNIQUE INDEX reading_lists_user_id_default_key ON reading_lists (user_id)
+  WHERE is_default;
+
+CREATE TABLE bookmarks (
+  id BIGSERIAL PRIMARY KEY,
+  list_id BIGINT NOT NULL REFERENCES reading_lists (id) ON DELETE CASCADE,
+  user_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (list_id, post_id)
+);
+
+CREATE INDEX bookmarks_list_id_position_idx ON bookmarks (list_id, position);
+CREATE INDEX bookmarks_post_id_idx ON bookmarks (post_id, user_id);
+
+-- Saves counts the readers who bookmarked a post, on any of their lists.
+ALTER TABLE posts
+ADD COLUMN saves INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+ALTER TABLE users
+DROP COLUMN following,
+DROP COLUMN followers;
+
+DROP INDEX IF EXISTS posts_category_id_created_at_idx;
+DROP INDEX IF EXISTS posts_user_id_created_at_idx;
+
+DROP TABLE IF EXISTS category_follows;
+DROP TABLE IF EXISTS author_follows;
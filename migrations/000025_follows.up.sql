@@ -0,0 +1,30 @@
+-- Readers follow authors and categories. Their feed is read from these
+-- tables joined to posts rather than copied to every follower.
+CREATE TABLE author_follows (
+  follower_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  author_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (follower_id, author_id),
+  CHECK (follower_id <> author_id)
+);
+
+CREATE INDEX author_follows_author_id_idx ON author_follows (author_id);
+
+CREATE TABLE category_follows (
+  follower_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  category_id INTEGER NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (follower_id, category_id)
+);
+
+CREATE INDEX category_follows_category_id_idx ON category_follows (category_id);
+
+-- The feed walks the newest posts of each followed author and category.
+CREATE INDEX posts_user_id_created_at_idx ON posts (user_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX posts_category_id_created_at_idx ON posts (category_id, created_at, id) WHERE deleted_at IS NULL;
+
+-- Followers counts the readers following an author, following the authors
+-- and categories a reader follows.
+ALTER TABLE users
+ADD COLUMN followers INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN following INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,24 @@
+-- Notifications tell users what happened to them: a reviewed post, a new
+-- comment, like or follower, an approved payroll.
+CREATE TABLE notifications (
+  id BIGSERIAL PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  type VARCHAR(50) NOT NULL,
+  actor_id BIGINT REFERENCES users (user_id) ON DELETE SET NULL,
+  post_id INTEGER REFERENCES posts (id) ON DELETE CASCADE,
+  comment_id BIGINT REFERENCES comments (id) ON DELETE CASCADE,
+  run_id BIGINT REFERENCES payroll_runs (id) ON DELETE CASCADE,
+  read_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX notifications_user_id_id_idx ON notifications (user_id, id);
+CREATE INDEX notifications_unread_idx ON notifications (user_id) WHERE read_at IS NULL;
+
+-- Users get notifications of every type until they turn it off.
+CREATE TABLE notification_preferences (
+  user_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  type VARCHAR(50) NOT NULL,
+  enabled BOOLEAN NOT NULL,
+  PRIMARY KEY (user_id, type)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS media_variants;
+DROP TABLE IF EXISTS media;
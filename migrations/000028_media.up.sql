@@ -0,0 +1,26 @@
+-- Media are images users upload for their posts. Their files are in the
+-- media storage under their keys.
+CREATE TABLE media (
+  id BIGSERIAL PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  key VARCHAR(255) NOT NULL UNIQUE,
+  content_type VARCHAR(50) NOT NULL,
+  size BIGINT NOT NULL,
+  width INTEGER NOT NULL,
+  height INTEGER NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX media_user_id_id_idx ON media (user_id, id);
+
+-- Variants are the resized copies made of every upload.
+CREATE TABLE media_variants (
+  media_id BIGINT NOT NULL REFERENCES media (id) ON DELETE CASCADE,
+  name VARCHAR(20) NOT NULL,
+  key VARCHAR(255) NOT NULL UNIQUE,
+  content_type VARCHAR(50) NOT NULL,
+  size BIGINT NOT NULL,
+  width INTEGER NOT NULL,
+  height INTEGER NOT NULL,
+  PRIMARY KEY (media_id, name)
+);
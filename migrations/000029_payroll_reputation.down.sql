@@ -0,0 +1,2 @@
+ALTER TABLE payroll_authors
+DROP COLUMN IF EXISTS reputation;
@@ -0,0 +1,4 @@
+-- Runs are paid by the reputation authors had at the end of the month, which
+-- is kept next to the line. Older runs were paid by points.
+ALTER TABLE payroll_authors
+ADD COLUMN reputation BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS posts_publish_at_id_idx;
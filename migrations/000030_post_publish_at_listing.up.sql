@@ -0,0 +1,3 @@
+-- Feeds walk published posts by the time they went live.
+CREATE INDEX posts_publish_at_id_idx ON posts (publish_at, id)
+  WHERE deleted_at IS NULL AND publish_at IS NOT NULL;
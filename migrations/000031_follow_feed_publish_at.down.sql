@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS posts_category_id_publish_at_idx;
+DROP INDEX IF EXISTS posts_user_id_publish_at_idx;
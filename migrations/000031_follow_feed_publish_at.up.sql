@@ -0,0 +1,6 @@
+-- The feed walks the posts of each followed author and category by the time
+-- they went live.
+CREATE INDEX posts_user_id_publish_at_idx ON posts (user_id, publish_at, id)
+  WHERE deleted_at IS NULL AND publish_at IS NOT NULL;
+CREATE INDEX posts_category_id_publish_at_idx ON posts (category_id, publish_at, id)
+  WHERE deleted_at IS NULL AND publish_at IS NOT NULL;
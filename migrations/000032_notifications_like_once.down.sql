@@ -0,0 +1 @@
+DROP INDEX IF EXISTS notifications_post_liked_key;
@@ -0,0 +1,13 @@
+-- A like is told once per post and actor, taking it back and liking again
+-- doesn't tell the author again. The latest of earlier repeats is kept.
+DELETE FROM notifications
+USING notifications AS later
+WHERE notifications.type = 'post_liked'
+  AND later.type = 'post_liked'
+  AND later.user_id = notifications.user_id
+  AND later.post_id = notifications.post_id
+  AND later.actor_id = notifications.actor_id
+  AND later.id > notifications.id;
+
+CREATE UNIQUE INDEX notifications_post_liked_key ON notifications (user_id, post_id, actor_id)
+  WHERE type = 'post_liked';
@@ -0,0 +1,4 @@
+-- Users confirm their address through the link emailed when they sign up or
+-- change it.
+ALTER TABLE users
+ADD COLUMN email_verified_at TIMESTAMP;
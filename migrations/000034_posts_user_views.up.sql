@@ -0,0 +1,15 @@
+-- Views of registered users, the ones view milestones are counted in.
+-- Earlier views can't be told apart, so each registered reader on record
+-- counts once.
+ALTER TABLE posts
+ADD COLUMN user_views BIGINT NOT NULL DEFAULT 0;
+
+UPDATE posts
+SET user_views = seen.readers
+FROM (
+  SELECT post_id, COUNT(*) AS readers
+  FROM post_views
+  WHERE viewer LIKE 'user:%'
+  GROUP BY post_id
+) AS seen
+WHERE posts.id = seen.post_id;